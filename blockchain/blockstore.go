@@ -41,6 +41,11 @@ var (
 	paraSeqToHashKey      = []byte("ParaSeq:")
 	HashToParaSeqPrefix   = []byte("HashToParaSeq:")
 	LastParaSequence      = []byte("LastParaSequence")
+	reorgRecordPrefix     = []byte("ReorgRecord:")
+	reorgSeqPrefix        = []byte("ReorgSeq:")
+	lightLastHeight       = []byte("LightLastHeight")
+	lightHeaderPrefix     = []byte("LightHeader:")
+	lightTxPrefix         = []byte("LightTx:")
 	// chunk相关
 	BodyHashToChunk    = []byte("BodyHashToChunk:")
 	ChunkNumToHash     = []byte("ChunkNumToHash:")
@@ -61,7 +66,8 @@ func GetLocalDBKeyList() [][]byte {
 		pushPrefix, lastSeqNumPrefix, tempBlockKey, lastTempBlockKey, LastParaSequence,
 		chainParaTxPrefix, chainBodyPrefix, chainHeaderPrefix, chainReceiptPrefix,
 		BodyHashToChunk, ChunkNumToHash, ChunkHashToNum, RecvChunkNumToHash,
-		MaxSerialChunkNum, MaxDeletedChunkNum,
+		MaxSerialChunkNum, MaxDeletedChunkNum, reorgRecordPrefix, reorgSeqPrefix,
+		lightLastHeight, lightHeaderPrefix, lightTxPrefix,
 	}
 }
 
@@ -312,6 +318,11 @@ func (bs *BlockStore) GetKey(key []byte) ([]byte, error) {
 	return value, err
 }
 
+// Delete store通用接口
+func (bs *BlockStore) Delete(key []byte) error {
+	return bs.db.Delete(key)
+}
+
 // PrefixCount store通用接口
 func (bs *BlockStore) PrefixCount(prefix []byte) int64 {
 	counts := dbm.NewListHelper(bs.db).PrefixCount(prefix)
@@ -438,12 +449,14 @@ func (bs *BlockStore) UpdateHeight() {
 		return
 	}
 	atomic.StoreInt64(&bs.height, height)
+	blockHeightGauge.Update(height)
 	storeLog.Debug("UpdateHeight", "curblockheight", height)
 }
 
 //UpdateHeight2 更新指定的block高度到BlockStore.Height TODO：命名不清晰，不能体现和原来函数的区别
 func (bs *BlockStore) UpdateHeight2(height int64) {
 	atomic.StoreInt64(&bs.height, height)
+	blockHeightGauge.Update(height)
 	storeLog.Debug("UpdateHeight2", "curblockheight", height)
 }
 
@@ -315,23 +315,10 @@ func (chain *BlockChain) fetchPeerList() error {
 		synlog.Error("fetchPeerList chain client not bind message queue.")
 		return nil
 	}
-	msg := chain.client.NewMessage("p2p", types.EventPeerInfo, nil)
-	Err := chain.client.SendTimeout(msg, true, 30*time.Second)
-	if Err != nil {
-		synlog.Error("fetchPeerList", "client.Send err:", Err)
-		return Err
-	}
-	resp, err := chain.client.WaitTimeout(msg, 60*time.Second)
+	peerlist, err := chain.requestPeerList()
 	if err != nil {
-		synlog.Error("fetchPeerList", "client.Wait err:", err)
 		return err
 	}
-
-	peerlist, ok := resp.GetData().(*types.PeerList)
-	if !ok {
-		synlog.Error("fetchPeerList", "peerlist", "is nil")
-		return types.ErrNoPeer
-	}
 	curheigt := chain.GetBlockHeight()
 
 	var peerInfoList PeerInfoList
@@ -359,6 +346,7 @@ func (chain *BlockChain) fetchPeerList() error {
 	chain.peerMaxBlklock.Lock()
 	chain.peerList = subInfoList
 	chain.peerMaxBlklock.Unlock()
+	chain.updateSyncLag()
 
 	//获取到peerlist之后，需要判断是否已经发起了最优链的检测。如果没有就触发一次最优链的检测
 	if atomic.LoadInt32(&chain.firstcheckbestchain) == 0 {
@@ -368,6 +356,28 @@ func (chain *BlockChain) fetchPeerList() error {
 	return nil
 }
 
+//requestPeerList 从p2p模块获取peerlist
+func (chain *BlockChain) requestPeerList() (*types.PeerList, error) {
+	msg := chain.client.NewMessage("p2p", types.EventPeerInfo, nil)
+	Err := chain.client.SendTimeout(msg, true, 30*time.Second)
+	if Err != nil {
+		synlog.Error("fetchPeerList", "client.Send err:", Err)
+		return nil, Err
+	}
+	resp, err := chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		synlog.Error("fetchPeerList", "client.Wait err:", err)
+		return nil, err
+	}
+
+	peerlist, ok := resp.GetData().(*types.PeerList)
+	if !ok {
+		synlog.Error("fetchPeerList", "peerlist", "is nil")
+		return nil, types.ErrNoPeer
+	}
+	return peerlist, nil
+}
+
 //GetRcvLastCastBlkHeight 存储广播的block最新高度
 func (chain *BlockChain) GetRcvLastCastBlkHeight() int64 {
 	chain.castlock.Lock()
@@ -881,6 +891,12 @@ func (chain *BlockChain) ProcBlockHeaders(headers *types.Headers, pid string) er
 		return types.ErrContinueBack
 	}
 	synlog.Info("ProcBlockHeaders find fork point", "height", ForkHeight, "hash", common.ToHex(forkhash))
+	//分叉点在本节点已经通过的检查点之前时，此peer的链与检查点冲突
+	if ForkHeight < chain.checkpoints.lastBelow(tipheight) {
+		synlog.Error("ProcBlockHeaders fork point before checkpoint", "forkHeight", ForkHeight, "pid", pid)
+		chain.RecordFaultPeer(pid, headers.Items[count-1].Height, headers.Items[count-1].Hash, types.ErrCheckpointMismatch)
+		return types.ErrCheckpointMismatch
+	}
 
 	if chain.GetDownloadSyncStatus() == forkChainDetectMode {
 		synlog.Error("ProcBlockHeaders forkDetect", "forkHeight", ForkHeight)
@@ -931,6 +947,12 @@ func (chain *BlockChain) ProcAddBlockHeadersMsg(headers *types.Headers, pid stri
 	}
 	count := len(headers.Items)
 	synlog.Debug("ProcAddBlockHeadersMsg", "count", count, "pid", pid)
+	if err := chain.checkHeadersCheckpoint(headers, pid); err != nil {
+		return err
+	}
+	if chain.light != nil {
+		return chain.procLightHeaders(headers, pid)
+	}
 	if count == 1 {
 		return chain.ProcBlockHeader(headers, pid)
 	}
@@ -132,6 +132,12 @@ type BlockChain struct {
 
 	blockOnChain   *BlockOnChain
 	onChainTimeout int64
+	//主链切换允许回滚的最大区块数, 0表示不限制
+	maxReorgDepth int64
+	//区块同步的检查点
+	checkpoints *checkpoints
+	//轻节点模式下校验过的区块头和交易, 非轻节点时为nil
+	light *lightChain
 
 	//记录当前已经连续的最高高度
 	maxSerialChunkNum     int64
@@ -223,6 +229,11 @@ func (chain *BlockChain) initConfig(cfg *types.Chain33Config) {
 	chain.isRecordBlockSequence = mcfg.IsRecordBlockSequence
 	chain.enablePushSubscribe = mcfg.EnablePushSubscribe
 	chain.isParaChain = mcfg.IsParaChain
+	chain.maxReorgDepth = mcfg.MaxReorgDepth
+	chain.checkpoints = newCheckpoints(cfg.GetTitle(), mcfg.Checkpoints)
+	if mcfg.LightMode && mcfg.IsParaChain {
+		panic("blockchain light mode is not supported on para chain")
+	}
 	cfg.S("quickIndex", mcfg.EnableTxQuickIndex)
 	cfg.S("reduceLocaldb", mcfg.EnableReduceLocaldb)
 
@@ -290,8 +301,12 @@ func (chain *BlockChain) SetQueueClient(client queue.Client) {
 
 	//recv 消息的处理，共识模块需要获取lastblock从数据库中
 	chain.recvwg.Add(1)
-	//初始化blockchian模块
-	chain.InitBlockChain()
+	//初始化blockchian模块, 轻节点只同步区块头
+	if chain.cfg.LightMode {
+		chain.initLightChain()
+	} else {
+		chain.InitBlockChain()
+	}
 	go chain.ProcRecvMsg()
 }
 
@@ -324,6 +339,7 @@ func (chain *BlockChain) InitBlockChain() {
 	beg := types.Now()
 	chain.InitIndexAndBestView()
 	chainlog.Info("InitIndexAndBestView", "cost", types.Since(beg))
+	chain.verifyCheckpoints()
 
 	//获取数据库中最新的区块高度，以及blockchain的数据库版本号
 	curdbver := chain.blockStore.GetDbVersion()
@@ -514,10 +530,15 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 	if currHeight < 0 {
 		return
 	}
+	//状态快照同步的节点只存储了快照高度之后的区块
+	var lowest int64
+	if base := chain.blockStore.GetSnapshotBaseHeight(); base > 0 {
+		lowest = base
+	}
 
 	for i := currHeight - chain.cfg.DefCacheSize; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < lowest {
+			i = lowest
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -527,8 +548,8 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 	}
 
 	for i := currHeight - types.HighAllowPackHeight - types.LowAllowPackHeight + 1; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < lowest {
+			i = lowest
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -559,6 +580,9 @@ func (chain *BlockChain) InitIndexAndBestView() {
 	} else {
 		height = 0
 	}
+	if base := chain.blockStore.GetSnapshotBaseHeight(); base > height {
+		height = base
+	}
 	for ; height <= curheight; height++ {
 		header, err := chain.blockStore.GetBlockHeaderByHeight(height)
 		if header == nil {
@@ -110,6 +110,15 @@ func (c *chainView) DelTip(node *blockNode) {
 	c.mtx.Unlock()
 }
 
+// 清空chain view并以node作为新的tip，状态快照同步之后使用
+func (c *chainView) ResetTip(node *blockNode) {
+	c.mtx.Lock()
+	c.nodes = make(map[int64]*list.Element)
+	c.cacheQueue.Init()
+	c.setTip(node)
+	c.mtx.Unlock()
+}
+
 // 返回 chain view tip 的height
 func (c *chainView) height() int64 {
 	node := c.tip()
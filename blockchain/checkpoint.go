@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/types/chaincfg"
+)
+
+//checkpoints 区块同步的检查点, 按高度从小到大排序
+type checkpoints struct {
+	heights []int64
+	hashes  map[int64][]byte
+}
+
+//newCheckpoints 合并chaincfg中注册的检查点和配置文件中的检查点, 相同高度以配置文件为准
+func newCheckpoints(title string, cfgs []*types.CheckpointConfig) *checkpoints {
+	hexes := make(map[int64]string)
+	for height, hash := range chaincfg.LoadCheckpoints(title) {
+		hexes[height] = hash
+	}
+	for _, cfg := range cfgs {
+		hexes[cfg.Height] = cfg.Hash
+	}
+	cps := &checkpoints{hashes: make(map[int64][]byte)}
+	for height, hexHash := range hexes {
+		hash, err := common.FromHex(hexHash)
+		if err != nil || len(hash) != sha256Len || height < 0 {
+			panic(fmt.Sprintf("blockchain checkpoint config is invalid, height:%d, hash:%s", height, hexHash))
+		}
+		cps.heights = append(cps.heights, height)
+		cps.hashes[height] = hash
+	}
+	sort.Slice(cps.heights, func(i, j int) bool { return cps.heights[i] < cps.heights[j] })
+	return cps
+}
+
+//lastBelow 获取不超过指定高度的最大检查点高度, 不存在时返回-1
+func (cps *checkpoints) lastBelow(height int64) int64 {
+	i := sort.Search(len(cps.heights), func(i int) bool { return cps.heights[i] > height })
+	if i == 0 {
+		return -1
+	}
+	return cps.heights[i-1]
+}
+
+//checkCheckpoint 检测区块是否与检查点冲突:
+//检查点高度上的区块hash必须与检查点一致, 并且不接受在本节点已经通过的检查点之前分叉的区块
+func (chain *BlockChain) checkCheckpoint(height int64, hash []byte) error {
+	if len(chain.checkpoints.heights) == 0 {
+		return nil
+	}
+	if cpHash, ok := chain.checkpoints.hashes[height]; ok {
+		if !bytes.Equal(cpHash, hash) {
+			return types.ErrCheckpointMismatch
+		}
+		return nil
+	}
+	if height < chain.checkpoints.lastBelow(chain.bestChain.Height()) {
+		return types.ErrCheckpointMismatch
+	}
+	return nil
+}
+
+//checkHeadersCheckpoint 检测peer发送的区块头是否与检查点冲突, 冲突时将此peer记录为故障节点
+func (chain *BlockChain) checkHeadersCheckpoint(headers *types.Headers, pid string) error {
+	for _, header := range headers.GetItems() {
+		cpHash, ok := chain.checkpoints.hashes[header.Height]
+		if ok && !bytes.Equal(cpHash, header.Hash) {
+			synlog.Error("checkHeadersCheckpoint", "height", header.Height, "hash", common.ToHex(header.Hash),
+				"checkpoint", common.ToHex(cpHash), "pid", pid)
+			chain.RecordFaultPeer(pid, header.Height, header.Hash, types.ErrCheckpointMismatch)
+			return types.ErrCheckpointMismatch
+		}
+	}
+	return nil
+}
+
+//verifyCheckpoints 启动时检测本节点主链与检查点是否一致, 不一致时拒绝启动, 需要清空数据重新同步
+func (chain *BlockChain) verifyCheckpoints() {
+	tipHeight := chain.GetBlockHeight()
+	for _, height := range chain.checkpoints.heights {
+		if height > tipHeight {
+			break
+		}
+		hash, err := chain.blockStore.GetBlockHashByHeight(height)
+		if err != nil {
+			chainlog.Error("verifyCheckpoints GetBlockHashByHeight", "height", height, "err", err)
+			continue
+		}
+		if !bytes.Equal(hash, chain.checkpoints.hashes[height]) {
+			chainlog.Error("verifyCheckpoints main chain conflicts with checkpoint", "height", height,
+				"hash", common.ToHex(hash), "checkpoint", common.ToHex(chain.checkpoints.hashes[height]))
+			panic(fmt.Sprintf("main chain conflicts with checkpoint at height %d, please remove the datadir and resync", height))
+		}
+	}
+}
+
+//GetCheckpoints 获取区块同步的检查点
+func (chain *BlockChain) GetCheckpoints() *types.BlockCheckpoints {
+	reply := &types.BlockCheckpoints{}
+	for _, height := range chain.checkpoints.heights {
+		reply.Items = append(reply.Items, &types.BlockCheckpoint{Height: height, Hash: chain.checkpoints.hashes[height]})
+	}
+	return reply
+}
@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/types/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newCheckpoints(t *testing.T) {
+	hash1 := common.ToHex(bytes.Repeat([]byte{1}, sha256Len))
+	hash2 := common.ToHex(bytes.Repeat([]byte{2}, sha256Len))
+	hash3 := common.ToHex(bytes.Repeat([]byte{3}, sha256Len))
+	chaincfg.RegisterCheckpoints("checkpoint-test", map[int64]string{10: hash1, 20: hash1})
+	assert.Panics(t, func() { chaincfg.RegisterCheckpoints("checkpoint-test", nil) })
+
+	//相同高度以配置文件为准
+	cps := newCheckpoints("checkpoint-test", []*types.CheckpointConfig{{Height: 20, Hash: hash2}, {Height: 5, Hash: hash3}})
+	assert.Equal(t, []int64{5, 10, 20}, cps.heights)
+	assert.Equal(t, bytes.Repeat([]byte{2}, sha256Len), cps.hashes[20])
+	assert.Equal(t, int64(-1), cps.lastBelow(4))
+	assert.Equal(t, int64(5), cps.lastBelow(5))
+	assert.Equal(t, int64(10), cps.lastBelow(19))
+	assert.Equal(t, int64(20), cps.lastBelow(100))
+
+	assert.Panics(t, func() { newCheckpoints("", []*types.CheckpointConfig{{Height: 1, Hash: "0x1234"}}) })
+	assert.Panics(t, func() { newCheckpoints("", []*types.CheckpointConfig{{Height: -1, Hash: hash1}}) })
+	assert.Equal(t, 0, len(newCheckpoints("", nil).heights))
+}
+
+func Test_checkCheckpoint(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+
+	//没有配置检查点时不做检查
+	assert.Nil(t, chain.checkCheckpoint(1, []byte("hash")))
+
+	hash, err := chain.blockStore.GetBlockHashByHeight(5)
+	require.Nil(t, err)
+	//本地主链与检查点冲突时拒绝启动
+	chain.checkpoints = newCheckpoints("", []*types.CheckpointConfig{{Height: 5, Hash: common.ToHex(bytes.Repeat([]byte{1}, sha256Len))}})
+	assert.Panics(t, chain.verifyCheckpoints)
+	chain.checkpoints = newCheckpoints("", []*types.CheckpointConfig{{Height: 5, Hash: common.ToHex(hash)}})
+	assert.NotPanics(t, chain.verifyCheckpoints)
+
+	assert.Nil(t, chain.checkCheckpoint(5, hash))
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.checkCheckpoint(5, bytes.Repeat([]byte{1}, sha256Len)))
+	//不接受在已经通过的检查点之前分叉的区块
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.checkCheckpoint(4, []byte("fork")))
+	assert.Nil(t, chain.checkCheckpoint(6, []byte("fork")))
+
+	//与检查点冲突的区块头, 发送的peer被记录为故障节点
+	chain.peerMaxBlklock.Lock()
+	chain.peerList = PeerInfoList{{Name: "peer"}, {Name: "peer2"}}
+	chain.peerMaxBlklock.Unlock()
+	headers := &types.Headers{Items: []*types.Header{{Height: 4, Hash: []byte("h4")}, {Height: 5, Hash: []byte("h5")}}}
+	assert.Equal(t, types.ErrCheckpointMismatch, chain.checkHeadersCheckpoint(headers, "peer"))
+	assert.True(t, chain.IsFaultPeer("peer"))
+	headers.Items[1].Hash = hash
+	assert.Nil(t, chain.checkHeadersCheckpoint(headers, "peer2"))
+	assert.False(t, chain.IsFaultPeer("peer2"))
+
+	reply := chain.GetCheckpoints()
+	require.Equal(t, 1, len(reply.Items))
+	assert.Equal(t, int64(5), reply.Items[0].Height)
+	assert.Equal(t, hash, reply.Items[0].Hash)
+}
@@ -32,6 +32,8 @@ const (
 	fastDownLoadMode    = 1
 	chunkDownLoadMode   = 2
 	forkChainDetectMode = 3
+	//状态快照同步模式
+	snapshotDownLoadMode = 4
 )
 
 //DownLoadInfo blockchain模块下载block处理结构体
@@ -443,7 +445,11 @@ func (chain *BlockChain) DownLoadBlocks() {
 			chain.ChunkDownLoadBlocks()
 		}
 	} else {
-		// 2.其次尝试开启快速下载模式,目前默认开启
+		// 2.新节点落后较多时尝试状态快照同步, 同步完成之后从快照高度开始快速下载
+		if chain.GetDownloadSyncStatus() == fastDownLoadMode && chain.isSnapshotSyncEnable() {
+			chain.SnapshotDownLoadBlocks()
+		}
+		// 3.其次尝试开启快速下载模式,目前默认开启
 		if chain.GetDownloadSyncStatus() == fastDownLoadMode {
 			chain.FastDownLoadBlocks()
 		}
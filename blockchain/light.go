@@ -0,0 +1,442 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+//轻节点获取交易证明时最多尝试的节点数
+const maxLightProofPeers = 3
+
+//存储轻节点校验过的区块头 height--->header
+func calcLightHeaderKey(height int64) []byte {
+	return append(lightHeaderPrefix, []byte(fmt.Sprintf("%012d", height))...)
+}
+
+//存储轻节点校验过的交易及其证明 txhash--->TransactionDetail
+func calcLightTxKey(hash []byte) []byte {
+	return append(lightTxPrefix, hash...)
+}
+
+//calcHeaderHash 通过区块头计算区块hash, 需要和Block.Hash的计算方式保持一致
+func calcHeaderHash(cfg *types.Chain33Config, header *types.Header) []byte {
+	head := &types.Header{
+		Version:    header.Version,
+		ParentHash: header.ParentHash,
+		TxHash:     header.TxHash,
+		BlockTime:  header.BlockTime,
+		Height:     header.Height,
+	}
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	return common.Sha256(types.Encode(head))
+}
+
+//lightChain 轻节点模式下只保存校验过的区块头, 以及按需从全节点获取并校验过的交易
+type lightChain struct {
+	bs    *BlockStore
+	mu    sync.Mutex
+	tip   *types.Header
+	tipTd *big.Int
+}
+
+func newLightChain(bs *BlockStore) *lightChain {
+	light := &lightChain{bs: bs, tipTd: big.NewInt(0)}
+	value, err := bs.GetKey(lightLastHeight)
+	if err != nil {
+		return light
+	}
+	height, err := decodeHeight(value)
+	if err != nil {
+		panic(fmt.Sprintf("light chain last height is invalid, err:%v", err))
+	}
+	light.tip, err = light.getHeader(height)
+	if err != nil {
+		panic(fmt.Sprintf("light chain header is lost, height:%d, err:%v", height, err))
+	}
+	light.tipTd, err = bs.GetTdByBlockHash(light.tip.Hash)
+	if err != nil {
+		panic(fmt.Sprintf("light chain td is lost, height:%d, err:%v", height, err))
+	}
+	return light
+}
+
+//lastHeader 获取轻节点最新的区块头, 还没有同步区块头时返回nil
+func (light *lightChain) lastHeader() *types.Header {
+	light.mu.Lock()
+	defer light.mu.Unlock()
+	return light.tip
+}
+
+func (light *lightChain) getHeader(height int64) (*types.Header, error) {
+	value, err := light.bs.GetKey(calcLightHeaderKey(height))
+	if err != nil {
+		return nil, types.ErrHeightNotExist
+	}
+	var header types.Header
+	if err = types.Decode(value, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+//getTd 获取区块头的累计工作量, 和全节点一样按区块hash保存
+func (light *lightChain) getTd(header *types.Header) (*big.Int, error) {
+	if header == nil {
+		return big.NewInt(0), nil
+	}
+	return light.bs.GetTdByBlockHash(header.Hash)
+}
+
+//saveHeaders 删除分叉点之后的区块头并保存新的区块头以及对应的累计工作量, 调用者需要持有锁
+func (light *lightChain) saveHeaders(forkHeight int64, headers []*types.Header, tds []*big.Int) error {
+	batch := light.bs.NewBatch(true)
+	if light.tip != nil {
+		for height := light.tip.Height; height > forkHeight; height-- {
+			batch.Delete(calcLightHeaderKey(height))
+		}
+	}
+	for i, header := range headers {
+		batch.Set(calcLightHeaderKey(header.Height), types.Encode(header))
+		if err := light.bs.SaveTdByBlockHash(batch, header.Hash, tds[i]); err != nil {
+			return err
+		}
+	}
+	tip := headers[len(headers)-1]
+	batch.Set(lightLastHeight, types.Encode(&types.Int64{Data: tip.Height}))
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	light.tip = tip
+	light.tipTd = tds[len(tds)-1]
+	return nil
+}
+
+func (light *lightChain) getTx(hash []byte) (*types.TransactionDetail, error) {
+	value, err := light.bs.GetKey(calcLightTxKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	var detail types.TransactionDetail
+	if err = types.Decode(value, &detail); err != nil {
+		return nil, err
+	}
+	return &detail, nil
+}
+
+func (light *lightChain) saveTx(hash []byte, detail *types.TransactionDetail) error {
+	return light.bs.SetSync(calcLightTxKey(hash), types.Encode(detail))
+}
+
+//initLightChain 轻节点不需要执行区块, 只加载本地校验过的区块头并开始同步区块头
+func (chain *BlockChain) initLightChain() {
+	chain.light = newLightChain(chain.blockStore)
+	chain.InitIndexAndBestView()
+	if tip := chain.light.lastHeader(); tip != nil {
+		chainlog.Info("initLightChain", "height", tip.Height, "hash", common.ToHex(tip.Hash))
+	}
+	chain.tickerwg.Add(1)
+	go chain.lightSyncRoutine()
+}
+
+//lightSyncRoutine 定时从最高的节点获取本节点之后的区块头
+func (chain *BlockChain) lightSyncRoutine() {
+	defer chain.tickerwg.Done()
+	ticker := time.NewTicker(chain.blockSynInterVal * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		case <-ticker.C:
+			chain.lightSync()
+		}
+	}
+}
+
+func (chain *BlockChain) lightSync() {
+	peerlist, err := chain.requestPeerList()
+	if err != nil {
+		return
+	}
+	var peerInfoList PeerInfoList
+	for _, peer := range peerlist.GetPeers() {
+		if peer == nil || peer.Self || peer.Header == nil {
+			continue
+		}
+		peerInfoList = append(peerInfoList, &PeerInfo{
+			Name:       peer.Name,
+			ParentHash: peer.Header.ParentHash,
+			Height:     peer.Header.Height,
+			Hash:       peer.Header.Hash,
+		})
+	}
+	sort.Sort(peerInfoList)
+	chain.peerMaxBlklock.Lock()
+	chain.peerList = peerInfoList
+	chain.peerMaxBlklock.Unlock()
+
+	maxpeer := chain.GetMaxPeerInfo()
+	if maxpeer == nil {
+		return
+	}
+	start := int64(0)
+	if tip := chain.light.lastHeader(); tip != nil {
+		start = tip.Height + 1
+	}
+	chain.fetchLightHeaders(start, maxpeer.Height, maxpeer.Name)
+}
+
+//fetchLightHeaders 从指定节点获取区块头, 一次最多获取MaxFetchBlockNum个
+func (chain *BlockChain) fetchLightHeaders(start, peerHeight int64, pid string) {
+	if start > peerHeight {
+		return
+	}
+	end := peerHeight
+	if end-start+1 > chain.MaxFetchBlockNum {
+		end = start + chain.MaxFetchBlockNum - 1
+	}
+	if err := chain.FetchBlockHeaders(start, end, pid); err != nil {
+		synlog.Error("fetchLightHeaders", "start", start, "end", end, "pid", pid, "err", err)
+	}
+}
+
+//verifyLightHeader 校验区块头和父区块头的连接关系, 区块hash, 检查点,
+//以及由共识模块校验出块签名和难度, 创世区块头只能通过检查点约束
+func (chain *BlockChain) verifyLightHeader(parent, header *types.Header) error {
+	if parent == nil && header.Height != 0 {
+		return types.ErrLightHeaderInvalid
+	}
+	if parent != nil && (header.Height != parent.Height+1 || !bytes.Equal(header.ParentHash, parent.Hash)) {
+		return types.ErrParentHash
+	}
+	if !bytes.Equal(calcHeaderHash(chain.client.GetConfig(), header), header.Hash) {
+		return types.ErrLightHeaderInvalid
+	}
+	if cpHash, ok := chain.checkpoints.hashes[header.Height]; ok && !bytes.Equal(cpHash, header.Hash) {
+		return types.ErrCheckpointMismatch
+	}
+	if header.Height == 0 {
+		return nil
+	}
+	return util.CheckHeader(chain.client, parent, header)
+}
+
+//procLightHeaders 轻节点处理从peer获取或者广播的区块头:
+//跳过本地已经存在的区块头, 校验剩余的区块头连接到本地区块头上, 分叉时只切换到累计工作量更大的链
+func (chain *BlockChain) procLightHeaders(headers *types.Headers, pid string) error {
+	items := headers.GetItems()
+	if len(items) == 0 {
+		return types.ErrInvalidParam
+	}
+	light := chain.light
+	light.mu.Lock()
+	defer light.mu.Unlock()
+
+	start := 0
+	var parent *types.Header
+	for ; start < len(items); start++ {
+		local, err := light.getHeader(items[start].Height)
+		if err != nil || !bytes.Equal(local.Hash, items[start].Hash) {
+			break
+		}
+		parent = local
+	}
+	if start == len(items) {
+		return nil
+	}
+	first := items[start]
+	tipHeight := int64(-1)
+	if light.tip != nil {
+		tipHeight = light.tip.Height
+	}
+	//和本地区块头不连续时忽略
+	if first.Height > tipHeight+1 {
+		synlog.Debug("procLightHeaders not continuous", "height", first.Height, "tipHeight", tipHeight, "pid", pid)
+		return nil
+	}
+	if parent == nil && first.Height > 0 {
+		parent, _ = light.getHeader(first.Height - 1)
+	}
+	//第一个区块头和本地不在同一条链上, 需要继续向前获取区块头寻找分叉点
+	if start == 0 && parent != nil && !bytes.Equal(first.ParentHash, parent.Hash) {
+		if tipHeight-first.Height > MaxRollBlockNum {
+			return types.ErrNotRollBack
+		}
+		go chain.FetchBlockHeaders(first.Height-BackBlockNum, first.Height, pid)
+		return types.ErrContinueBack
+	}
+
+	td, err := light.getTd(parent)
+	if err != nil {
+		return err
+	}
+	tds := make([]*big.Int, 0, len(items)-start)
+	for i := start; i < len(items); i++ {
+		if err := chain.verifyLightHeader(parent, items[i]); err != nil {
+			synlog.Error("procLightHeaders", "height", items[i].Height, "hash", common.ToHex(items[i].Hash), "pid", pid, "err", err)
+			chain.RecordFaultPeer(pid, items[i].Height, items[i].Hash, err)
+			return err
+		}
+		td = new(big.Int).Add(td, difficulty.CalcWork(items[i].Difficulty))
+		tds = append(tds, td)
+		parent = items[i]
+	}
+
+	forkHeight := first.Height - 1
+	newTip := items[len(items)-1]
+	if forkHeight < tipHeight {
+		if td.Cmp(light.tipTd) <= 0 {
+			//分叉链的区块头需要一次获取到累计工作量超过本地才能切换
+			peer := chain.GetPeerInfo(pid)
+			if peer != nil && peer.Height > newTip.Height && peer.Height-first.Height < chain.MaxFetchBlockNum {
+				go chain.fetchLightHeaders(first.Height, peer.Height, pid)
+			}
+			return nil
+		}
+		if forkHeight < chain.checkpoints.lastBelow(tipHeight) {
+			chain.RecordFaultPeer(pid, newTip.Height, newTip.Hash, types.ErrCheckpointMismatch)
+			return types.ErrCheckpointMismatch
+		}
+		if chain.maxReorgDepth > 0 && tipHeight-forkHeight > chain.maxReorgDepth {
+			chain.RecordFaultPeer(pid, newTip.Height, newTip.Hash, types.ErrReorgTooDeep)
+			return types.ErrReorgTooDeep
+		}
+		synlog.Info("procLightHeaders reorg", "forkHeight", forkHeight, "tipHeight", tipHeight, "newTipHeight", newTip.Height, "pid", pid)
+	}
+	if err := light.saveHeaders(forkHeight, items[start:], tds); err != nil {
+		return err
+	}
+	synlog.Debug("procLightHeaders", "height", newTip.Height, "hash", common.ToHex(newTip.Hash), "pid", pid)
+
+	//节点还有更高的区块头时继续获取
+	if peer := chain.GetPeerInfo(pid); peer != nil && peer.Height > newTip.Height && len(items) > 1 {
+		go chain.fetchLightHeaders(newTip.Height+1, peer.Height, pid)
+	}
+	return nil
+}
+
+//verifyTxProof 使用本地校验过的区块头校验交易的默克尔证明,
+//交易回执不在区块头的承诺中无法校验, 轻节点不返回交易回执
+func (chain *BlockChain) verifyTxProof(hash []byte, detail *types.TransactionDetail) (*types.Header, error) {
+	tx := detail.GetTx()
+	if tx == nil || !bytes.Equal(tx.Hash(), hash) {
+		return nil, types.ErrTxProofInvalid
+	}
+	header, err := chain.light.getHeader(detail.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	if detail.GetIndex() < 0 || detail.GetIndex() >= header.GetTxCount() {
+		return nil, types.ErrTxProofInvalid
+	}
+	var root []byte
+	if !chain.client.GetConfig().IsFork(header.Height, "ForkRootHash") {
+		root = merkle.GetMerkleRootFromBranch(detail.GetProofs(), tx.Hash(), uint32(detail.GetIndex()))
+	} else {
+		proofs := detail.GetTxProofs()
+		if len(proofs) == 0 || len(proofs) > 2 {
+			return nil, types.ErrTxProofInvalid
+		}
+		//单层merkle树时只有一个证明, 多层时先证明交易在子链中, 再证明子链在区块中
+		root = merkle.GetMerkleRootFromBranch(proofs[0].GetProofs(), tx.FullHash(), proofs[0].GetIndex())
+		if len(proofs) == 1 && int64(proofs[0].GetIndex()) != detail.GetIndex() {
+			return nil, types.ErrTxProofInvalid
+		}
+		if len(proofs) == 2 {
+			if !bytes.Equal(proofs[0].GetRootHash(), root) {
+				return nil, types.ErrTxProofInvalid
+			}
+			root = merkle.GetMerkleRootFromBranch(proofs[1].GetProofs(), root, proofs[1].GetIndex())
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return nil, types.ErrTxProofInvalid
+	}
+	return header, nil
+}
+
+//fetchTxProof 通过p2p模块从指定节点获取交易及其默克尔证明
+func (chain *BlockChain) fetchTxProof(pid string, hash []byte) (*types.TransactionDetail, error) {
+	msg := chain.client.NewMessage("p2p", types.EventFetchTxProof, &types.ReqTxProof{Pid: pid, Hash: hash})
+	err := chain.client.SendTimeout(msg, true, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.WaitTimeout(msg, 60*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	detail, ok := resp.GetData().(*types.TransactionDetail)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return detail, nil
+}
+
+//queryLightTx 轻节点查询交易, 先从本地获取校验过的交易, 不存在或者区块头已经回滚时从全节点获取交易证明并校验
+func (chain *BlockChain) queryLightTx(hash []byte) (*types.TransactionDetail, error) {
+	detail, err := chain.light.getTx(hash)
+	if err == nil {
+		if _, err = chain.verifyTxProof(hash, detail); err == nil {
+			return detail, nil
+		}
+	} else if err != dbm.ErrNotFoundInDb {
+		return nil, err
+	}
+
+	peers := chain.GetPeers()
+	tried := 0
+	for i := len(peers) - 1; i >= 0 && tried < maxLightProofPeers; i-- {
+		pid := peers[i].Name
+		if chain.IsFaultPeer(pid) {
+			continue
+		}
+		tried++
+		detail, err = chain.fetchTxProof(pid, hash)
+		if err != nil {
+			synlog.Debug("queryLightTx fetchTxProof", "hash", common.ToHex(hash), "pid", pid, "err", err)
+			continue
+		}
+		header, err := chain.verifyTxProof(hash, detail)
+		if err != nil {
+			synlog.Error("queryLightTx verifyTxProof", "hash", common.ToHex(hash), "height", detail.GetHeight(), "pid", pid, "err", err)
+			continue
+		}
+		//除证明之外的字段在本地重新计算, 无法校验的交易回执不保存
+		txDetail := &types.TransactionDetail{
+			Proofs:   detail.GetProofs(),
+			TxProofs: detail.GetTxProofs(),
+			FullHash: detail.GetTx().FullHash(),
+		}
+		setTxDetailFromTxResult(txDetail, &types.TxResult{
+			Height:    detail.GetHeight(),
+			Index:     int32(detail.GetIndex()),
+			Tx:        detail.GetTx(),
+			Blocktime: header.GetBlockTime(),
+		})
+		if err = chain.light.saveTx(hash, txDetail); err != nil {
+			chainlog.Error("queryLightTx saveTx", "hash", common.ToHex(hash), "err", err)
+		}
+		return txDetail, nil
+	}
+	return nil, types.ErrTxNotExist
+}
@@ -0,0 +1,144 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//构造从parent开始的分叉区块头
+func newLightForkHeaders(cfg *types.Chain33Config, parent *types.Header, count int) []*types.Header {
+	var headers []*types.Header
+	for i := 0; i < count; i++ {
+		header := &types.Header{
+			Version:    parent.Version,
+			ParentHash: parent.Hash,
+			TxHash:     parent.TxHash,
+			BlockTime:  parent.BlockTime + 1000,
+			Height:     parent.Height + 1,
+			StateHash:  parent.StateHash,
+			Difficulty: parent.Difficulty,
+		}
+		header.Hash = calcHeaderHash(cfg, header)
+		headers = append(headers, header)
+		parent = header
+	}
+	return headers
+}
+
+func Test_procLightHeaders(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	cfg := chain.client.GetConfig()
+
+	tipHeight := chain.GetBlockHeight()
+	headers, err := chain.ProcGetHeadersMsg(&types.ReqBlocks{Start: 0, End: tipHeight})
+	require.Nil(t, err)
+	for _, header := range headers.Items {
+		assert.Equal(t, header.Hash, calcHeaderHash(cfg, header))
+	}
+
+	//轻节点数据使用单独的前缀, 可以和全节点数据共用一个db
+	chain.light = newLightChain(chain.blockStore)
+	chain.peerMaxBlklock.Lock()
+	chain.peerList = PeerInfoList{{Name: "peer", Height: tipHeight}, {Name: "badpeer", Height: tipHeight}}
+	chain.peerMaxBlklock.Unlock()
+	_, err = chain.ProcGetLastHeaderMsg()
+	assert.Equal(t, types.ErrBlockNotFound, err)
+
+	require.Nil(t, chain.ProcAddBlockHeadersMsg(&types.Headers{Items: headers.Items[:6]}, "peer"))
+	last, err := chain.ProcGetLastHeaderMsg()
+	require.Nil(t, err)
+	assert.Equal(t, headers.Items[5].Hash, last.Hash)
+	//重复的区块头直接跳过, 不连续的区块头忽略
+	assert.Nil(t, chain.procLightHeaders(&types.Headers{Items: headers.Items[:3]}, "peer"))
+	assert.Nil(t, chain.procLightHeaders(&types.Headers{Items: headers.Items[8:9]}, "peer"))
+	assert.Equal(t, int64(5), chain.light.lastHeader().Height)
+
+	//hash错误的区块头, 发送的peer被记录为故障节点
+	bad := types.Clone(headers.Items[6]).(*types.Header)
+	bad.BlockTime++
+	err = chain.procLightHeaders(&types.Headers{Items: []*types.Header{bad}}, "badpeer")
+	assert.Equal(t, types.ErrLightHeaderInvalid, err)
+	assert.True(t, chain.IsFaultPeer("badpeer"))
+	//不满足共识规则的区块头由共识模块拒绝
+	bad = types.Clone(headers.Items[6]).(*types.Header)
+	bad.Difficulty++
+	bad.Hash = calcHeaderHash(cfg, bad)
+	err = chain.procLightHeaders(&types.Headers{Items: []*types.Header{bad}}, "badpeer")
+	require.NotNil(t, err)
+	assert.Equal(t, types.ErrBlockHeaderDifficulty.Error(), err.Error())
+	assert.Equal(t, int64(5), chain.light.lastHeader().Height)
+
+	require.Nil(t, chain.procLightHeaders(&types.Headers{Items: headers.Items[5:]}, "peer"))
+	assert.Equal(t, tipHeight, chain.light.lastHeader().Height)
+
+	//累计工作量不超过本地的分叉链不切换
+	forkParent := headers.Items[tipHeight-2]
+	fork := newLightForkHeaders(cfg, forkParent, 2)
+	assert.Nil(t, chain.procLightHeaders(&types.Headers{Items: fork}, "peer"))
+	assert.Equal(t, headers.Items[tipHeight].Hash, chain.light.lastHeader().Hash)
+	//超过最大回滚深度时拒绝切换
+	fork = newLightForkHeaders(cfg, forkParent, 3)
+	chain.maxReorgDepth = 1
+	assert.Equal(t, types.ErrReorgTooDeep, chain.procLightHeaders(&types.Headers{Items: fork}, "peer"))
+	chain.maxReorgDepth = 0
+	require.Nil(t, chain.procLightHeaders(&types.Headers{Items: fork}, "peer"))
+	assert.Equal(t, fork[2].Hash, chain.light.lastHeader().Hash)
+	_, err = chain.light.getHeader(tipHeight + 2)
+	assert.Equal(t, types.ErrHeightNotExist, err)
+
+	//重启后加载最新的区块头
+	light := newLightChain(chain.blockStore)
+	assert.Equal(t, fork[2].Hash, light.lastHeader().Hash)
+}
+
+func Test_queryLightTx(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+
+	tipHeight := chain.GetBlockHeight()
+	headers, err := chain.ProcGetHeadersMsg(&types.ReqBlocks{Start: 0, End: tipHeight})
+	require.Nil(t, err)
+	block, err := chain.GetBlock(tipHeight)
+	require.Nil(t, err)
+	tx := block.Block.Txs[len(block.Block.Txs)-1]
+	detail, err := chain.ProcQueryTxMsg(tx.Hash())
+	require.Nil(t, err)
+
+	chain.light = newLightChain(chain.blockStore)
+	//区块头还没有同步
+	_, err = chain.verifyTxProof(tx.Hash(), detail)
+	assert.Equal(t, types.ErrHeightNotExist, err)
+	require.Nil(t, chain.procLightHeaders(headers, "peer"))
+
+	header, err := chain.verifyTxProof(tx.Hash(), detail)
+	require.Nil(t, err)
+	assert.Equal(t, block.Block.Hash(chain.client.GetConfig()), header.Hash)
+	_, err = chain.verifyTxProof([]byte("otherhash"), detail)
+	assert.Equal(t, types.ErrTxProofInvalid, err)
+	wrong := types.Clone(detail).(*types.TransactionDetail)
+	wrong.Height--
+	_, err = chain.verifyTxProof(tx.Hash(), wrong)
+	assert.Equal(t, types.ErrTxProofInvalid, err)
+	wrong = types.Clone(detail).(*types.TransactionDetail)
+	wrong.Index = header.TxCount
+	_, err = chain.verifyTxProof(tx.Hash(), wrong)
+	assert.Equal(t, types.ErrTxProofInvalid, err)
+
+	//没有可以提供证明的节点
+	_, err = chain.queryLightTx(tx.Hash())
+	assert.Equal(t, types.ErrTxNotExist, err)
+	//本地校验过的交易直接返回
+	require.Nil(t, chain.light.saveTx(tx.Hash(), detail))
+	local, err := chain.ProcQueryTxMsg(tx.Hash())
+	require.Nil(t, err)
+	assert.Equal(t, detail.Height, local.Height)
+	assert.Equal(t, tx.Hash(), local.Tx.Hash())
+}
@@ -0,0 +1,26 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	blockHeightGauge    = metrics.NewRegisteredGauge("blockchain/height", nil)                                                  // 当前主链高度
+	syncLagGauge        = metrics.NewRegisteredGauge("blockchain/synclag", nil)                                                 // 与peer最高高度的差距
+	reorgCounter        = metrics.NewRegisteredCounter("blockchain/reorg", nil)                                                 // 主链切换次数
+	reorgDepthHist      = metrics.NewRegisteredHistogram("blockchain/reorg/depth", nil, metrics.NewExpDecaySample(1028, 0.015)) // 主链切换回滚的区块数
+	reorgRefusedCounter = metrics.NewRegisteredCounter("blockchain/reorg/refused", nil)                                         // 超过最大回滚深度被拒绝的主链切换次数
+)
+
+// updateSyncLag 更新本节点高度与peer最高高度的差距
+func (chain *BlockChain) updateSyncLag() {
+	lag := chain.GetPeerMaxBlkHeight() - chain.GetBlockHeight()
+	if lag < 0 {
+		lag = 0
+	}
+	syncLagGauge.Update(lag)
+}
@@ -84,6 +84,14 @@ func (chain *BlockChain) ProcRecvMsg() {
 			go chain.processMsg(msg, reqnum, chain.listPush)
 		case types.EventGetPushLastNum:
 			go chain.processMsg(msg, reqnum, chain.getPushLastNum)
+		case types.EventManagePush:
+			go chain.processMsg(msg, reqnum, chain.managePush)
+		case types.EventAttachPush:
+			go chain.processMsg(msg, reqnum, chain.attachPush)
+		case types.EventGetReorgHistory:
+			go chain.processMsg(msg, reqnum, chain.getReorgHistory)
+		case types.EventGetCheckpoints:
+			go chain.processMsg(msg, reqnum, chain.getCheckpoints)
 		case types.EventGetLastBlockMainSequence:
 			go chain.processMsg(msg, reqnum, chain.GetLastBlockMainSequence)
 		case types.EventGetMainSeqByHash:
@@ -275,6 +283,18 @@ func (chain *BlockChain) broadcastAddBlock(msg *queue.Message) {
 	blockwithpid := msg.Data.(*types.BlockPid)
 
 	castheight := blockwithpid.Block.Height
+	//轻节点只校验并保存广播区块的区块头
+	if chain.light != nil {
+		header := blockwithpid.Block.GetHeader(chain.client.GetConfig())
+		header.Signature = blockwithpid.Block.Signature
+		err := chain.procLightHeaders(&types.Headers{Items: []*types.Header{header}}, blockwithpid.Pid)
+		if err != nil {
+			reply.IsOk = false
+			reply.Msg = []byte(err.Error())
+		}
+		msg.Reply(chain.client.NewMessage("", types.EventReply, &reply))
+		return
+	}
 	curheight := chain.GetBlockHeight()
 
 	futureMaximum := castheight > curheight+BackBlockNum
@@ -726,6 +746,46 @@ func (chain *BlockChain) subscribePush(msg *queue.Message) {
 	msg.Reply(chain.client.NewMessage("rpc", types.EventReplySubscribePush, reply))
 }
 
+func (chain *BlockChain) managePush(msg *queue.Message) {
+	reply := &types.ReplySubscribePush{
+		IsOk: true,
+		Msg:  "Succeed",
+	}
+	req := (msg.Data).(*types.ReqManagePush)
+	err := chain.procManagePush(req)
+	if err != nil {
+		reply.IsOk = false
+		reply.Msg = err.Error()
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventReplySubscribePush, reply))
+}
+
+func (chain *BlockChain) attachPush(msg *queue.Message) {
+	req := (msg.Data).(*types.ReqAttachPush)
+	subscribe, err := chain.procAttachPush(req)
+	if err != nil {
+		chainlog.Error("attachPush", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventAttachPush, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventAttachPush, subscribe))
+}
+
+func (chain *BlockChain) getReorgHistory(msg *queue.Message) {
+	req := (msg.Data).(*types.ReqReorgHistory)
+	records, err := chain.GetReorgHistory(req)
+	if err != nil {
+		chainlog.Error("getReorgHistory", "err", err.Error())
+		msg.Reply(chain.client.NewMessage("rpc", types.EventGetReorgHistory, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventGetReorgHistory, records))
+}
+
+func (chain *BlockChain) getCheckpoints(msg *queue.Message) {
+	msg.Reply(chain.client.NewMessage("rpc", types.EventGetCheckpoints, chain.GetCheckpoints()))
+}
+
 func (chain *BlockChain) highestBlockNum(msg *queue.Message) {
 	var replyBlockHeight types.ReplyBlockHeight
 	replyBlockHeight.Height = chain.GetPeerMaxBlkHeight()
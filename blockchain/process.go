@@ -65,6 +65,13 @@ func (chain *BlockChain) ProcessBlock(broadcast bool, block *types.BlockDetail,
 		return nil, false, false, types.ErrBlockExist
 	}
 
+	//与检查点冲突的区块不再处理，并将此block的源peer节点添加到故障peerlist中
+	if err := chain.checkCheckpoint(block.Block.Height, blockHash); err != nil {
+		chainlog.Error("ProcessBlock conflicts with checkpoint", "height", block.Block.Height, "blockHash", common.ToHex(blockHash), "pid", pid)
+		chain.RecordFaultPeer(pid, block.Block.Height, blockHash, err)
+		return nil, false, false, err
+	}
+
 	// 判断本区块是否已经存在孤儿链中
 	exists = chain.orphanPool.IsKnownOrphan(blockHash)
 	if exists {
@@ -249,6 +256,16 @@ func (chain *BlockChain) connectBestChain(node *blockNode, block *types.BlockDet
 	// 获取需要重组的block node
 	detachNodes, attachNodes := chain.getReorganizeNodes(node)
 
+	// 需要回滚的区块数超过限制时拒绝切换到竞争分叉，并将此分叉区块的来源节点记录为故障节点
+	if chain.maxReorgDepth > 0 && int64(detachNodes.Len()) > chain.maxReorgDepth {
+		chainlog.Error("connectBestChain reorg too deep", "depth", detachNodes.Len(), "maxReorgDepth", chain.maxReorgDepth,
+			"height", node.height, "hash", common.ToHex(node.hash), "pid", node.pid)
+		reorgRefusedCounter.Inc(1)
+		chain.index.DelNode(node.hash)
+		chain.RecordFaultPeer(node.pid, node.height, node.hash, types.ErrReorgTooDeep)
+		return nil, false, types.ErrReorgTooDeep
+	}
+
 	// Reorganize the chain.
 	err := chain.reorganizeChain(detachNodes, attachNodes)
 	if err != nil {
@@ -550,6 +567,13 @@ func (chain *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) er
 		}
 	}
 
+	// 切换之前保存主链切换记录，推送服务处理切换产生的区块序列时可以读取到此记录
+	record := chain.newReorgRecord(detachNodes, attachNodes)
+	if err := chain.blockStore.saveReorgRecord(record); err != nil {
+		chainlog.Error("reorganizeChain saveReorgRecord", "err", err)
+		return err
+	}
+
 	// Disconnect blocks from the main chain.
 	for i, e := 0, detachNodes.Front(); e != nil; i, e = i+1, e.Next() {
 		n := e.Value.(*blockNode)
@@ -558,6 +582,7 @@ func (chain *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) er
 		// Update the database and chain state.
 		err := chain.disconnectBlock(n, block, n.sequence)
 		if err != nil {
+			chain.abortReorgRecord(record, i > 0)
 			return err
 		}
 	}
@@ -570,10 +595,16 @@ func (chain *BlockChain) reorganizeChain(detachNodes, attachNodes *list.List) er
 		// Update the database and chain state.
 		_, err := chain.connectBlock(n, block)
 		if err != nil {
+			chain.abortReorgRecord(record, detachNodes.Len() > 0)
 			return err
 		}
 	}
 
+	chainlog.Info("reorganizeChain", "index", record.Index, "depth", record.Depth, "ancestorHeight", record.AncestorHeight,
+		"oldTip", common.ToHex(record.OldTipHash), "newTip", common.ToHex(record.NewTipHash))
+	reorgCounter.Inc(1)
+	reorgDepthHist.Update(int64(detachNodes.Len()))
+
 	// Log the point where the chain forked and old and new best chain
 	// heads.
 	if attachNodes.Front() != nil {
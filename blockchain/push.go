@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/types"
@@ -27,10 +28,33 @@ const (
 	maxPushSubscriber        = int(100)
 	subscribeStatusActive    = int32(1)
 	subscribeStatusNotActive = int32(2)
+	subscribeStatusPaused    = int32(3)
 	postFail2Sleep           = int32(60) //一次发送失败，sleep的次数
 	chanBufCap               = int(10)
 	encodeJSON               = "jrpc"
 	encodeGrpc               = "grpc"
+	encodeProto              = "proto"
+	pushStreamTimeout        = 60 * time.Second
+	//交易回执推送的执行结果过滤
+	pushExecResultAll  = int32(0)
+	pushExecResultOk   = int32(1)
+	pushExecResultFail = int32(2)
+)
+
+//推送订阅的管理操作
+const (
+	//PushManagePause 暂停推送
+	PushManagePause = int32(1)
+	//PushManageResume 恢复推送
+	PushManageResume = int32(2)
+	//PushManageDelete 删除订阅
+	PushManageDelete = int32(3)
+	//PushManageRewind 重置已推送的sequence, 从该sequence之后重新推送
+	PushManageRewind = int32(4)
+	//PushManageSetURL 修改推送的URL
+	PushManageSetURL = int32(5)
+	//PushManageAttach websocket或者SSE连接附加到订阅, 只用于ReqAttachPush的签名, 不能通过管理请求执行
+	PushManageAttach = int32(6)
 )
 
 // PushType ...
@@ -47,11 +71,13 @@ const (
 	PushTxResult
 	//PushEVMEvent push evem tx event
 	PushEVMEvent
+	//PushReorg push chain reorg records, 5为mempool推送的交易状态
+	PushReorg PushType = 6
 )
 
 //String format string
 func (p PushType) String() string {
-	str := [...]string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "NotSupported"}
+	str := [...]string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "PushTxStatus", "PushReorg", "NotSupported"}
 	if p < 0 || int(p) >= len(str) {
 		return "(unrecognized)"
 	}
@@ -65,6 +91,7 @@ type CommonStore interface {
 	SetSync(key, value []byte) error
 	Set(key, value []byte) error
 	GetKey(key []byte) ([]byte, error)
+	Delete(key []byte) error
 	PrefixCount(prefix []byte) int64
 	List(prefix []byte) ([][]byte, error)
 }
@@ -100,6 +127,7 @@ type pushNotify struct {
 	closechan      chan struct{}
 	status         int32
 	postFail2Sleep int32
+	done           chan struct{}
 }
 
 //Push ...
@@ -108,10 +136,14 @@ type Push struct {
 	sequenceStore  SequenceStore
 	tasks          map[string]*pushNotify
 	mu             sync.Mutex
+	manageMu       sync.Mutex
 	postService    PostService
 	cfg            *types.Chain33Config
 	postFail2Sleep int32
 	postwg         *sync.WaitGroup
+	client         queue.Client
+	//附加了websocket或者SSE连接的订阅
+	streams map[string]bool
 }
 
 //PushClient ...
@@ -150,6 +182,11 @@ func buildRPCData(data []byte, subscribe *types.PushSubscribeReq) (*types.PushDa
 		types.Decode(data, &evmlogs)
 		pushData.Value = &types.PushData_EvmLogs{EvmLogs: &evmlogs}
 		ty = types.EventPushEVM
+	case PushReorg:
+		var reorgs types.ReorgRecords
+		types.Decode(data, &reorgs)
+		pushData.Value = &types.PushData_Reorgs{Reorgs: &reorgs}
+		ty = types.EventPushReorg
 	default:
 		return nil, ty, errors.New("wrong pushType")
 
@@ -242,6 +279,44 @@ func (chain *BlockChain) procSubscribePush(subscribe *types.PushSubscribeReq) er
 	return chain.push.addSubscriber(subscribe)
 }
 
+//procManagePush 处理推送订阅的管理请求
+func (chain *BlockChain) procManagePush(req *types.ReqManagePush) error {
+	if !chain.enablePushSubscribe {
+		chainlog.Error("Push is not enabled for subscribed")
+		return types.ErrPushNotSupport
+	}
+
+	if !chain.isRecordBlockSequence {
+		chainlog.Error("procManagePush can be enable after the RecordBlockSequence is configured ")
+		return types.ErrRecordBlockSequence
+	}
+
+	if req == nil {
+		chainlog.Error("procManagePush para is null")
+		return types.ErrInvalidParam
+	}
+	return chain.push.manageSubscriber(req)
+}
+
+//procAttachPush websocket或者SSE连接附加到推送订阅或者断开
+func (chain *BlockChain) procAttachPush(req *types.ReqAttachPush) (*types.PushSubscribeReq, error) {
+	if !chain.enablePushSubscribe {
+		chainlog.Error("Push is not enabled for subscribed")
+		return nil, types.ErrPushNotSupport
+	}
+
+	if !chain.isRecordBlockSequence {
+		chainlog.Error("procAttachPush can be enable after the RecordBlockSequence is configured ")
+		return nil, types.ErrRecordBlockSequence
+	}
+
+	if req == nil {
+		chainlog.Error("procAttachPush para is null")
+		return nil, types.ErrInvalidParam
+	}
+	return chain.push.attachStream(req)
+}
+
 //ProcListPush 列出所有已经设置的推送订阅
 func (chain *BlockChain) ProcListPush() (*types.PushSubscribes, error) {
 	if !chain.isRecordBlockSequence {
@@ -314,6 +389,8 @@ func newpush(commonStore CommonStore, seqStore SequenceStore, qclient queue.Clie
 		cfg:            qclient.GetConfig(),
 		postFail2Sleep: postFail2Sleep,
 		postwg:         &sync.WaitGroup{},
+		client:         qclient,
+		streams:        make(map[string]bool),
 	}
 	service.init()
 
@@ -366,7 +443,7 @@ func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 		return types.ErrInvalidParam
 	}
 
-	if PushType(subscribe.Type) < PushBlock || PushType(subscribe.Type) > PushEVMEvent {
+	if (PushType(subscribe.Type) < PushBlock || PushType(subscribe.Type) > PushEVMEvent) && PushType(subscribe.Type) != PushReorg {
 		chainlog.Error("addSubscriber input type is error", "type", subscribe.Type)
 		return types.ErrInvalidParam
 	}
@@ -379,6 +456,27 @@ func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 		}
 	}
 
+	if filter := subscribe.GetTxFilter(); filter != nil {
+		if filter.ExecResult < pushExecResultAll || filter.ExecResult > pushExecResultFail {
+			chainlog.Error("addSubscriber input txFilter is error", "execResult", filter.ExecResult)
+			return types.ErrInvalidParam
+		}
+		//地址过滤条件统一格式后随订阅保存
+		filter.FromAddr = formatFilterAddrs(filter.FromAddr)
+		filter.ToAddr = formatFilterAddrs(filter.ToAddr)
+		filter.AnyAddr = formatFilterAddrs(filter.AnyAddr)
+	}
+
+	//设置owner的订阅可以通过owner签名的请求进行管理
+	if subscribe.Owner != "" {
+		owner, err := common.FromHex(subscribe.Owner)
+		if err != nil || len(owner) == 0 {
+			chainlog.Error("addSubscriber input owner is error", "owner", subscribe.Owner)
+			return types.ErrInvalidParam
+		}
+		subscribe.Owner = common.ToHex(owner)
+	}
+
 	//如果需要配置起始的块的信息，则为了保持一致性，三项缺一不可
 	if subscribe.LastBlockHash != "" || subscribe.LastSequence != 0 || subscribe.LastHeight != 0 {
 		if subscribe.LastBlockHash == "" || subscribe.LastSequence == 0 || subscribe.LastHeight == 0 {
@@ -392,6 +490,17 @@ func (push *Push) addSubscriber(subscribe *types.PushSubscribeReq) error {
 		if subscribeInDB.URL != subscribe.URL || subscribeInDB.Type != subscribe.Type {
 			return types.ErrNotAllowModifyPush
 		}
+		//过滤条件不同时不能直接恢复推送, 否则会按旧的过滤条件推送
+		if !sameTxFilter(subscribeInDB.GetTxFilter(), subscribe.GetTxFilter()) {
+			return types.ErrNotAllowModifyPush
+		}
+		if subscribe.Owner != "" && subscribeInDB.Owner != subscribe.Owner {
+			return types.ErrNotAllowModifyPush
+		}
+		//owner暂停的推送只能由owner恢复
+		if pushWithStatus, err := push.getSubscriber(subscribe.Name); err == nil && pushWithStatus.Status == subscribeStatusPaused {
+			return types.ErrPushPaused
+		}
 		//使用保存在数据库中的push配置，而不是最新的配置信息
 		if err := push.check2ResumePush(subscribeInDB); nil != err {
 			return err
@@ -447,6 +556,19 @@ func (push *Push) hasSubscriberExist(subscribe *types.PushSubscribeReq) (bool, *
 	return false, nil
 }
 
+func (push *Push) getSubscriber(name string) (*types.PushWithStatus, error) {
+	value, err := push.store.GetKey(calcPushKey(name))
+	if err != nil {
+		return nil, err
+	}
+	var pushWithStatus types.PushWithStatus
+	err = types.Decode(value, &pushWithStatus)
+	if err != nil {
+		return nil, err
+	}
+	return &pushWithStatus, nil
+}
+
 func (push *Push) subscriberCount() int64 {
 	return push.store.PrefixCount(pushPrefix)
 }
@@ -459,8 +581,8 @@ func (push *Push) persisAndStart(subscribe *types.PushSubscribeReq) error {
 		return errors.New(types.ErrInvalidParam.Error() + ": Name or URL exceeds limit（len(Name)<128),len(URL)<1024")
 	}
 
-	if subscribe.GetURL() == "" && subscribe.GetEncode() != "grpc" {
-		//非grpc通信必须要求配置url
+	if subscribe.GetURL() == "" && !allowEmptyURL(subscribe.GetEncode()) {
+		//非grpc通信必须要求配置url, 明确指定jrpc或者proto编码的订阅可以只通过websocket或者SSE推送
 		storeLog.Info("persisAndStart", "url empty", subscribe.GetURL(), "encode:", subscribe.GetEncode())
 		return errors.New(types.ErrInvalidParam.Error() + ": URL must be configure")
 	}
@@ -563,6 +685,213 @@ func (push *Push) addTask(subscribe *types.PushSubscribeReq) {
 	push.runTask(push.tasks[keyStr])
 }
 
+//stopTask 停止推送任务, 并等待任务退出, 防止退出前更新已推送的sequence
+func (push *Push) stopTask(name string) {
+	keyStr := string(calcPushKey(name))
+	push.mu.Lock()
+	notify := push.tasks[keyStr]
+	if notify == nil {
+		push.mu.Unlock()
+		return
+	}
+	delete(push.tasks, keyStr)
+	close(notify.closechan)
+	done := notify.done
+	push.mu.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+func allowEmptyURL(encode string) bool {
+	return encode == encodeGrpc || encode == encodeJSON || encode == encodeProto
+}
+
+//AttachPushSignData 连接附加到推送订阅的签名数据, 和管理请求使用相同的签名方式和nonce
+func AttachPushSignData(req *types.ReqAttachPush) []byte {
+	return ManagePushSignData(attachManageReq(req))
+}
+
+func attachManageReq(req *types.ReqAttachPush) *types.ReqManagePush {
+	return &types.ReqManagePush{Name: req.GetName(), Op: PushManageAttach, Nonce: req.GetNonce(), Signature: req.GetSignature()}
+}
+
+//attachStream 附加连接期间订阅的数据通过rpc模块推送给连接, 因连续推送失败而停止的推送同时被恢复,
+//附加连接需要订阅owner的签名, 断开连接由rpc模块在连接关闭时发起
+func (push *Push) attachStream(req *types.ReqAttachPush) (*types.PushSubscribeReq, error) {
+	push.manageMu.Lock()
+	defer push.manageMu.Unlock()
+	pushWithStatus, err := push.getSubscriber(req.GetName())
+	if err != nil {
+		return nil, types.ErrPushNotSubscribed
+	}
+	subscribe := pushWithStatus.Push
+	if !req.Attach {
+		push.setStream(subscribe.Name, false)
+		chainlog.Info("attachStream detached", "name", subscribe.Name)
+		return subscribe, nil
+	}
+	//grpc订阅通过SubEvent推送
+	if subscribe.Encode == encodeGrpc {
+		return nil, types.ErrInvalidParam
+	}
+	if err = push.checkManageSign(pushWithStatus, attachManageReq(req)); err != nil {
+		chainlog.Error("attachStream", "name", subscribe.Name, "err", err)
+		return nil, err
+	}
+	if pushWithStatus.Status == subscribeStatusPaused {
+		return nil, types.ErrPushPaused
+	}
+	//签名的附加请求不能重放
+	pushWithStatus.ManageNonce = req.Nonce
+	if err = push.store.SetSync(calcPushKey(subscribe.Name), types.Encode(pushWithStatus)); err != nil {
+		return nil, err
+	}
+	push.setStream(subscribe.Name, true)
+	if err = push.check2ResumePush(subscribe); err == nil {
+		err = push.setActive(subscribe)
+	}
+	if err != nil {
+		push.setStream(subscribe.Name, false)
+		return nil, err
+	}
+	chainlog.Info("attachStream attached", "name", subscribe.Name)
+	return subscribe, nil
+}
+
+func (push *Push) setStream(name string, attached bool) {
+	push.mu.Lock()
+	defer push.mu.Unlock()
+	if attached {
+		push.streams[name] = true
+		return
+	}
+	delete(push.streams, name)
+}
+
+func (push *Push) hasStream(name string) bool {
+	push.mu.Lock()
+	defer push.mu.Unlock()
+	return push.streams[name]
+}
+
+//post 附加了websocket或者SSE连接的订阅通过rpc模块推送, 等待客户端确认
+func (push *Push) post(subscribe *types.PushSubscribeReq, postdata []byte, seq int64) error {
+	if !push.hasStream(subscribe.Name) {
+		if subscribe.URL == "" && subscribe.Encode != encodeGrpc {
+			return types.ErrPushNotAttached
+		}
+		return push.postService.PostData(subscribe, postdata, seq)
+	}
+	data := &types.PushStreamData{
+		Name:   subscribe.Name,
+		Type:   subscribe.Type,
+		Encode: subscribe.Encode,
+		Seq:    seq,
+		Data:   postdata,
+	}
+	msg := push.client.NewMessage("rpc", types.EventPushStream, data)
+	err := push.client.SendTimeout(msg, true, time.Second)
+	if err != nil {
+		return err
+	}
+	resp, err := push.client.WaitTimeout(msg, pushStreamTimeout)
+	if err != nil {
+		return err
+	}
+	if reply, ok := resp.GetData().(*types.Reply); !ok || !reply.GetIsOk() {
+		return errors.New(string(reply.GetMsg()))
+	}
+	return nil
+}
+
+//ManagePushSignData 推送订阅管理请求的签名数据, 不包含签名本身
+func ManagePushSignData(req *types.ReqManagePush) []byte {
+	data := types.Clone(req).(*types.ReqManagePush)
+	data.Signature = nil
+	return types.Encode(data)
+}
+
+func (push *Push) checkManageSign(pushWithStatus *types.PushWithStatus, req *types.ReqManagePush) error {
+	owner := pushWithStatus.GetPush().GetOwner()
+	if owner == "" {
+		return types.ErrPushNoOwner
+	}
+	sign := req.GetSignature()
+	if sign == nil || common.ToHex(sign.Pubkey) != owner {
+		return types.ErrPushNotOwner
+	}
+	if req.Nonce <= pushWithStatus.ManageNonce {
+		return types.ErrPushManageNonce
+	}
+	if !types.CheckSign(ManagePushSignData(req), "", sign, push.sequenceStore.LastHeader().GetHeight()) {
+		return types.ErrSign
+	}
+	return nil
+}
+
+//manageSubscriber 处理owner签名的管理请求, 没有设置owner的订阅不能被管理
+func (push *Push) manageSubscriber(req *types.ReqManagePush) error {
+	push.manageMu.Lock()
+	defer push.manageMu.Unlock()
+	pushWithStatus, err := push.getSubscriber(req.GetName())
+	if err != nil {
+		return types.ErrPushNotSubscribed
+	}
+	if err = push.checkManageSign(pushWithStatus, req); err != nil {
+		chainlog.Error("manageSubscriber", "name", req.GetName(), "err", err)
+		return err
+	}
+
+	subscribe := pushWithStatus.Push
+	switch req.Op {
+	case PushManagePause:
+		push.stopTask(subscribe.Name)
+		pushWithStatus.Status = subscribeStatusPaused
+	case PushManageResume:
+		push.stopTask(subscribe.Name)
+		pushWithStatus.Status = subscribeStatusActive
+	case PushManageDelete:
+		push.stopTask(subscribe.Name)
+		push.setStream(subscribe.Name, false)
+		if err = push.store.Delete(calcLastPushSeqNumKey(subscribe.Name)); err != nil {
+			return err
+		}
+		chainlog.Info("manageSubscriber delete push", "name", subscribe.Name)
+		return push.store.Delete(calcPushKey(subscribe.Name))
+	case PushManageRewind:
+		last, err := push.sequenceStore.LoadBlockLastSequence()
+		if err != nil {
+			return err
+		}
+		if req.LastSequence <= 0 || req.LastSequence > last {
+			return types.ErrInvalidParam
+		}
+		push.stopTask(subscribe.Name)
+		if err = push.setLastPushSeq(subscribe.Name, req.LastSequence); err != nil {
+			return err
+		}
+	case PushManageSetURL:
+		if len(req.URL) > 1024 || (req.URL == "" && !allowEmptyURL(subscribe.Encode)) {
+			return types.ErrInvalidParam
+		}
+		push.stopTask(subscribe.Name)
+		subscribe.URL = req.URL
+	default:
+		return types.ErrInvalidParam
+	}
+
+	pushWithStatus.ManageNonce = req.Nonce
+	if err = push.store.SetSync(calcPushKey(subscribe.Name), types.Encode(pushWithStatus)); err != nil {
+		return err
+	}
+	chainlog.Info("manageSubscriber", "name", subscribe.Name, "op", req.Op, "status", pushWithStatus.Status)
+	if pushWithStatus.Status == subscribeStatusActive {
+		push.addTask(subscribe)
+	}
+	return nil
+}
+
 func trigeRun(run chan struct{}, sleep time.Duration, name string) {
 	chainlog.Info("trigeRun", name, "name", "sleep", sleep, "run len", len(run))
 	if sleep > 0 {
@@ -576,8 +905,11 @@ func trigeRun(run chan struct{}, sleep time.Duration, name string) {
 func (push *Push) runTask(input *pushNotify) {
 	//触发goroutine运行
 	push.updateLastSeq(input.subscribe.Name)
+	done := make(chan struct{})
+	input.done = done
 
 	go func(in *pushNotify) {
+		defer close(done)
 		var lastesBlockSeq int64
 		var continueFailCount int32
 		var err error
@@ -589,7 +921,7 @@ func (push *Push) runTask(input *pushNotify) {
 
 		runChan := make(chan struct{}, 10)
 		pushMaxSeq := pushBlockMaxSeq
-		if PushType(subscribe.Type) == PushTxReceipt {
+		if PushType(subscribe.Type) == PushTxReceipt || PushType(subscribe.Type) == PushReorg {
 			pushMaxSeq = pushTxReceiptMaxSeq
 		}
 
@@ -646,7 +978,7 @@ func (push *Push) runTask(input *pushNotify) {
 				}
 
 				if data != nil {
-					err = push.postService.PostData(subscribe, data, updateSeq)
+					err = push.post(subscribe, data, updateSeq)
 					if err != nil {
 						continueFailCount++
 						chainlog.Error("postdata failed", "err", err, "lastProcessedseq", lastProcessedseq,
@@ -655,17 +987,16 @@ func (push *Push) runTask(input *pushNotify) {
 							atomic.StoreInt32(&in.status, notRunning)
 							chainlog.Error("postdata failed exceed 3 times", "Name", subscribe.Name, "in.status", atomic.LoadInt32(&in.status))
 
-							pushWithStatus := &types.PushWithStatus{
-								Push:   subscribe,
-								Status: subscribeStatusNotActive,
-							}
-
 							key := calcPushKey(subscribe.Name)
 							push.mu.Lock()
 							delete(push.tasks, string(key))
 							push.mu.Unlock()
 							//多次Post失败后，把这个subscriber设置为NoActive状态，停止这个task的运行
-							_ = push.store.SetSync(key, types.Encode(pushWithStatus))
+							//只修改状态, 保留订阅者和管理nonce等其他信息
+							if pushWithStatus, err := push.getSubscriber(subscribe.Name); err == nil {
+								pushWithStatus.Status = subscribeStatusNotActive
+								_ = push.store.SetSync(key, types.Encode(pushWithStatus))
+							}
 							push.postwg.Done()
 							return
 						}
@@ -725,6 +1056,8 @@ func (push *Push) getPushData(subscribe *types.PushSubscribeReq, startSeq int64,
 		return push.getTxResults(subscribe.Encode, startSeq, seqCount)
 	case PushEVMEvent:
 		return push.getEVMEvent(subscribe, startSeq, seqCount, maxSize)
+	case PushReorg:
+		return push.getReorgs(subscribe.Encode, startSeq, seqCount)
 	default:
 		return nil, 0, errors.New("wrong subscribe type")
 	}
@@ -847,7 +1180,7 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 		txReceiptsPerBlk := &types.TxReceipts4SubscribePerBlk{}
 		chainlog.Info("getTxReceipts", "height:", detail.Block.Height, "tx numbers:", len(detail.Block.Txs), "Receipts numbers:", len(detail.Receipts))
 		for txIndex, tx := range detail.Block.Txs {
-			if subscribe.Contract[string(tx.Execer)] {
+			if subscribe.Contract[string(tx.Execer)] && matchTxFilter(subscribe.TxFilter, tx, detail.Receipts[txIndex]) {
 				chainlog.Info("getTxReceipts", "txIndex:", txIndex)
 				txReceiptsPerBlk.Tx = append(txReceiptsPerBlk.Tx, tx)
 				txReceiptsPerBlk.ReceiptData = append(txReceiptsPerBlk.ReceiptData, detail.Receipts[txIndex])
@@ -894,6 +1227,41 @@ func (push *Push) getTxReceipts(subscribe *types.PushSubscribeReq, startSeq int6
 	return postdata, updateSeq, nil
 }
 
+//getReorgs 获取区块序列区间内发生的主链切换记录, 没有切换时不推送数据只更新已处理的sequence
+func (push *Push) getReorgs(encode string, startSeq int64, seqCount int) ([]byte, int64, error) {
+	reorgs := &types.ReorgRecords{}
+	for seq := startSeq; seq < startSeq+int64(seqCount); seq++ {
+		value, err := push.store.GetKey(calcReorgSeqKey(seq))
+		if err == dbm.ErrNotFoundInDb {
+			continue
+		}
+		if err != nil {
+			return nil, -1, err
+		}
+		var record types.ReorgRecord
+		if err = types.Decode(value, &record); err != nil {
+			return nil, -1, err
+		}
+		reorgs.Records = append(reorgs.Records, &record)
+	}
+	updateSeq := startSeq + int64(seqCount) - 1
+	if len(reorgs.Records) == 0 {
+		return nil, updateSeq, nil
+	}
+
+	var postdata []byte
+	var err error
+	if encode == encodeJSON {
+		postdata, err = types.PBToJSON(reorgs)
+		if err != nil {
+			return nil, -1, err
+		}
+	} else {
+		postdata = types.Encode(reorgs)
+	}
+	return postdata, updateSeq, nil
+}
+
 func (push *Push) getBlockDataBySeq(seq int64) (*types.BlockSeq, int, error) {
 	seqdata, err := push.sequenceStore.GetBlockSequence(seq)
 	if err != nil {
@@ -1038,3 +1406,54 @@ func (push *Push) getLastPushSeq(subscribe *types.PushSubscribeReq) int64 {
 func (push *Push) setLastPushSeq(name string, num int64) error {
 	return push.store.SetSync(calcLastPushSeqNumKey(name), types.Encode(&types.Int64{Data: num}))
 }
+
+//formatFilterAddrs eth地址有大小写区分, 过滤条件中的地址统一格式
+func formatFilterAddrs(addrs map[string]bool) map[string]bool {
+	if len(addrs) == 0 {
+		return addrs
+	}
+	formatted := make(map[string]bool, len(addrs))
+	for addr, ok := range addrs {
+		if ok {
+			formatted[string(address.FormatAddrKey(addr))] = true
+		}
+	}
+	return formatted
+}
+
+//sameTxFilter 比较两个过滤条件是否相同, 没有设置过滤条件等同于空的过滤条件
+func sameTxFilter(a, b *types.PushTxFilter) bool {
+	if a == nil {
+		a = &types.PushTxFilter{}
+	}
+	if b == nil {
+		b = &types.PushTxFilter{}
+	}
+	return bytes.Equal(types.Encode(a), types.Encode(b))
+}
+
+//matchTxFilter 交易是否满足回执推送的过滤条件
+func matchTxFilter(filter *types.PushTxFilter, tx *types.Transaction, receipt *types.ReceiptData) bool {
+	if filter == nil {
+		return true
+	}
+	switch filter.ExecResult {
+	case pushExecResultOk:
+		if receipt.GetTy() != types.ExecOk {
+			return false
+		}
+	case pushExecResultFail:
+		if receipt.GetTy() == types.ExecOk {
+			return false
+		}
+	}
+	if len(filter.ActionName) > 0 && !filter.ActionName[tx.ActionName()] {
+		return false
+	}
+	if len(filter.FromAddr) == 0 && len(filter.ToAddr) == 0 && len(filter.AnyAddr) == 0 {
+		return true
+	}
+	from := string(address.FormatAddrKey(tx.From()))
+	to := string(address.FormatAddrKey(tx.GetRealToAddr()))
+	return filter.FromAddr[from] || filter.ToAddr[to] || filter.AnyAddr[from] || filter.AnyAddr[to]
+}
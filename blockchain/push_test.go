@@ -455,6 +455,229 @@ func Test_PostTxReceipt(t *testing.T) {
 	require.Equal(t, atomic.LoadInt32(&pushNotify.postFail2Sleep), int32(0))
 }
 
+func Test_addSubscriber_InvalidTxFilter(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	subscribe := new(types.PushSubscribeReq)
+	subscribe.Name = "push-test"
+	subscribe.URL = "http://localhost"
+	subscribe.Type = int32(PushTxReceipt)
+	subscribe.Contract = map[string]bool{"coins": true}
+	subscribe.TxFilter = &types.PushTxFilter{ExecResult: 3}
+	err := chain.push.addSubscriber(subscribe)
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	subscribe.TxFilter = &types.PushTxFilter{ExecResult: pushExecResultOk, AnyAddr: map[string]bool{"0xDE79A84DD3A16BB91044167075DE17A1CA4B1D6B": true}}
+	err = chain.push.addSubscriber(subscribe)
+	require.Nil(t, err)
+	//地址过滤条件统一格式后随订阅保存
+	subInfo, err := chain.push.store.GetKey(calcPushKey(subscribe.Name))
+	require.Nil(t, err)
+	var pushWithStatus types.PushWithStatus
+	require.Nil(t, types.Decode(subInfo, &pushWithStatus))
+	require.True(t, pushWithStatus.Push.TxFilter.AnyAddr["0xde79a84dd3a16bb91044167075de17a1ca4b1d6b"])
+	require.Equal(t, pushExecResultOk, pushWithStatus.Push.TxFilter.ExecResult)
+
+	//相同的过滤条件可以重复订阅, 修改过滤条件则不允许
+	subscribe.TxFilter = &types.PushTxFilter{ExecResult: pushExecResultOk, AnyAddr: map[string]bool{"0xDE79A84DD3A16BB91044167075DE17A1CA4B1D6B": true}}
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	subscribe.TxFilter = &types.PushTxFilter{ExecResult: pushExecResultFail}
+	require.Equal(t, types.ErrNotAllowModifyPush, chain.push.addSubscriber(subscribe))
+	subscribe.TxFilter = nil
+	require.Equal(t, types.ErrNotAllowModifyPush, chain.push.addSubscriber(subscribe))
+}
+
+func Test_matchTxFilter(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	txs := util.GenCoinsTxs(chain.client.GetConfig(), mock33.GetGenesisKey(), 1)
+	tx := txs[0]
+	from, to := tx.From(), tx.GetRealToAddr()
+	ok := &types.ReceiptData{Ty: types.ExecOk}
+	fail := &types.ReceiptData{Ty: types.ExecPack}
+
+	require.True(t, matchTxFilter(nil, tx, ok))
+	require.True(t, matchTxFilter(&types.PushTxFilter{}, tx, fail))
+	require.True(t, matchTxFilter(&types.PushTxFilter{ExecResult: pushExecResultOk}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{ExecResult: pushExecResultOk}, tx, fail))
+	require.True(t, matchTxFilter(&types.PushTxFilter{ExecResult: pushExecResultFail}, tx, fail))
+	require.False(t, matchTxFilter(&types.PushTxFilter{ExecResult: pushExecResultFail}, tx, ok))
+
+	require.True(t, matchTxFilter(&types.PushTxFilter{FromAddr: map[string]bool{from: true}}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{FromAddr: map[string]bool{to: true}}, tx, ok))
+	require.True(t, matchTxFilter(&types.PushTxFilter{ToAddr: map[string]bool{to: true}}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{ToAddr: map[string]bool{from: true}}, tx, ok))
+	require.True(t, matchTxFilter(&types.PushTxFilter{AnyAddr: map[string]bool{to: true}}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{AnyAddr: map[string]bool{"1MbEuwsXqTHfHW6UjHWMyUEEQRatrUhmdC": true}}, tx, ok))
+
+	require.True(t, matchTxFilter(&types.PushTxFilter{ActionName: map[string]bool{"transfer": true}}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{ActionName: map[string]bool{"withdraw": true}}, tx, ok))
+	require.False(t, matchTxFilter(&types.PushTxFilter{ActionName: map[string]bool{"transfer": true}, FromAddr: map[string]bool{to: true}}, tx, ok))
+}
+
+func signManagePush(priv crypto.PrivKey, req *types.ReqManagePush) *types.ReqManagePush {
+	req.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(ManagePushSignData(req)).Bytes(),
+	}
+	return req
+}
+
+func signAttachPush(priv crypto.PrivKey, req *types.ReqAttachPush) *types.ReqAttachPush {
+	req.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(AttachPushSignData(req)).Bytes(),
+	}
+	return req
+}
+
+func Test_ManagePush(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	ps := &bcMocks.PostService{}
+	ps.On("PostData", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	chain.push.postService = ps
+	createBlocks(t, mock33, chain, 2)
+	priv := mock33.GetGenesisKey()
+	_, other := util.Genaddress()
+
+	//没有设置owner的订阅不能被管理
+	subscribe := &types.PushSubscribeReq{Name: "push-noowner", URL: "http://localhost"}
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	err := chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManagePause, Nonce: 1}))
+	require.Equal(t, types.ErrPushNoOwner, err)
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: "push-notexist", Op: PushManagePause, Nonce: 1}))
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+
+	subscribe = &types.PushSubscribeReq{Name: "push-owner", URL: "http://localhost", Owner: "0xzz"}
+	require.Equal(t, types.ErrInvalidParam, chain.push.addSubscriber(subscribe))
+	subscribe.Owner = common.ToHex(priv.PubKey().Bytes())
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	keyStr := string(calcPushKey(subscribe.Name))
+	hasTask := func() bool {
+		chain.push.mu.Lock()
+		defer chain.push.mu.Unlock()
+		return chain.push.tasks[keyStr] != nil
+	}
+	require.True(t, hasTask())
+
+	//非owner签名或者签名错误
+	err = chain.procManagePush(signManagePush(other, &types.ReqManagePush{Name: subscribe.Name, Op: PushManagePause, Nonce: 1}))
+	require.Equal(t, types.ErrPushNotOwner, err)
+	req := signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManagePause, Nonce: 1})
+	req.Nonce = 2
+	require.Equal(t, types.ErrSign, chain.procManagePush(req))
+
+	//暂停后不能通过重新注册恢复, 请求不能重放
+	req = signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManagePause, Nonce: 1})
+	require.Nil(t, chain.procManagePush(req))
+	require.False(t, hasTask())
+	pushWithStatus, err := chain.push.getSubscriber(subscribe.Name)
+	require.Nil(t, err)
+	require.Equal(t, subscribeStatusPaused, pushWithStatus.Status)
+	require.Equal(t, types.ErrPushPaused, chain.push.addSubscriber(&types.PushSubscribeReq{Name: subscribe.Name, URL: subscribe.URL}))
+	require.Equal(t, types.ErrPushManageNonce, chain.procManagePush(req))
+
+	//暂停状态下重置推送的sequence和URL
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManageRewind, LastSequence: 100, Nonce: 2}))
+	require.Equal(t, types.ErrInvalidParam, err)
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManageRewind, LastSequence: 1, Nonce: 3}))
+	require.Nil(t, err)
+	seq, err := chain.ProcGetLastPushSeq(subscribe.Name)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), seq)
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManageSetURL, URL: "http://localhost:8801", Nonce: 4}))
+	require.Nil(t, err)
+	require.False(t, hasTask())
+	pushWithStatus, err = chain.push.getSubscriber(subscribe.Name)
+	require.Nil(t, err)
+	require.Equal(t, "http://localhost:8801", pushWithStatus.Push.URL)
+	require.Equal(t, int64(4), pushWithStatus.ManageNonce)
+
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManageResume, Nonce: 5}))
+	require.Nil(t, err)
+	require.True(t, hasTask())
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: 10, Nonce: 6}))
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	//删除后可以使用相同的名字重新注册
+	err = chain.procManagePush(signManagePush(priv, &types.ReqManagePush{Name: subscribe.Name, Op: PushManageDelete, Nonce: 7}))
+	require.Nil(t, err)
+	require.False(t, hasTask())
+	_, err = chain.push.getSubscriber(subscribe.Name)
+	require.NotNil(t, err)
+	_, err = chain.ProcGetLastPushSeq(subscribe.Name)
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+	require.Nil(t, chain.push.addSubscriber(&types.PushSubscribeReq{Name: subscribe.Name, URL: "http://localhost:8802"}))
+}
+
+func Test_PushStream(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+	priv := mock33.GetGenesisKey()
+	_, other := util.Genaddress()
+	subscribe := &types.PushSubscribeReq{Name: "push-stream", Encode: "jrpc", Type: int32(PushBlockHeader), Owner: common.ToHex(priv.PubKey().Bytes())}
+	require.Nil(t, chain.push.addSubscriber(subscribe))
+	//没有配置URL的订阅只能通过附加的连接推送
+	require.Equal(t, types.ErrPushNotAttached, chain.push.post(subscribe, []byte("data"), 1))
+
+	_, err := chain.procAttachPush(&types.ReqAttachPush{Name: "push-notexist", Attach: true})
+	require.Equal(t, types.ErrPushNotSubscribed, err)
+	require.Nil(t, chain.push.addSubscriber(&types.PushSubscribeReq{Name: "push-grpc", Encode: "grpc"}))
+	_, err = chain.procAttachPush(&types.ReqAttachPush{Name: "push-grpc", Attach: true})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	//模拟rpc模块确认推送的数据
+	cli := mock33.q.Client()
+	cli.Sub("rpc")
+	received := make(chan *types.PushStreamData, 16)
+	go func() {
+		for msg := range cli.Recv() {
+			if data, ok := msg.GetData().(*types.PushStreamData); ok {
+				received <- data
+				msg.Reply(cli.NewMessage("blockchain", types.EventPushStream, &types.Reply{IsOk: true}))
+			}
+		}
+	}()
+	//附加连接需要owner签名, 签名的请求不能重放
+	_, err = chain.procAttachPush(&types.ReqAttachPush{Name: subscribe.Name, Attach: true})
+	require.Equal(t, types.ErrPushNotOwner, err)
+	_, err = chain.procAttachPush(signAttachPush(other, &types.ReqAttachPush{Name: subscribe.Name, Attach: true, Nonce: 1}))
+	require.Equal(t, types.ErrPushNotOwner, err)
+	req := signAttachPush(priv, &types.ReqAttachPush{Name: subscribe.Name, Attach: true, Nonce: 1})
+	reply, err := chain.procAttachPush(req)
+	require.Nil(t, err)
+	_, err = chain.procAttachPush(req)
+	require.Equal(t, types.ErrPushManageNonce, err)
+	//附加请求的签名不能作为管理请求使用
+	manage := &types.ReqManagePush{Name: subscribe.Name, Op: PushManagePause, Nonce: 2, Signature: signAttachPush(priv, &types.ReqAttachPush{Name: subscribe.Name, Attach: true, Nonce: 2}).Signature}
+	require.Equal(t, types.ErrSign, chain.procManagePush(manage))
+	require.Equal(t, subscribe.Name, reply.Name)
+	require.True(t, chain.push.hasStream(subscribe.Name))
+
+	createBlocks(t, mock33, chain, 3)
+	select {
+	case data := <-received:
+		require.Equal(t, subscribe.Name, data.Name)
+		require.Equal(t, "jrpc", data.Encode)
+		var headers types.HeaderSeqs
+		require.Nil(t, types.JSONToPB(data.Data, &headers))
+		require.Equal(t, data.Seq, headers.Seqs[len(headers.Seqs)-1].Num)
+		require.Eventually(t, func() bool {
+			seq, _ := chain.ProcGetLastPushSeq(subscribe.Name)
+			return seq >= data.Seq
+		}, 5*time.Second, 100*time.Millisecond)
+	case <-time.After(10 * time.Second):
+		t.Fatal("wait push stream data timeout")
+	}
+
+	_, err = chain.procAttachPush(&types.ReqAttachPush{Name: subscribe.Name})
+	require.Nil(t, err)
+	require.False(t, chain.push.hasStream(subscribe.Name))
+}
+
 func Test_PostEVMEvent_Subscribe(t *testing.T) {
 	chain, mock33 := createBlockChain(t)
 	defer mock33.Close()
@@ -1112,6 +1335,11 @@ func Test_ReactivePush(t *testing.T) {
 	err := chain.push.addSubscriber(subscribe)
 	time.Sleep(2 * time.Second)
 	require.Equal(t, err, nil)
+	//模拟已经执行过管理操作的订阅, 推送失败之后不能丢失管理nonce
+	stored, err := chain.push.getSubscriber(subscribe.Name)
+	require.Nil(t, err)
+	stored.ManageNonce = 7
+	require.Nil(t, chain.push.store.SetSync(calcPushKey(subscribe.Name), types.Encode(stored)))
 	createBlocks(t, mock33, chain, 10)
 	keyStr := string(calcPushKey(subscribe.Name))
 	pushNotify := chain.push.tasks[keyStr]
@@ -1142,6 +1370,7 @@ func Test_ReactivePush(t *testing.T) {
 	var pushWithStatus types.PushWithStatus
 	_ = types.Decode(value, &pushWithStatus)
 	assert.Equal(t, pushWithStatus.Status, subscribeStatusNotActive)
+	assert.Equal(t, int64(7), pushWithStatus.ManageNonce)
 
 	//重新激活
 	chain.push.postService = ps
@@ -1373,6 +1602,8 @@ func newWalletRealize(qAPI client.QueueProtocolAPI) {
 }
 
 type mockP2P struct {
+	//提供状态快照数据的节点, 只有pid为snapshotPeerPid的请求才会返回数据
+	snapshotPeer *Chain33Mock
 }
 
 //SetQueueClient :
@@ -1387,6 +1618,8 @@ func (m *mockP2P) SetQueueClient(client queue.Client) {
 			case types.EventGetNetInfo:
 				msg.Reply(client.NewMessage(p2pKey, types.EventPeerList, &types.NodeNetInfo{}))
 			case types.EventTxBroadcast, types.EventBlockBroadcast:
+			case types.EventFetchSnapshotHeaders, types.EventFetchSnapshotBlock, types.EventFetchStateSnapshot:
+				m.serveSnapshot(client, msg)
 			default:
 				msg.ReplyErr("p2p->Do not support "+types.GetEventName(int(msg.Ty)), types.ErrNotSupport)
 			}
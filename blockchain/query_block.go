@@ -165,6 +165,12 @@ func (chain *BlockChain) ProcGetHeadersMsg(requestblock *types.ReqBlocks) (resph
 
 //ProcGetLastHeaderMsg 获取最新区块头信息
 func (chain *BlockChain) ProcGetLastHeaderMsg() (*types.Header, error) {
+	if chain.light != nil {
+		if head := chain.light.lastHeader(); head != nil {
+			return head, nil
+		}
+		return nil, types.ErrBlockNotFound
+	}
 	//首先从缓存中获取最新的blockheader
 	head := chain.blockStore.LastHeader()
 	if head == nil {
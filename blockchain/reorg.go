@@ -0,0 +1,146 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"container/list"
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+//一次最多获取的主链切换记录数
+const maxReorgHistoryCount = 100
+
+//存储主链切换记录 index--->record
+func calcReorgRecordKey(index int64) []byte {
+	return append(reorgRecordPrefix, []byte(fmt.Sprintf("%012d", index))...)
+}
+
+//存储主链切换记录 sequence--->record, 用于推送服务按区块序列查找
+func calcReorgSeqKey(sequence int64) []byte {
+	return append(reorgSeqPrefix, []byte(fmt.Sprintf("%012d", sequence))...)
+}
+
+//getLastReorgIndex 获取最新主链切换记录的index, 没有记录时返回0
+func (bs *BlockStore) getLastReorgIndex() int64 {
+	values := dbm.NewListHelper(bs.db).IteratorScanFromLast(reorgRecordPrefix, 1, dbm.ListDESC)
+	if len(values) == 0 {
+		return 0
+	}
+	var record types.ReorgRecord
+	if err := types.Decode(values[0], &record); err != nil {
+		storeLog.Error("getLastReorgIndex", "decode err", err)
+		return 0
+	}
+	return record.Index
+}
+
+//saveReorgRecord 分配index并保存主链切换记录
+func (bs *BlockStore) saveReorgRecord(record *types.ReorgRecord) error {
+	record.Index = bs.getLastReorgIndex() + 1
+	return bs.updateReorgRecord(record)
+}
+
+//updateReorgRecord 按照record的index更新已保存的主链切换记录
+func (bs *BlockStore) updateReorgRecord(record *types.ReorgRecord) error {
+	value := types.Encode(record)
+	batch := bs.db.NewBatch(true)
+	batch.Set(calcReorgRecordKey(record.Index), value)
+	if record.Sequence > 0 {
+		batch.Set(calcReorgSeqKey(record.Sequence), value)
+	}
+	return batch.Write()
+}
+
+//delReorgRecord 主链切换失败时删除切换前保存的记录
+func (bs *BlockStore) delReorgRecord(record *types.ReorgRecord) {
+	batch := bs.db.NewBatch(true)
+	batch.Delete(calcReorgRecordKey(record.Index))
+	if record.Sequence > 0 {
+		batch.Delete(calcReorgSeqKey(record.Sequence))
+	}
+	if err := batch.Write(); err != nil {
+		storeLog.Error("delReorgRecord", "index", record.Index, "err", err)
+	}
+}
+
+//abortReorgRecord 主链切换失败时, 还没有回滚区块则删除切换记录,
+//否则保留记录并标记为未完成, 避免推送服务读取到没有对应记录的回滚区块序列
+func (chain *BlockChain) abortReorgRecord(record *types.ReorgRecord, detached bool) {
+	if !detached {
+		chain.blockStore.delReorgRecord(record)
+		return
+	}
+	tip := chain.bestChain.Tip()
+	record.Incomplete = true
+	record.NewTipHash = tip.hash
+	record.NewTipHeight = tip.height
+	if err := chain.blockStore.updateReorgRecord(record); err != nil {
+		chainlog.Error("abortReorgRecord", "index", record.Index, "err", err)
+	}
+}
+
+//newReorgRecord 根据需要回滚和添加的区块生成主链切换记录,
+//开启区块序列记录时, sequence为回滚第一个区块时产生的序列号
+func (chain *BlockChain) newReorgRecord(detachNodes, attachNodes *list.List) *types.ReorgRecord {
+	record := &types.ReorgRecord{
+		Depth: int64(detachNodes.Len()),
+		Time:  types.Now().Unix(),
+	}
+	if e := detachNodes.Front(); e != nil {
+		oldTip := e.Value.(*blockNode)
+		record.OldTipHash = oldTip.hash
+		record.OldTipHeight = oldTip.height
+	}
+	if e := attachNodes.Back(); e != nil {
+		newTip := e.Value.(*blockNode)
+		record.NewTipHash = newTip.hash
+		record.NewTipHeight = newTip.height
+	}
+	if e := attachNodes.Front(); e != nil && e.Value.(*blockNode).parent != nil {
+		ancestor := e.Value.(*blockNode).parent
+		record.AncestorHash = ancestor.hash
+		record.AncestorHeight = ancestor.height
+	}
+	if chain.isRecordBlockSequence {
+		lastSequence, err := chain.blockStore.LoadBlockLastSequence()
+		if err == nil {
+			record.Sequence = lastSequence + 1
+		}
+	}
+	return record
+}
+
+//GetReorgHistory 获取主链切换的历史记录, 按index从新到旧返回
+func (chain *BlockChain) GetReorgHistory(req *types.ReqReorgHistory) (*types.ReorgRecords, error) {
+	if req == nil || req.Count <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if req.Count > maxReorgHistoryCount {
+		return nil, types.ErrMaxCountPerTime
+	}
+	start := chain.blockStore.getLastReorgIndex()
+	if req.Start > 0 && req.Start < start {
+		start = req.Start
+	}
+	records := &types.ReorgRecords{}
+	for index := start; index > 0 && len(records.Records) < int(req.Count); index-- {
+		value, err := chain.blockStore.GetKey(calcReorgRecordKey(index))
+		if err == dbm.ErrNotFoundInDb {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var record types.ReorgRecord
+		if err = types.Decode(value, &record); err != nil {
+			return nil, err
+		}
+		records.Records = append(records.Records, &record)
+	}
+	return records, nil
+}
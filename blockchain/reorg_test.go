@@ -0,0 +1,137 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"container/list"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReorgRecord(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+
+	ancestor := &blockNode{hash: []byte("ancestor"), height: 5}
+	detachNodes, attachNodes := list.New(), list.New()
+	parent := ancestor
+	for i := int64(1); i <= 2; i++ {
+		parent = &blockNode{parent: parent, hash: []byte{'o', byte(i)}, height: ancestor.height + i}
+		detachNodes.PushFront(parent)
+	}
+	parent = ancestor
+	for i := int64(1); i <= 3; i++ {
+		parent = &blockNode{parent: parent, hash: []byte{'n', byte(i)}, height: ancestor.height + i}
+		attachNodes.PushBack(parent)
+	}
+
+	lastSequence, err := chain.blockStore.LoadBlockLastSequence()
+	require.Nil(t, err)
+	record := chain.newReorgRecord(detachNodes, attachNodes)
+	assert.Equal(t, int64(2), record.Depth)
+	assert.Equal(t, []byte{'o', 2}, record.OldTipHash)
+	assert.Equal(t, int64(7), record.OldTipHeight)
+	assert.Equal(t, []byte{'n', 3}, record.NewTipHash)
+	assert.Equal(t, int64(8), record.NewTipHeight)
+	assert.Equal(t, ancestor.hash, record.AncestorHash)
+	assert.Equal(t, ancestor.height, record.AncestorHeight)
+	assert.Equal(t, lastSequence+1, record.Sequence)
+
+	require.Nil(t, chain.blockStore.saveReorgRecord(record))
+	assert.Equal(t, int64(1), record.Index)
+	second := &types.ReorgRecord{Depth: 1, Time: types.Now().Unix()}
+	require.Nil(t, chain.blockStore.saveReorgRecord(second))
+	assert.Equal(t, int64(2), second.Index)
+
+	_, err = chain.GetReorgHistory(&types.ReqReorgHistory{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = chain.GetReorgHistory(&types.ReqReorgHistory{Count: maxReorgHistoryCount + 1})
+	assert.Equal(t, types.ErrMaxCountPerTime, err)
+	records, err := chain.GetReorgHistory(&types.ReqReorgHistory{Count: 10})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(records.Records))
+	assert.Equal(t, int64(2), records.Records[0].Index)
+	assert.Equal(t, int64(1), records.Records[1].Index)
+	records, err = chain.GetReorgHistory(&types.ReqReorgHistory{Start: 1, Count: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records.Records))
+	assert.Equal(t, record.OldTipHash, records.Records[0].OldTipHash)
+
+	//推送服务按照区块序列获取主链切换记录, 没有切换的区间只更新sequence
+	data, updateSeq, err := chain.push.getReorgs(encodeProto, record.Sequence-2, 2)
+	require.Nil(t, err)
+	assert.Nil(t, data)
+	assert.Equal(t, record.Sequence-1, updateSeq)
+	data, updateSeq, err = chain.push.getReorgs(encodeProto, record.Sequence-1, 3)
+	require.Nil(t, err)
+	assert.Equal(t, record.Sequence+1, updateSeq)
+	var reorgs types.ReorgRecords
+	require.Nil(t, types.Decode(data, &reorgs))
+	require.Equal(t, 1, len(reorgs.Records))
+	assert.Equal(t, record.Index, reorgs.Records[0].Index)
+
+	chain.blockStore.delReorgRecord(record)
+	records, err = chain.GetReorgHistory(&types.ReqReorgHistory{Count: 10})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records.Records))
+	assert.Equal(t, int64(2), records.Records[0].Index)
+	data, _, err = chain.push.getReorgs(encodeProto, record.Sequence, 1)
+	require.Nil(t, err)
+	assert.Nil(t, data)
+
+	//切换失败时, 已经回滚区块的记录标记为未完成并保留, 没有回滚区块的记录删除
+	third := chain.newReorgRecord(detachNodes, attachNodes)
+	require.Nil(t, chain.blockStore.saveReorgRecord(third))
+	chain.abortReorgRecord(third, true)
+	records, err = chain.GetReorgHistory(&types.ReqReorgHistory{Count: 1})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records.Records))
+	assert.Equal(t, third.Index, records.Records[0].Index)
+	assert.True(t, records.Records[0].Incomplete)
+	assert.Equal(t, chain.bestChain.Tip().hash, records.Records[0].NewTipHash)
+	data, _, err = chain.push.getReorgs(encodeProto, third.Sequence, 1)
+	require.Nil(t, err)
+	require.Nil(t, types.Decode(data, &reorgs))
+	assert.True(t, reorgs.Records[0].Incomplete)
+
+	fourth := &types.ReorgRecord{Depth: 1, Time: types.Now().Unix()}
+	require.Nil(t, chain.blockStore.saveReorgRecord(fourth))
+	chain.abortReorgRecord(fourth, false)
+	records, err = chain.GetReorgHistory(&types.ReqReorgHistory{Count: 1})
+	require.Nil(t, err)
+	assert.Equal(t, third.Index, records.Records[0].Index)
+}
+
+func Test_connectBestChain_MaxReorgDepth(t *testing.T) {
+	chain, mock33 := createBlockChain(t)
+	defer mock33.Close()
+
+	//在当前主链tip之前的第三个区块上构造一个总难度更大的分叉区块, 切换需要回滚3个区块
+	tip := chain.bestChain.Tip()
+	parent := chain.bestChain.nodeByHeight(tip.height - 3)
+	require.NotNil(t, parent)
+	node := &blockNode{
+		parent:     parent,
+		hash:       []byte("fork-block-hash"),
+		height:     parent.height + 1,
+		pid:        "peer",
+		Difficulty: new(big.Int).Lsh(big.NewInt(1), 200),
+	}
+	chain.index.AddNode(node)
+	block := &types.BlockDetail{Block: &types.Block{ParentHash: parent.hash, Height: node.height}}
+
+	chain.maxReorgDepth = 2
+	refused := reorgRefusedCounter.Count()
+	_, isMainChain, err := chain.connectBestChain(node, block)
+	assert.Equal(t, types.ErrReorgTooDeep, err)
+	assert.False(t, isMainChain)
+	assert.False(t, chain.index.HaveBlock(node.hash))
+	assert.Equal(t, tip.hash, chain.bestChain.Tip().hash)
+	assert.Equal(t, refused+1, reorgRefusedCounter.Count())
+}
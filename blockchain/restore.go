@@ -16,6 +16,10 @@ import (
 
 // Upgrade 升级localDB和storeDB
 func (chain *BlockChain) Upgrade() {
+	//轻节点没有区块数据, 不需要升级
+	if chain.light != nil {
+		return
+	}
 	chainlog.Info("chain upgrade start")
 	chain.UpgradeChain()
 	chainlog.Info("storedb upgrade start")
@@ -0,0 +1,430 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+/*
+状态快照同步:
+新节点落后最高节点较多时，不再从创世区块开始逐个执行区块，而是
+1. 选取最高节点高度之前snapshotConfirmBlocks个区块作为快照高度, 配置了检查点时使用不超过该高度的最大检查点高度
+2. 从本地的创世区块开始获取到快照高度的区块头, 校验连接关系, 区块hash, 检查点, 并由共识模块校验每个区块头,
+   共识不支持区块头校验时快照高度必须是检查点高度, 同时计算快照区块的总难度
+3. 获取快照高度的区块, 区块hash需要和校验过的区块头一致
+4. 以区块头的StateHash为根，按层从其他节点下载mavl树节点，每个节点由store模块做hash校验之后写入db
+5. 下载完成之后由store模块从根节点开始重新计算整棵树的hash, 和区块头的StateHash做比对
+6. 将快照高度的区块作为本节点的最新区块，之后从该高度继续正常的区块同步
+
+快照高度及之前的区块不在本地存储, 也不会执行生成localdb中的交易和地址等索引, 相关的历史数据需要从全节点查询
+*/
+
+var (
+	snapshotBaseHeightKey = []byte("SnapshotBaseHeight")
+	snapshotlog           = chainlog.New("submodule", "snapshot")
+
+	// ErrSnapshotBlock 各节点返回的快照区块不一致
+	ErrSnapshotBlock = errors.New("ErrSnapshotBlock")
+	// ErrSnapshotNoPeer 没有可用的节点提供快照数据
+	ErrSnapshotNoPeer = errors.New("ErrSnapshotNoPeer")
+	// ErrSnapshotNotVerified 共识不支持区块头校验且快照高度没有检查点, 无法确认快照区块
+	ErrSnapshotNotVerified = errors.New("ErrSnapshotNotVerified")
+)
+
+const (
+	// 快照高度距离最高节点的区块数, 避免同步到侧链的状态
+	snapshotConfirmBlocks int64 = 128
+	// 单次向一个节点请求的mavl树节点数
+	snapshotBatchNodes = 1024
+	// 单次向一个节点请求的区块头数, 不能超过p2p协议的限制
+	snapshotBatchHeaders = 1000
+	// 单个请求失败的最大重试次数
+	snapshotMaxRetry = 10
+	// 默认落后最高节点超过该区块数才启用状态快照同步
+	defaultSnapshotSyncMinGap int64 = 100000
+)
+
+// GetSnapshotBaseHeight 获取状态快照同步的基础高度, 该高度之前的区块不在本地存储
+func (bs *BlockStore) GetSnapshotBaseHeight() int64 {
+	height, err := bs.loadFlag(snapshotBaseHeightKey)
+	if err != nil || height <= 0 {
+		return -1
+	}
+	return height
+}
+
+// 是否满足状态快照同步的条件
+func (chain *BlockChain) isSnapshotSyncEnable() bool {
+	if !chain.cfg.EnableSnapshotSync {
+		return false
+	}
+	if chain.isParaChain || chain.isRecordBlockSequence || !chain.cfg.DisableShard {
+		snapshotlog.Warn("isSnapshotSyncEnable: snapshot sync need disableShard and not record block sequence")
+		return false
+	}
+	//只有新加入的节点才能使用状态快照同步
+	return chain.GetBlockHeight() <= 0
+}
+
+// SnapshotDownLoadBlocks 状态快照同步, 同步完成之后继续快速下载区块
+func (chain *BlockChain) SnapshotDownLoadBlocks() {
+	minGap := chain.cfg.SnapshotSyncMinGap
+	if minGap <= 0 {
+		minGap = defaultSnapshotSyncMinGap
+	}
+	startTime := types.Now()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		default:
+		}
+		curheight := chain.GetBlockHeight()
+		peerMaxBlkHeight := chain.GetPeerMaxBlkHeight()
+		pids := chain.GetBestChainPids()
+		if peerMaxBlkHeight != -1 && curheight+minGap < peerMaxBlkHeight && len(pids) >= bestPeerCount {
+			chain.UpdateDownloadSyncStatus(snapshotDownLoadMode)
+			height := peerMaxBlkHeight - snapshotConfirmBlocks
+			if cp := chain.checkpoints.lastBelow(height); cp > 0 {
+				height = cp
+			}
+			err := chain.syncSnapshot(height, pids)
+			if err != nil {
+				snapshotlog.Error("SnapshotDownLoadBlocks", "peerMaxBlkHeight", peerMaxBlkHeight, "err", err)
+			}
+			chain.UpdateDownloadSyncStatus(fastDownLoadMode)
+			return
+		} else if (peerMaxBlkHeight != -1 && curheight+minGap >= peerMaxBlkHeight) ||
+			types.Since(startTime) > waitTimeDownLoad*time.Second || chain.cfg.SingleMode {
+			snapshotlog.Info("SnapshotDownLoadBlocks:quit!", "curheight", curheight, "peerMaxBlkHeight", peerMaxBlkHeight, "pids", len(pids))
+			return
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// 同步指定高度的状态快照
+func (chain *BlockChain) syncSnapshot(height int64, pids []string) error {
+	beg := types.Now()
+	snapshotlog.Info("syncSnapshot start", "height", height, "pids", len(pids))
+	header, td, err := chain.fetchSnapshotHeaders(height, pids)
+	if err != nil {
+		return err
+	}
+	detail, err := chain.fetchSnapshotBlock(header, pids)
+	if err != nil {
+		return err
+	}
+	stateHash := detail.GetBlock().GetStateHash()
+	err = chain.fetchStateSnapshot(stateHash, pids)
+	if err != nil {
+		return err
+	}
+	err = chain.checkStateSnapshot(stateHash)
+	if err != nil {
+		return err
+	}
+	err = chain.setSnapshotBlock(detail, td)
+	if err != nil {
+		return err
+	}
+	snapshotlog.Info("syncSnapshot complete", "height", height, "stateHash", common.ToHex(stateHash), "cost", types.Since(beg))
+	return nil
+}
+
+// 从本地的创世区块开始获取并校验到快照高度的区块头链, 返回快照高度的区块头以及该区块的总难度
+func (chain *BlockChain) fetchSnapshotHeaders(height int64, pids []string) (*types.Header, *big.Int, error) {
+	cfg := chain.client.GetConfig()
+	parent, err := chain.blockStore.GetBlockHeaderByHeight(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	td := difficulty.CalcWork(parent.Difficulty)
+	consensusCheck := true
+	next := 0
+	for parent.Height < height {
+		select {
+		case <-chain.quit:
+			return nil, nil, types.ErrIsClosed
+		default:
+		}
+		if len(pids) == 0 {
+			return nil, nil, ErrSnapshotNoPeer
+		}
+		start := parent.Height + 1
+		end := start + snapshotBatchHeaders - 1
+		if end > height {
+			end = height
+		}
+		pid := pids[next%len(pids)]
+		next++
+		msg := chain.client.NewMessage("p2p", types.EventFetchSnapshotHeaders, &types.ReqBlocks{Start: start, End: end, Pid: []string{pid}})
+		resp, err := chain.sendAndWait(msg)
+		if err != nil {
+			snapshotlog.Error("fetchSnapshotHeaders", "pid", pid, "start", start, "end", end, "err", err)
+			pids = removePid(pids, pid)
+			continue
+		}
+		headers := resp.(*types.Headers).GetItems()
+		batchTd := new(big.Int).Set(td)
+		batchParent := parent
+		for _, header := range headers {
+			err = chain.verifySnapshotHeader(cfg, batchParent, header, &consensusCheck)
+			if err != nil {
+				snapshotlog.Error("fetchSnapshotHeaders", "pid", pid, "height", header.GetHeight(), "err", err)
+				chain.RecordFaultPeer(pid, header.GetHeight(), header.GetHash(), err)
+				break
+			}
+			batchTd.Add(batchTd, difficulty.CalcWork(header.Difficulty))
+			batchParent = header
+		}
+		if err != nil || batchParent.Height != end {
+			pids = removePid(pids, pid)
+			continue
+		}
+		parent, td = batchParent, batchTd
+	}
+	if _, ok := chain.checkpoints.hashes[height]; !ok && !consensusCheck {
+		return nil, nil, ErrSnapshotNotVerified
+	}
+	snapshotlog.Info("fetchSnapshotHeaders complete", "height", height, "hash", common.ToHex(parent.Hash), "consensusCheck", consensusCheck)
+	return parent, td, nil
+}
+
+// 校验区块头和父区块头的连接关系, 区块hash和检查点, 共识不支持区块头校验时只做以上校验
+func (chain *BlockChain) verifySnapshotHeader(cfg *types.Chain33Config, parent, header *types.Header, consensusCheck *bool) error {
+	if header.GetHeight() != parent.Height+1 || !bytes.Equal(header.ParentHash, parent.Hash) {
+		return types.ErrParentHash
+	}
+	if !bytes.Equal(calcHeaderHash(cfg, header), header.Hash) {
+		return ErrSnapshotBlock
+	}
+	if cpHash, ok := chain.checkpoints.hashes[header.Height]; ok && !bytes.Equal(cpHash, header.Hash) {
+		return types.ErrCheckpointMismatch
+	}
+	if !*consensusCheck {
+		return nil
+	}
+	err := util.CheckHeader(chain.client, parent, header)
+	if err != nil && err.Error() == types.ErrActionNotSupport.Error() {
+		*consensusCheck = false
+		return nil
+	}
+	return err
+}
+
+func removePid(pids []string, pid string) []string {
+	var left []string
+	for _, p := range pids {
+		if p != pid {
+			left = append(left, p)
+		}
+	}
+	return left
+}
+
+// 获取快照高度的区块, 区块hash需要和校验过的区块头一致, 且交易根hash校验通过
+func (chain *BlockChain) fetchSnapshotBlock(header *types.Header, pids []string) (*types.BlockDetail, error) {
+	cfg := chain.client.GetConfig()
+	height := header.Height
+	for _, pid := range pids {
+		msg := chain.client.NewMessage("p2p", types.EventFetchSnapshotBlock, &types.ReqBlocks{Start: height, End: height, Pid: []string{pid}})
+		resp, err := chain.sendAndWait(msg)
+		if err != nil {
+			snapshotlog.Error("fetchSnapshotBlock", "pid", pid, "height", height, "err", err)
+			continue
+		}
+		detail := resp.(*types.BlockDetail)
+		if !bytes.Equal(detail.GetBlock().Hash(cfg), header.Hash) {
+			snapshotlog.Error("fetchSnapshotBlock: block hash not match", "pid", pid, "height", height)
+			chain.RecordFaultPeer(pid, height, detail.GetBlock().Hash(cfg), ErrSnapshotBlock)
+			continue
+		}
+		if !bytes.Equal(detail.GetBlock().GetTxHash(), merkle.CalcMerkleRoot(cfg, height, detail.GetBlock().GetTxs())) {
+			snapshotlog.Error("fetchSnapshotBlock: tx hash not match", "pid", pid, "height", height)
+			chain.RecordFaultPeer(pid, height, header.Hash, ErrSnapshotBlock)
+			continue
+		}
+		return detail, nil
+	}
+	return nil, ErrSnapshotNoPeer
+}
+
+// 以stateHash为根, 按层并发从多个节点下载mavl树节点
+func (chain *BlockChain) fetchStateSnapshot(stateHash []byte, pids []string) error {
+	pending := [][]byte{stateHash}
+	retry := make(map[string]int)
+	var total int
+	for len(pending) > 0 {
+		select {
+		case <-chain.quit:
+			return types.ErrIsClosed
+		default:
+		}
+		if len(pids) == 0 {
+			return ErrSnapshotNoPeer
+		}
+		var batches [][][]byte
+		for len(pending) > 0 && len(batches) < len(pids) {
+			n := snapshotBatchNodes
+			if n > len(pending) {
+				n = len(pending)
+			}
+			batches = append(batches, pending[:n])
+			pending = pending[n:]
+		}
+		chunks := make([]*types.StateSnapshotChunk, len(batches))
+		errs := make([]error, len(batches))
+		var wg sync.WaitGroup
+		for i := range batches {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				req := &types.ReqStateSnapshot{Pid: pids[i], StateHash: stateHash, Hashes: batches[i]}
+				resp, err := chain.sendAndWait(chain.client.NewMessage("p2p", types.EventFetchStateSnapshot, req))
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				chunks[i] = resp.(*types.StateSnapshotChunk)
+			}(i)
+		}
+		wg.Wait()
+
+		var badPeers = make(map[string]bool)
+		for i, batch := range batches {
+			requested := make(map[string]bool, len(batch))
+			for _, hash := range batch {
+				requested[string(hash)] = true
+			}
+			var nodes []*types.StateSnapshotNode
+			if errs[i] == nil {
+				//只导入请求过的节点, 节点hash来自已经校验过的父节点
+				for _, node := range chunks[i].GetNodes() {
+					if requested[string(node.Hash)] {
+						nodes = append(nodes, node)
+						delete(requested, string(node.Hash))
+					}
+				}
+			}
+			var children [][]byte
+			if len(nodes) > 0 {
+				children, errs[i] = chain.importStateSnapshot(stateHash, nodes)
+			}
+			if errs[i] != nil {
+				snapshotlog.Error("fetchStateSnapshot", "pid", pids[i], "err", errs[i])
+				badPeers[pids[i]] = true
+				for _, node := range nodes {
+					requested[string(node.Hash)] = true
+				}
+			} else {
+				total += len(nodes)
+			}
+			pending = append(pending, children...)
+			//未返回的节点放回队列, 由其他节点重新提供
+			for _, hash := range batch {
+				if !requested[string(hash)] {
+					continue
+				}
+				retry[string(hash)]++
+				if retry[string(hash)] > snapshotMaxRetry {
+					return types.ErrTimeout
+				}
+				pending = append(pending, hash)
+			}
+		}
+		if len(badPeers) > 0 {
+			var good []string
+			for _, pid := range pids {
+				if !badPeers[pid] {
+					good = append(good, pid)
+				}
+			}
+			pids = good
+		}
+		snapshotlog.Debug("fetchStateSnapshot", "imported", total, "pending", len(pending), "pids", len(pids))
+	}
+	snapshotlog.Info("fetchStateSnapshot complete", "stateHash", common.ToHex(stateHash), "nodes", total)
+	return nil
+}
+
+// 由store模块校验节点hash并写入db, 返回还未下载的子节点
+func (chain *BlockChain) importStateSnapshot(stateHash []byte, nodes []*types.StateSnapshotNode) ([][]byte, error) {
+	msg := chain.client.NewMessage("store", types.EventStoreImportSnapshot, &types.StateSnapshotChunk{StateHash: stateHash, Nodes: nodes})
+	resp, err := chain.sendAndWait(msg)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*types.ReplyHashes).GetHashes(), nil
+}
+
+// 由store模块重新计算整棵树的hash, 校验和区块头的StateHash一致
+func (chain *BlockChain) checkStateSnapshot(stateHash []byte) error {
+	msg := chain.client.NewMessage("store", types.EventStoreCheckSnapshot, &types.ReqHash{Hash: stateHash})
+	_, err := chain.sendAndWait(msg)
+	return err
+}
+
+// 将快照高度的区块设置为本节点的最新区块, td为通过区块头链计算的总难度
+func (chain *BlockChain) setSnapshotBlock(detail *types.BlockDetail, td *big.Int) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	cfg := chain.client.GetConfig()
+	block := detail.GetBlock()
+	newbatch := chain.blockStore.NewBatch(true)
+	_, err := chain.blockStore.SaveBlock(newbatch, detail, -1)
+	if err != nil {
+		return err
+	}
+	err = chain.blockStore.SaveTdByBlockHash(newbatch, block.Hash(cfg), td)
+	if err != nil {
+		return err
+	}
+	newbatch.Set(snapshotBaseHeightKey, types.Encode(&types.Int64{Data: block.Height}))
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+
+	node := newBlockNode(cfg, false, block, "snapshot", -1)
+	chain.index.AddNode(node)
+	chain.bestChain.ResetTip(node)
+	chain.blockStore.UpdateHeight2(block.Height)
+	chain.blockStore.UpdateLastBlock2(block)
+	chain.query.updateStateHash(block.GetStateHash())
+	chain.AddCacheBlock(detail)
+	chain.UpdatesynBlkHeight(block.Height)
+
+	return chain.SendAddBlockEvent(detail)
+}
+
+// 发送消息并等待回复, 回复数据为error时直接返回该错误
+func (chain *BlockChain) sendAndWait(msg *queue.Message) (interface{}, error) {
+	err := chain.client.SendTimeout(msg, true, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.WaitTimeout(msg, time.Minute*3)
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := resp.GetData().(error); ok {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
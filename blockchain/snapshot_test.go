@@ -0,0 +1,104 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const snapshotPeerPid = "snapshot-peer"
+
+// 从snapshotPeer获取快照数据, 模拟p2p模块的快照协议
+func (m *mockP2P) serveSnapshot(client queue.Client, msg *queue.Message) {
+	var pid string
+	switch req := msg.GetData().(type) {
+	case *types.ReqBlocks:
+		pid = req.GetPid()[0]
+	case *types.ReqStateSnapshot:
+		pid = req.GetPid()
+	}
+	peer := m.snapshotPeer
+	if peer == nil || pid != snapshotPeerPid {
+		msg.Reply(client.NewMessage("blockchain", msg.Ty, types.ErrNotFound))
+		return
+	}
+	var data interface{}
+	var err error
+	switch msg.Ty {
+	case types.EventFetchSnapshotHeaders:
+		req := msg.GetData().(*types.ReqBlocks)
+		data, err = peer.GetAPI().GetHeaders(&types.ReqBlocks{Start: req.Start, End: req.End})
+	case types.EventFetchSnapshotBlock:
+		req := msg.GetData().(*types.ReqBlocks)
+		var details *types.BlockDetails
+		details, err = peer.GetAPI().GetBlocks(&types.ReqBlocks{Start: req.Start, End: req.End, IsDetail: true})
+		if err == nil {
+			data = details.Items[0]
+		}
+	case types.EventFetchStateSnapshot:
+		req := msg.GetData().(*types.ReqStateSnapshot)
+		cli := peer.GetClient()
+		storeMsg := cli.NewMessage("store", types.EventStoreGetSnapshot, &types.ReqStateSnapshot{StateHash: req.StateHash, Hashes: req.Hashes})
+		err = cli.Send(storeMsg, true)
+		if err == nil {
+			var reply *queue.Message
+			reply, err = cli.Wait(storeMsg)
+			if err == nil {
+				data = reply.GetData()
+			}
+		}
+	}
+	if err != nil {
+		data = err
+	}
+	msg.Reply(client.NewMessage("blockchain", msg.Ty, data))
+}
+
+func Test_syncSnapshot(t *testing.T) {
+	src, srcMock := createBlockChain(t)
+	defer srcMock.Close()
+	dstMock := NewChain33Mock("", nil)
+	defer dstMock.Close()
+	require.Nil(t, dstMock.WaitHeight(0))
+	dst := dstMock.GetBlockChain()
+	dstMock.network.(*mockP2P).snapshotPeer = srcMock
+
+	//没有节点提供数据
+	height := src.GetBlockHeight() - 2
+	assert.Equal(t, ErrSnapshotNoPeer, dst.syncSnapshot(height, []string{"bad-peer"}))
+	assert.Equal(t, int64(0), dst.GetBlockHeight())
+
+	//不能提供数据的节点被移除, 由其他节点完成同步
+	require.Nil(t, dst.syncSnapshot(height, []string{"bad-peer", snapshotPeerPid}))
+	assert.Equal(t, height, dst.GetBlockHeight())
+	assert.Equal(t, height, dst.blockStore.GetSnapshotBaseHeight())
+
+	cfg := src.client.GetConfig()
+	block, err := src.GetBlock(height)
+	require.Nil(t, err)
+	hash := block.Block.Hash(cfg)
+	assert.Equal(t, hash, dst.bestChain.Tip().hash)
+	srcTd, err := src.blockStore.GetTdByBlockHash(hash)
+	require.Nil(t, err)
+	dstTd, err := dst.blockStore.GetTdByBlockHash(hash)
+	require.Nil(t, err)
+	assert.Equal(t, 0, srcTd.Cmp(dstTd))
+
+	//快照区块之后的状态数据和源节点一致
+	key := []byte("mavl-coins-bty-" + address.PubKeyToAddr(address.DefaultID, srcMock.GetGenesisKey().PubKey().Bytes()))
+	req := &types.StoreGet{StateHash: block.Block.StateHash, Keys: [][]byte{key}}
+	srcValues, err := srcMock.GetAPI().StoreGet(req)
+	require.Nil(t, err)
+	dstValues, err := dstMock.GetAPI().StoreGet(req)
+	require.Nil(t, err)
+	require.NotNil(t, srcValues.Values[0])
+	assert.Equal(t, srcValues.Values, dstValues.Values)
+}
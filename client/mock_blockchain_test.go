@@ -214,6 +214,14 @@ func (m *mockBlockChain) SetQueueClient(q queue.Queue) {
 				msg.Reply(client.NewMessage(blockchainKey, types.EventReplyQuery, &types.PushSubscribes{}))
 			case types.EventGetPushLastNum:
 				msg.Reply(client.NewMessage(blockchainKey, types.EventReplyQuery, &types.Int64{}))
+			case types.EventAttachPush:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventAttachPush, &types.PushSubscribeReq{}))
+			case types.EventGetCheckpoints:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventGetCheckpoints, &types.BlockCheckpoints{}))
+			case types.EventGetReorgHistory:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventGetReorgHistory, &types.ReorgRecords{}))
+			case types.EventManagePush:
+				msg.Reply(client.NewMessage(blockchainKey, types.EventReplySubscribePush, &types.ReplySubscribePush{}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
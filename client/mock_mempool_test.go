@@ -49,6 +49,10 @@ func (m *mockMempool) SetQueueClient(q queue.Queue) {
 				msg.Reply(client.NewMessage(mempoolKey, types.EventReplyTxList, &types.ReplyTxList{}))
 			case types.EventGetProperFee:
 				msg.Reply(client.NewMessage(mempoolKey, types.EventReplyProperFee, &types.ReplyProperFee{}))
+			case types.EventGetTxStatus:
+				msg.Reply(client.NewMessage(mempoolKey, types.EventGetTxStatus, &types.TxStatusHistory{}))
+			case types.EventSubTxStatus:
+				msg.Reply(client.NewMessage(mempoolKey, types.EventReply, &types.Reply{IsOk: true}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
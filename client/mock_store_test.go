@@ -40,6 +40,9 @@ func (m *mockStore) SetQueueClient(q queue.Queue) {
 				} else {
 					msg.ReplyErr("Do not support", types.ErrInvalidParam)
 				}
+			case types.EventStoreGetProof:
+				req := msg.GetData().(*types.ReqStateProof)
+				msg.Reply(client.NewMessage("store", types.EventStoreGetProof, &types.StateProof{Key: req.Key, StateHash: req.StateHash}))
 			default:
 				msg.ReplyErr("Do not support", types.ErrNotSupport)
 			}
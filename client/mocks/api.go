@@ -60,6 +60,29 @@ func (_m *QueueProtocolAPI) AddPushSubscribe(param *types.PushSubscribeReq) (*ty
 	return r0, r1
 }
 
+// AttachPush provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) AttachPush(param *types.ReqAttachPush) (*types.PushSubscribeReq, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.PushSubscribeReq
+	if rf, ok := ret.Get(0).(func(*types.ReqAttachPush) *types.PushSubscribeReq); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PushSubscribeReq)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqAttachPush) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Close() {
 	_m.Called()
@@ -364,6 +387,29 @@ func (_m *QueueProtocolAPI) GetBlocks(param *types.ReqBlocks) (*types.BlockDetai
 	return r0, r1
 }
 
+// GetCheckpoints provides a mock function with given fields:
+func (_m *QueueProtocolAPI) GetCheckpoints() (*types.BlockCheckpoints, error) {
+	ret := _m.Called()
+
+	var r0 *types.BlockCheckpoints
+	if rf, ok := ret.Get(0).(func() *types.BlockCheckpoints); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BlockCheckpoints)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *QueueProtocolAPI) GetConfig() *types.Chain33Config {
 	ret := _m.Called()
@@ -679,6 +725,29 @@ func (_m *QueueProtocolAPI) GetPushSeqLastNum(param *types.ReqString) (*types.In
 	return r0, r1
 }
 
+// GetReorgHistory provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetReorgHistory(param *types.ReqReorgHistory) (*types.ReorgRecords, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReorgRecords
+	if rf, ok := ret.Get(0).(func(*types.ReqReorgHistory) *types.ReorgRecords); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReorgRecords)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqReorgHistory) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSequenceByHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -794,6 +863,29 @@ func (_m *QueueProtocolAPI) GetTxListByAddr(param *types.ReqAddrs) (*types.Trans
 	return r0, r1
 }
 
+// GetTxStatus provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetTxStatus(param *types.ReqHash) (*types.TxStatusHistory, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.TxStatusHistory
+	if rf, ok := ret.Get(0).(func(*types.ReqHash) *types.TxStatusHistory); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TxStatusHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqHash) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsNtpClockSync provides a mock function with given fields:
 func (_m *QueueProtocolAPI) IsNtpClockSync() (*types.Reply, error) {
 	ret := _m.Called()
@@ -1025,6 +1117,29 @@ func (_m *QueueProtocolAPI) LocalSet(param *types.LocalDBSet) error {
 	return r0
 }
 
+// ManagePush provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) ManagePush(param *types.ReqManagePush) (*types.ReplySubscribePush, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.ReplySubscribePush
+	if rf, ok := ret.Get(0).(func(*types.ReqManagePush) *types.ReplySubscribePush); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySubscribePush)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqManagePush) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetProtocols provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) NetProtocols(_a0 *types.ReqNil) (*types.NetProtocolInfos, error) {
 	ret := _m.Called(_a0)
@@ -1400,6 +1515,29 @@ func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHas
 	return r0, r1
 }
 
+// StoreGetProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreGetProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(*types.ReqStateProof) *types.StateProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStateProof) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreList provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) StoreList(param *types.StoreList) (*types.StoreListReply, error) {
 	ret := _m.Called(param)
@@ -1492,6 +1630,52 @@ func (_m *QueueProtocolAPI) StoreSet(param *types.StoreSetWithSync) (*types.Repl
 	return r0, r1
 }
 
+// SubscribeTxStatus provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) SubscribeTxStatus(param *types.ReqSubTxStatus) (*types.Reply, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.Reply
+	if rf, ok := ret.Get(0).(func(*types.ReqSubTxStatus) *types.Reply); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Reply)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqSubTxStatus) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TraceTx provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) TraceTx(param *types.ReqTraceTx) (*types.TxTraces, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.TxTraces
+	if rf, ok := ret.Get(0).(func(*types.ReqTraceTx) *types.TxTraces); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.TxTraces)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqTraceTx) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Version provides a mock function with given fields:
 func (_m *QueueProtocolAPI) Version() (*types.VersionInfo, error) {
 	ret := _m.Called()
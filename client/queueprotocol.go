@@ -353,6 +353,42 @@ func (q *QueueProtocol) GetProperFee(req *types.ReqProperFee) (*types.ReplyPrope
 	return nil, types.ErrTypeAsset
 }
 
+// GetTxStatus get recent status changes of tx from mempool
+func (q *QueueProtocol) GetTxStatus(param *types.ReqHash) (*types.TxStatusHistory, error) {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("GetTxStatus", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventGetTxStatus, param)
+	if err != nil {
+		log.Error("GetTxStatus", "Error", err)
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.TxStatusHistory); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// SubscribeTxStatus subscribe or unsubscribe tx status push from mempool
+func (q *QueueProtocol) SubscribeTxStatus(param *types.ReqSubTxStatus) (*types.Reply, error) {
+	if param == nil || param.GetName() == "" {
+		err := types.ErrInvalidParam
+		log.Error("SubscribeTxStatus", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(mempoolKey, types.EventSubTxStatus, param)
+	if err != nil {
+		log.Error("SubscribeTxStatus", "Error", err)
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetBlockOverview get block head detil by hash
 func (q *QueueProtocol) GetBlockOverview(param *types.ReqHash) (*types.BlockOverview, error) {
 	if param == nil {
@@ -829,6 +865,24 @@ func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash)
 	return nil, err
 }
 
+// StoreGetProof get merkle proof of state key from statedb
+func (q *QueueProtocol) StoreGetProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	if param == nil || len(param.Key) == 0 || len(param.StateHash) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(storeKey, types.EventStoreGetProof, param)
+	if err != nil {
+		log.Error("StoreGetProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateProof); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // CloseQueue close client queue
 func (q *QueueProtocol) CloseQueue() (*types.Reply, error) {
 	return q.client.CloseQueue()
@@ -945,6 +999,26 @@ func (q *QueueProtocol) QueryChain(param *types.ChainExecutor) (types.Message, e
 	return nil, err
 }
 
+// TraceTx 在父区块状态上重新执行交易, 返回交易执行追踪
+func (q *QueueProtocol) TraceTx(param *types.ReqTraceTx) (*types.TxTraces, error) {
+	if param == nil || param.GetTxList() == nil {
+		err := types.ErrInvalidParam
+		log.Error("TraceTx", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(executorKey, types.EventTraceTx, param)
+	if err != nil {
+		log.Error("TraceTx", "Error", err)
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.TxTraces); ok {
+		return reply, nil
+	}
+	err = types.ErrTypeAsset
+	log.Error("TraceTx", "Error", err)
+	return nil, err
+}
+
 // AddPushSubscribe Add Seq CallBack
 func (q *QueueProtocol) AddPushSubscribe(param *types.PushSubscribeReq) (*types.ReplySubscribePush, error) {
 	msg, err := q.send(blockchainKey, types.EventSubscribePush, param)
@@ -984,6 +1058,64 @@ func (q *QueueProtocol) GetPushSeqLastNum(param *types.ReqString) (*types.Int64,
 	return nil, types.ErrTypeAsset
 }
 
+// ManagePush 管理推送订阅
+func (q *QueueProtocol) ManagePush(param *types.ReqManagePush) (*types.ReplySubscribePush, error) {
+	msg, err := q.send(blockchainKey, types.EventManagePush, param)
+	if err != nil {
+		log.Error("ManagePush", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReplySubscribePush); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// AttachPush websocket或者SSE连接附加到推送订阅或者断开
+func (q *QueueProtocol) AttachPush(param *types.ReqAttachPush) (*types.PushSubscribeReq, error) {
+	if param == nil || param.Name == "" {
+		return nil, types.ErrInvalidParam
+	}
+	msg, err := q.send(blockchainKey, types.EventAttachPush, param)
+	if err != nil {
+		log.Error("AttachPush", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.PushSubscribeReq); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetReorgHistory 获取主链切换的历史记录
+func (q *QueueProtocol) GetReorgHistory(param *types.ReqReorgHistory) (*types.ReorgRecords, error) {
+	if param == nil {
+		return nil, types.ErrInvalidParam
+	}
+	msg, err := q.send(blockchainKey, types.EventGetReorgHistory, param)
+	if err != nil {
+		log.Error("GetReorgHistory", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.ReorgRecords); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetCheckpoints 获取区块同步的检查点
+func (q *QueueProtocol) GetCheckpoints() (*types.BlockCheckpoints, error) {
+	msg, err := q.send(blockchainKey, types.EventGetCheckpoints, &types.ReqNil{})
+	if err != nil {
+		log.Error("GetCheckpoints", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.BlockCheckpoints); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
 // GetLastBlockMainSequence 获取最新的block执行序列号
 func (q *QueueProtocol) GetLastBlockMainSequence() (*types.Int64, error) {
 	msg, err := q.send(blockchainKey, types.EventGetLastBlockMainSequence, &types.ReqNil{})
@@ -73,6 +73,8 @@ func TestQueueProtocol(t *testing.T) {
 	testGetHeaders(t, api)
 	testGetLastMempool(t, api)
 	testGetProperFee(t, api)
+	testGetTxStatus(t, api)
+	testSubscribeTxStatus(t, api)
 	testGetBlockOverview(t, api)
 	testGetAddrOverview(t, api)
 	testGetBlockHash(t, api)
@@ -81,6 +83,10 @@ func TestQueueProtocol(t *testing.T) {
 	testAddSeqCallBack(t, api)
 	testListSeqCallBack(t, api)
 	testGetSeqCallBackLastNum(t, api)
+	testManagePush(t, api)
+	testAttachPush(t, api)
+	testGetReorgHistory(t, api)
+	testGetCheckpoints(t, api)
 	testGetLastBlockSequence(t, api)
 	testIsSync(t, api)
 	testIsNtpClockSync(t, api)
@@ -95,8 +101,10 @@ func TestQueueProtocol(t *testing.T) {
 	testStoreRollback(t, api)
 	testStoreDel(t, api)
 	testStoreGetTotalCoins(t, api)
+	testStoreGetProof(t, api)
 	testStoreList(t, api)
 	testBlockChainQuery(t, api)
+	testTraceTx(t, api)
 	testQueryConsensus(t, api)
 	testExecWalletFunc(t, api)
 	testGetSequenceByHash(t, api)
@@ -112,6 +120,32 @@ func testGetSequenceByHash(t *testing.T, api client.QueueProtocolAPI) {
 	assert.Equal(t, &types.Int64{Data: 1}, res)
 }
 
+func testGetTxStatus(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetTxStatus(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := api.GetTxStatus(&types.ReqHash{Hash: []byte("hash")})
+	assert.Nil(t, err)
+	assert.NotNil(t, reply)
+}
+
+func testSubscribeTxStatus(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.SubscribeTxStatus(&types.ReqSubTxStatus{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := api.SubscribeTxStatus(&types.ReqSubTxStatus{Name: "test"})
+	assert.Nil(t, err)
+	assert.True(t, reply.IsOk)
+}
+
+func testTraceTx(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.TraceTx(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = api.TraceTx(&types.ReqTraceTx{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	reply, err := api.TraceTx(&types.ReqTraceTx{TxList: &types.ExecTxList{}})
+	assert.Nil(t, err)
+	assert.NotNil(t, reply)
+}
+
 func testBlockChainQuery(t *testing.T, api client.QueueProtocolAPI) {
 	testCases := []struct {
 		param     *types.ChainExecutor
@@ -227,6 +261,34 @@ func testGetSeqCallBackLastNum(t *testing.T, api client.QueueProtocolAPI) {
 	assert.Equal(t, &types.Int64{}, res)
 }
 
+func testManagePush(t *testing.T, api client.QueueProtocolAPI) {
+	res, err := api.ManagePush(&types.ReqManagePush{})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ReplySubscribePush{}, res)
+}
+
+func testAttachPush(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.AttachPush(&types.ReqAttachPush{})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.AttachPush(&types.ReqAttachPush{Name: "test", Attach: true})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.PushSubscribeReq{}, res)
+}
+
+func testGetReorgHistory(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.GetReorgHistory(nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	res, err := api.GetReorgHistory(&types.ReqReorgHistory{Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ReorgRecords{}, res)
+}
+
+func testGetCheckpoints(t *testing.T, api client.QueueProtocolAPI) {
+	res, err := api.GetCheckpoints()
+	assert.Nil(t, err)
+	assert.Equal(t, &types.BlockCheckpoints{}, res)
+}
+
 func testStoreSet(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.StoreSet(&types.StoreSetWithSync{})
 	if err != nil {
@@ -299,6 +361,21 @@ func testStoreDel(t *testing.T, api client.QueueProtocolAPI) {
 	}
 }
 
+func testStoreGetProof(t *testing.T, api client.QueueProtocolAPI) {
+	_, err := api.StoreGetProof(nil)
+	if err == nil {
+		t.Error("StoreGetProof(nil) need return error.")
+	}
+	_, err = api.StoreGetProof(&types.ReqStateProof{Key: []byte("key")})
+	if err == nil {
+		t.Error("StoreGetProof without stateHash need return error.")
+	}
+	reply, err := api.StoreGetProof(&types.ReqStateProof{Key: []byte("key"), StateHash: []byte("hash")})
+	if err != nil || string(reply.GetKey()) != "key" {
+		t.Error("Call StoreGetProof Failed.", err)
+	}
+}
+
 func testStoreGetTotalCoins(t *testing.T, api client.QueueProtocolAPI) {
 	_, err := api.StoreGetTotalCoins(&types.IterateRangeByStateHash{})
 	if err != nil {
@@ -28,6 +28,10 @@ type QueueProtocolAPI interface {
 	GetLastMempool() (*types.ReplyTxList, error)
 	// types.EventGetProperFee
 	GetProperFee(req *types.ReqProperFee) (*types.ReplyProperFee, error)
+	// types.EventGetTxStatus
+	GetTxStatus(param *types.ReqHash) (*types.TxStatusHistory, error)
+	// types.EventSubTxStatus
+	SubscribeTxStatus(param *types.ReqSubTxStatus) (*types.Reply, error)
 	//types.EventDelTxList
 	RemoveTxsByHashList(hashList *types.TxHashList) error
 	// +++++++++++++++ execs interfaces begin
@@ -38,6 +42,8 @@ type QueueProtocolAPI interface {
 	QueryChain(param *types.ChainExecutor) (types.Message, error)
 	ExecWalletFunc(driver string, funcname string, param types.Message) (types.Message, error)
 	ExecWallet(param *types.ChainExecutor) (types.Message, error)
+	// types.EventTraceTx
+	TraceTx(param *types.ReqTraceTx) (*types.TxTraces, error)
 	// --------------- execs interfaces end
 
 	// +++++++++++++++ p2p interfaces begin
@@ -116,6 +122,8 @@ type QueueProtocolAPI interface {
 	StoreDel(param *types.StoreDel) (*types.ReplyHash, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	// types.EventStoreGetProof
+	StoreGetProof(param *types.ReqStateProof) (*types.StateProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
@@ -128,6 +136,14 @@ type QueueProtocolAPI interface {
 	ListPushes() (*types.PushSubscribes, error)
 	// types.EventGetSeqCBLastNum
 	GetPushSeqLastNum(param *types.ReqString) (*types.Int64, error)
+	// types.EventManagePush
+	ManagePush(param *types.ReqManagePush) (*types.ReplySubscribePush, error)
+	// types.EventAttachPush
+	AttachPush(param *types.ReqAttachPush) (*types.PushSubscribeReq, error)
+	// types.EventGetReorgHistory
+	GetReorgHistory(param *types.ReqReorgHistory) (*types.ReorgRecords, error)
+	// types.EventGetCheckpoints
+	GetCheckpoints() (*types.BlockCheckpoints, error)
 	// types.EventGetParaTxByTitle
 	GetParaTxByTitle(param *types.ReqParaTxByTitle) (*types.ParaTxDetails, error)
 	// types.EventGetHeightByTitle
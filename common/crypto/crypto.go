@@ -14,6 +14,8 @@ import (
 var (
 	//ErrNotSupportAggr 不支持聚合签名
 	ErrNotSupportAggr = errors.New("AggregateCrypto not support")
+	//ErrNotSupportBatch 不支持批量验签
+	ErrNotSupportBatch = errors.New("BatchCrypto not support")
 	//ErrSign 签名错误
 	ErrSign = errors.New("error signature")
 	//ErrUnknownDriver 未注册加密插件
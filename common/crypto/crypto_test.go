@@ -194,6 +194,13 @@ func TestAggregate(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestToBatch(t *testing.T) {
+	c, err := crypto.Load("secp256k1", -1)
+	require.Nil(t, err)
+	_, err = crypto.ToBatch(c)
+	require.Equal(t, crypto.ErrNotSupportBatch, err)
+}
+
 type democrypto struct{}
 
 func (d democrypto) GenKey() (crypto.PrivKey, error) {
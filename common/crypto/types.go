@@ -21,6 +21,13 @@ type AggregateCrypto interface {
 	VerifyAggregatedN(pubs []PubKey, ms [][]byte, sig Signature) error
 }
 
+//BatchCrypto 批量验签, 全部签名有效时返回nil, 否则由调用方逐个验签定位无效签名
+//
+//批量验证接受的签名集合必须和逐个验证一致(除可忽略的概率外), 否则不同验签路径的节点会产生共识分歧
+type BatchCrypto interface {
+	VerifyBatch(msgs, pubs, sigs [][]byte) error
+}
+
 //PrivKey 私钥
 type PrivKey interface {
 	Bytes() []byte
@@ -60,6 +60,14 @@ func ToAggregate(c Crypto) (AggregateCrypto, error) {
 	return nil, ErrNotSupportAggr
 }
 
+//ToBatch 判断签名是否支持批量验签，并且返回批量验签的接口
+func ToBatch(c Crypto) (BatchCrypto, error) {
+	if batch, ok := c.(BatchCrypto); ok {
+		return batch, nil
+	}
+	return nil, ErrNotSupportBatch
+}
+
 // WithRegOptionCGO 设置为CGO版本
 func WithRegOptionCGO() RegOption {
 	return func(d *Driver) error {
@@ -76,6 +84,14 @@ func WithRegOptionDefaultDisable() RegOption {
 	}
 }
 
+// WithRegOptionEnableHeight 设置内置的启用高度, 负数表示默认不启用, 可以通过配置覆盖
+func WithRegOptionEnableHeight(height int64) RegOption {
+	return func(d *Driver) error {
+		d.enableHeight = height
+		return nil
+	}
+}
+
 const (
 	// MaxManualTypeID 手动指定ID最大值 4095
 	MaxManualTypeID = 1<<12 - 1
@@ -0,0 +1,503 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"path"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var plog = log.New("module", "db.pebble")
+
+func init() {
+	dbCreator := func(name string, dir string, cache int) (DB, error) {
+		return NewGoPebbleDB(name, dir, cache)
+	}
+	registerDBCreator(goPebbleDBBackendStr, dbCreator, false)
+}
+
+//GoPebbleDB db
+type GoPebbleDB struct {
+	BaseDB
+	db *pebble.DB
+
+	compTimeMeter      metrics.Meter // Meter for measuring the total time spent in database compaction
+	compReadMeter      metrics.Meter // Meter for measuring the data read during compaction
+	compWriteMeter     metrics.Meter // Meter for measuring the data written during compaction
+	writeDelayNMeter   metrics.Meter // Meter for measuring the write delay number due to database compaction
+	writeDelayMeter    metrics.Meter // Meter for measuring the write delay duration due to database compaction
+	diskSizeGauge      metrics.Gauge // Gauge for tracking the size of all the levels in the database
+	diskReadMeter      metrics.Meter // Meter for measuring the effective amount of data read
+	diskWriteMeter     metrics.Meter // Meter for measuring the effective amount of data written
+	memCompGauge       metrics.Gauge // Gauge for tracking the number of memory compaction
+	level0CompGauge    metrics.Gauge // Gauge for tracking the number of table compaction in level0
+	nonlevel0CompGauge metrics.Gauge // Gauge for tracking the number of table compaction in non0 level
+	seekCompGauge      metrics.Gauge // Gauge for tracking the number of table compaction caused by read opt
+
+	compTime        int64 // Total time spent in compaction in ns, updated by event listener
+	writeDelayCount int64 // Total number of write stalls, updated by event listener
+	writeDelayTime  int64 // Total time spent in write stalls in ns, updated by event listener
+	writeDelayStart time.Time
+	writeDelayLock  sync.Mutex
+
+	quitChan chan chan error // Quit channel to stop the metrics collection before closing the database
+}
+
+//NewGoPebbleDB new
+func NewGoPebbleDB(name string, dir string, cache int) (*GoPebbleDB, error) {
+	dbPath := path.Join(dir, name+".db")
+	if cache == 0 {
+		cache = 64
+	}
+	handles := cache
+	if handles < 16 {
+		handles = 16
+	}
+	if cache < 4 {
+		cache = 4
+	}
+	database := &GoPebbleDB{
+		quitChan: make(chan chan error),
+	}
+	blockCache := pebble.NewCache(int64(cache / 2 * 1024 * 1024))
+	defer blockCache.Unref()
+	opts := &pebble.Options{
+		Cache:        blockCache,
+		MaxOpenFiles: handles,
+		MemTableSize: cache / 4 * 1024 * 1024, // Two of these are used internally
+		Levels:       []pebble.LevelOptions{{FilterPolicy: bloom.FilterPolicy(10)}},
+		EventListener: pebble.EventListener{
+			CompactionEnd:   database.onCompactionEnd,
+			WriteStallBegin: database.onWriteStallBegin,
+			WriteStallEnd:   database.onWriteStallEnd,
+		},
+	}
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	database.db = db
+
+	namespace := ""
+	database.compTimeMeter = metrics.NewRegisteredMeter(namespace+"compact/time", nil)
+	database.compReadMeter = metrics.NewRegisteredMeter(namespace+"compact/input", nil)
+	database.compWriteMeter = metrics.NewRegisteredMeter(namespace+"compact/output", nil)
+	database.diskSizeGauge = metrics.NewRegisteredGauge(namespace+"disk/size", nil)
+	database.diskReadMeter = metrics.NewRegisteredMeter(namespace+"disk/read", nil)
+	database.diskWriteMeter = metrics.NewRegisteredMeter(namespace+"disk/write", nil)
+	database.writeDelayMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/duration", nil)
+	database.writeDelayNMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/counter", nil)
+	database.memCompGauge = metrics.NewRegisteredGauge(namespace+"compact/memory", nil)
+	database.level0CompGauge = metrics.NewRegisteredGauge(namespace+"compact/level0", nil)
+	database.nonlevel0CompGauge = metrics.NewRegisteredGauge(namespace+"compact/nonlevel0", nil)
+	database.seekCompGauge = metrics.NewRegisteredGauge(namespace+"compact/seek", nil)
+
+	// Start up the metrics gathering and return
+	go database.meter(metricsGatheringInterval)
+
+	return database, nil
+}
+
+func (db *GoPebbleDB) onCompactionEnd(info pebble.CompactionInfo) {
+	atomic.AddInt64(&db.compTime, int64(info.TotalDuration))
+}
+
+func (db *GoPebbleDB) onWriteStallBegin(info pebble.WriteStallBeginInfo) {
+	db.writeDelayLock.Lock()
+	defer db.writeDelayLock.Unlock()
+	db.writeDelayStart = time.Now()
+	atomic.AddInt64(&db.writeDelayCount, 1)
+}
+
+func (db *GoPebbleDB) onWriteStallEnd() {
+	db.writeDelayLock.Lock()
+	defer db.writeDelayLock.Unlock()
+	if db.writeDelayStart.IsZero() {
+		return
+	}
+	atomic.AddInt64(&db.writeDelayTime, int64(time.Since(db.writeDelayStart)))
+	db.writeDelayStart = time.Time{}
+}
+
+//Get get
+func (db *GoPebbleDB) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.db.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("Get", "error", err)
+		return nil, err
+	}
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set
+func (db *GoPebbleDB) Set(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.NoSync)
+	if err != nil {
+		plog.Error("Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//SetSync 同步
+func (db *GoPebbleDB) SetSync(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.Sync)
+	if err != nil {
+		plog.Error("SetSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Delete 删除
+func (db *GoPebbleDB) Delete(key []byte) error {
+	err := db.db.Delete(key, pebble.NoSync)
+	if err != nil {
+		plog.Error("Delete", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DeleteSync 删除同步
+func (db *GoPebbleDB) DeleteSync(key []byte) error {
+	err := db.db.Delete(key, pebble.Sync)
+	if err != nil {
+		plog.Error("DeleteSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DB db
+func (db *GoPebbleDB) DB() *pebble.DB {
+	return db.db
+}
+
+//Close 关闭
+func (db *GoPebbleDB) Close() {
+	if db.quitChan != nil {
+		errc := make(chan error)
+		db.quitChan <- errc
+		if err := <-errc; err != nil {
+			plog.Error("Metrics collection failed", "err", err)
+		}
+		db.quitChan = nil
+	}
+
+	err := db.db.Close()
+	if err != nil {
+		plog.Error("Close", "error", err)
+	}
+}
+
+//Print 打印
+func (db *GoPebbleDB) Print() {
+	plog.Info("Print", "stats", db.db.Metrics().String())
+
+	iter := db.db.NewIter(nil)
+	for iter.First(); iter.Valid(); iter.Next() {
+		plog.Info("Print", "key", string(iter.Key()), "value", string(iter.Value()))
+	}
+	iter.Close()
+}
+
+//Stats ...
+func (db *GoPebbleDB) Stats() map[string]string {
+	stats := make(map[string]string)
+	stats["pebble.stats"] = db.db.Metrics().String()
+	return stats
+}
+
+//Iterator 迭代器
+func (db *GoPebbleDB) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.db.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return &goPebbleDBIt{it, itBase{start, end, reverse}}
+}
+
+//BeginTx 以indexed batch模拟事务, 提交前的写入对事务内读可见
+func (db *GoPebbleDB) BeginTx() (TxKV, error) {
+	return &goPebbleDBTx{tx: db.db.NewIndexedBatch()}, nil
+}
+
+// CompactRange ...
+func (db *GoPebbleDB) CompactRange(start, limit []byte) error {
+	if start == nil {
+		start = []byte{}
+	}
+	// pebble不支持开区间的压缩, limit为空时取最后一个key之后的位置
+	if limit == nil {
+		it := db.db.NewIter(nil)
+		if it.Last() {
+			limit = append(cloneByte(it.Key()), 0)
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return db.db.Compact(start, limit, true)
+}
+
+// meter periodically retrieves internal pebble counters and reports them to
+// the metrics subsystem, using the same meter names as the leveldb backend.
+func (db *GoPebbleDB) meter(refresh time.Duration) {
+	var (
+		compTime   [2]int64
+		compRead   [2]int64
+		compWrite  [2]int64
+		nWrite     [2]int64
+		delayCount [2]int64
+		delayTime  [2]int64
+
+		lastWritePaused time.Time
+		errc            chan error
+	)
+
+	// Iterate ad infinitum and collect the stats
+	for i := 1; errc == nil; i++ {
+		m := db.db.Metrics()
+		var (
+			read      int64
+			written   int64
+			level0    int64
+			nonlevel0 int64
+		)
+		for level, lm := range m.Levels {
+			read += int64(lm.BytesRead)
+			written += int64(lm.BytesCompacted)
+			if level == 0 {
+				level0 += int64(lm.TablesCompacted)
+			} else {
+				nonlevel0 += int64(lm.TablesCompacted)
+			}
+		}
+		compTime[i%2] = atomic.LoadInt64(&db.compTime)
+		compRead[i%2] = read
+		compWrite[i%2] = written
+		nWrite[i%2] = written + int64(m.WAL.BytesWritten)
+		delayCount[i%2] = atomic.LoadInt64(&db.writeDelayCount)
+		delayTime[i%2] = atomic.LoadInt64(&db.writeDelayTime)
+
+		// Update all the requested meters
+		db.diskSizeGauge.Update(int64(m.DiskSpaceUsage()))
+		db.compTimeMeter.Mark(compTime[i%2] - compTime[(i-1)%2])
+		db.compReadMeter.Mark(compRead[i%2] - compRead[(i-1)%2])
+		db.compWriteMeter.Mark(compWrite[i%2] - compWrite[(i-1)%2])
+		// pebble不统计非压缩产生的读取量, 以压缩读取量近似
+		db.diskReadMeter.Mark(compRead[i%2] - compRead[(i-1)%2])
+		db.diskWriteMeter.Mark(nWrite[i%2] - nWrite[(i-1)%2])
+		db.writeDelayNMeter.Mark(delayCount[i%2] - delayCount[(i-1)%2])
+		db.writeDelayMeter.Mark(delayTime[i%2] - delayTime[(i-1)%2])
+		db.memCompGauge.Update(m.Flush.Count)
+		db.level0CompGauge.Update(level0)
+		db.nonlevel0CompGauge.Update(nonlevel0)
+		db.seekCompGauge.Update(m.Compact.ReadCount)
+
+		// If a warning that db is performing compaction has been displayed, any subsequent
+		// warnings will be withheld for one minute not to overwhelm the user.
+		if delayCount[i%2] > delayCount[(i-1)%2] &&
+			time.Now().After(lastWritePaused.Add(degradationWarnInterval)) {
+			plog.Warn("Database compacting, degraded performance")
+			lastWritePaused = time.Now()
+		}
+
+		// Sleep a bit, then repeat the stats collection
+		select {
+		case errc = <-db.quitChan:
+			// Quit requesting, stop hammering the database
+		case <-time.After(refresh):
+			// Timeout, gather a new set of stats
+		}
+	}
+	errc <- nil
+}
+
+type goPebbleDBIt struct {
+	*pebble.Iterator
+	itBase
+}
+
+//Close 关闭
+func (dbit *goPebbleDBIt) Close() {
+	err := dbit.Iterator.Close()
+	if err != nil {
+		plog.Error("Iterator Close", "error", err)
+	}
+}
+
+//Next next
+func (dbit *goPebbleDBIt) Next() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Prev() && dbit.Valid()
+	}
+	return dbit.Iterator.Next() && dbit.Valid()
+}
+
+//Seek seek key, 逆序时定位到小于等于key的最大key
+func (dbit *goPebbleDBIt) Seek(key []byte) bool {
+	if dbit.reverse {
+		return dbit.Iterator.SeekLT(append(cloneByte(key), 0)) && dbit.Valid()
+	}
+	return dbit.Iterator.SeekGE(key)
+}
+
+//Rewind ...
+func (dbit *goPebbleDBIt) Rewind() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Last() && dbit.Valid()
+	}
+	return dbit.Iterator.First() && dbit.Valid()
+}
+
+func (dbit *goPebbleDBIt) Value() []byte {
+	return dbit.Iterator.Value()
+}
+
+func (dbit *goPebbleDBIt) ValueCopy() []byte {
+	return cloneByte(dbit.Iterator.Value())
+}
+
+func (dbit *goPebbleDBIt) Valid() bool {
+	return dbit.Iterator.Valid() && dbit.checkKey(dbit.Key())
+}
+
+type goPebbleDBBatch struct {
+	db    *GoPebbleDB
+	batch *pebble.Batch
+	wop   *pebble.WriteOptions
+	size  int
+	len   int
+}
+
+//NewBatch new
+func (db *GoPebbleDB) NewBatch(sync bool) Batch {
+	batch := db.db.NewBatch()
+	wop := &pebble.WriteOptions{Sync: sync}
+	return &goPebbleDBBatch{db, batch, wop, 0, 0}
+}
+
+func (mBatch *goPebbleDBBatch) Set(key, value []byte) {
+	mBatch.batch.Set(key, value, nil)
+	mBatch.size += len(key)
+	mBatch.size += len(value)
+	mBatch.len += len(value)
+}
+
+func (mBatch *goPebbleDBBatch) Delete(key []byte) {
+	mBatch.batch.Delete(key, nil)
+	mBatch.size += len(key)
+	mBatch.len++
+}
+
+func (mBatch *goPebbleDBBatch) Write() error {
+	// pebble的batch只能提交一次, 提交后保留内容以兼容leveldb的重复写入语义
+	repr := cloneByte(mBatch.batch.Repr())
+	err := mBatch.db.db.Apply(mBatch.batch, mBatch.wop)
+	if err != nil {
+		plog.Error("Write", "error", err)
+		return err
+	}
+	mBatch.batch = mBatch.db.db.NewBatch()
+	return mBatch.batch.SetRepr(repr)
+}
+
+func (mBatch *goPebbleDBBatch) ValueSize() int {
+	return mBatch.size
+}
+
+//ValueLen  batch数量
+func (mBatch *goPebbleDBBatch) ValueLen() int {
+	return mBatch.len
+}
+
+func (mBatch *goPebbleDBBatch) Reset() {
+	mBatch.batch.Reset()
+	mBatch.len = 0
+	mBatch.size = 0
+}
+
+func (mBatch *goPebbleDBBatch) UpdateWriteSync(sync bool) {
+	mBatch.wop.Sync = sync
+}
+
+type goPebbleDBTx struct {
+	tx *pebble.Batch
+}
+
+func (db *goPebbleDBTx) Commit() error {
+	return db.tx.Commit(pebble.Sync)
+}
+
+func (db *goPebbleDBTx) Rollback() {
+	err := db.tx.Close()
+	if err != nil {
+		plog.Error("tx Rollback", "error", err)
+	}
+}
+
+//Get get in transaction
+func (db *goPebbleDBTx) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.tx.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("tx Get", "error", err)
+		return nil, err
+	}
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set in transaction
+func (db *goPebbleDBTx) Set(key []byte, value []byte) error {
+	err := db.tx.Set(key, value, nil)
+	if err != nil {
+		plog.Error("tx Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Iterator 迭代器 in transaction
+func (db *goPebbleDBTx) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.tx.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return &goPebbleDBIt{it, itBase{start, end, reverse}}
+}
+
+//Begin call panic when Begin not rewrite
+func (db *goPebbleDBTx) Begin() {
+	panic("Begin not impl")
+}
@@ -0,0 +1,133 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pebble迭代器测试
+func TestGoPebbleDBIterator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBIterator(t, pebbledb)
+}
+
+func TestGoPebbleDBIteratorAll(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBIteratorAllKey(t, pebbledb)
+}
+
+func TestGoPebbleDBIteratorReserverExample(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBIteratorReserverExample(t, pebbledb)
+}
+
+func TestGoPebbleDBIteratorDel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBIteratorDel(t, pebbledb)
+}
+
+func TestGoPebbleDBBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testBatch(t, pebbledb)
+}
+
+func TestGoPebbleDBTransaction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testTransaction(t, pebbledb)
+}
+
+// pebble边界测试
+func TestGoPebbleDBBoundary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBBoundary(t, pebbledb)
+}
+
+func TestGoPebbleDBResult(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+	testDBIteratorResult(t, pebbledb)
+}
+
+func TestGoPebbleDBCompactRange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pebbledb, err := NewGoPebbleDB("gopebbledb", dir, 128)
+	require.NoError(t, err)
+	defer pebbledb.Close()
+
+	require.NoError(t, pebbledb.CompactRange(nil, nil))
+	require.NoError(t, pebbledb.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, pebbledb.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, pebbledb.CompactRange(nil, nil))
+	value, err := pebbledb.Get([]byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, "value2", string(value))
+	require.NotEmpty(t, pebbledb.Stats())
+}
+
+func TestGoPebbleDBCreator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gopebbledb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db := NewDB("gopebbledb", "pebble", dir, 128)
+	defer db.Close()
+	_, ok := db.(*GoPebbleDB)
+	require.True(t, ok)
+}
@@ -41,6 +41,8 @@ type executor struct {
 	currDriver drivers.Driver
 	cfg        *types.Chain33Config
 	exec       *Executor
+	//debug追踪执行时记录执行步骤, 正常执行时为nil
+	tracer *txTracer
 }
 
 type executorCtx struct {
@@ -364,7 +366,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	if err != nil {
 		return nil, err
 	}
-	feelog, err := e.execFee(txs[0], index)
+	feelog, err := e.traceExecFee(txs[0], index)
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +378,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	for i := 1; i < len(txs); i++ {
 		receipts[i] = &types.Receipt{Ty: types.ExecPack}
 	}
-	receipts[0], err = e.execTxOne(feelog, txs[0], index)
+	receipts[0], err = e.traceExecTxOne(feelog, txs[0], index)
 	if err != nil {
 		//接口临时错误，取消执行
 		if api.IsAPIEnvError(err) {
@@ -390,7 +392,7 @@ func (e *executor) execTxGroup(txs []*types.Transaction, index int) ([]*types.Re
 	}
 	for i := 1; i < len(txs); i++ {
 		//如果有一笔执行失败了，那么全部回滚
-		receipts[i], err = e.execTxOne(receipts[i], txs[i], index+i)
+		receipts[i], err = e.traceExecTxOne(receipts[i], txs[i], index+i)
 		if err != nil {
 			//reset other exec , and break!
 			if api.IsAPIEnvError(err) {
@@ -619,13 +621,13 @@ func (e *executor) execTx(exec *Executor, tx *types.Transaction, index int) (*ty
 	//处理交易手续费(先把手续费收了)
 	//如果收了手续费，表示receipt 至少是pack 级别
 	//收不了手续费的交易才是 error 级别
-	feelog, err := e.execFee(tx, index)
+	feelog, err := e.traceExecFee(tx, index)
 	if err != nil {
 		return nil, err
 	}
 	//ignore err
 	e.begin()
-	feelog, err = e.execTxOne(feelog, tx, index)
+	feelog, err = e.traceExecTxOne(feelog, tx, index)
 	if err != nil {
 		e.rollback()
 		elog.Error("exec tx = ", "index", index, "execer", string(tx.Execer), "err", err)
@@ -11,6 +11,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/33cn/chain33/client/api"
 	dbm "github.com/33cn/chain33/common/db"
@@ -49,6 +50,8 @@ type Executor struct {
 	pluginEnable     map[string]bool
 	alias            map[string]string
 	noneDriverPool   *sync.Pool
+	enableParallel   bool
+	parallelWorkers  int
 }
 
 func execInit(cfg *typ.Chain33Config) {
@@ -73,6 +76,12 @@ func New(cfg *typ.Chain33Config) *Executor {
 	exec.pluginEnable["txindex"] = !mcfg.DisableTxIndex
 	exec.pluginEnable["fee"] = !mcfg.DisableFeeIndex
 	exec.pluginEnable[addrFeeIndex] = mcfg.EnableAddrFeeIndex
+	exec.pluginEnable[evmLogIndex] = !mcfg.DisableEvmLogIndex
+	exec.enableParallel = mcfg.EnableParallelExec
+	exec.parallelWorkers = int(mcfg.ParallelExecWorkers)
+	if exec.parallelWorkers <= 0 {
+		exec.parallelWorkers = runtime.NumCPU()
+	}
 	exec.noneDriverPool = &sync.Pool{
 		New: func() interface{} {
 			none, err := drivers.LoadDriver("none", 0)
@@ -135,6 +144,8 @@ func (exec *Executor) SetQueueClient(qcli queue.Client) {
 				go exec.procExecCheckTx(msg)
 			} else if msg.Ty == types.EventBlockChainQuery {
 				go exec.procExecQuery(msg)
+			} else if msg.Ty == types.EventTraceTx {
+				go exec.procTraceTx(msg)
 			} else if msg.Ty == types.EventUpgrade {
 				//执行升级过程中不允许执行其他的事件，这个事件直接不采用异步执行
 				exec.procUpgrade(msg)
@@ -305,6 +316,8 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 		}
 	}()
 	datas := msg.GetData().(*types.ExecTxList)
+	defer blockExecTimer.UpdateSince(time.Now())
+	blockTxsMeter.Mark(int64(len(datas.Txs)))
 	ctx := &executorCtx{
 		stateHash:  datas.StateHash,
 		height:     datas.Height,
@@ -321,6 +334,7 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 	}
 	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
 	execute.enableMVCC(nil)
+	parallel := exec.isParallelExec(execute.cfg, datas.Height)
 	var receipts []*types.Receipt
 	index := 0
 	for i := 0; i < len(datas.Txs); i++ {
@@ -330,6 +344,24 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 			receipts = append(receipts, types.NewErrReceipt(types.ErrTxGroupCount))
 			continue
 		}
+		//连续的非交易组交易并行执行
+		if tx.GroupCount == 0 && parallel {
+			end := i + 1
+			for end < len(datas.Txs) && datas.Txs[end].GroupCount == 0 {
+				end++
+			}
+			if end-i > 1 {
+				receiptlist, nextIndex, err := execute.execTxsParallel(datas.Txs[i:end], index)
+				if err != nil {
+					msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
+					return
+				}
+				receipts = append(receipts, receiptlist...)
+				index = nextIndex
+				i = end - 1
+				continue
+			}
+		}
 		if tx.GroupCount == 0 {
 			receipt, err := execute.execTx(exec, tx, index)
 			if api.IsAPIEnvError(err) {
@@ -0,0 +1,14 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	blockExecTimer = metrics.NewRegisteredTimer("executor/block/exectime", nil) // 区块交易执行耗时
+	blockTxsMeter  = metrics.NewRegisteredMeter("executor/block/txs", nil)      // 执行的交易数量
+)
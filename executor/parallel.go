@@ -0,0 +1,168 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client/api"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+交易并行执行(乐观执行):
+1. 区块中连续的非交易组交易, 分配到多个协程中, 以区块当前状态为基础分别预执行, 记录每笔交易读取的状态key
+2. 按照交易在区块中的顺序依次提交预执行结果, 如果交易读取的key被之前的交易修改过, 即存在冲突, 则顺序重新执行
+3. 预执行无法确定结果的交易(执行panic, 需要访问localdb, 交易索引与顺序执行不一致等), 同样顺序重新执行
+提交的状态和收据与顺序执行完全一致
+*/
+
+// 单笔交易的预执行结果
+type parallelResult struct {
+	index    int
+	receipt  *types.Receipt
+	err      error
+	readKeys map[string]struct{}
+	//预执行结果不可用, 需要顺序重新执行
+	rerun bool
+}
+
+func (r *parallelResult) isConflict(written map[string]struct{}) bool {
+	for key := range r.readKeys {
+		if _, ok := written[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// 是否开启交易并行执行, 依赖的分叉需要全部生效, 以保证和顺序执行的结果一致
+func (exec *Executor) isParallelExec(cfg *types.Chain33Config, height int64) bool {
+	if !exec.enableParallel || height <= 0 || exec.pluginEnable["mvcc"] {
+		return false
+	}
+	return cfg.IsFork(height, "ForkParallelExec") &&
+		cfg.IsFork(height, "ForkExecRollback") &&
+		cfg.IsFork(height, "ForkStateDBSet") &&
+		cfg.IsFork(height, "ForkLocalDBAccess") &&
+		cfg.IsFork(height, "ForkCacheDriver")
+}
+
+// 创建预执行交易的执行环境, 不访问localdb, 状态数据只读共享
+func (e *executor) newParallelExecutor() *executor {
+	return &executor{
+		coinsAccount: account.NewCoinsAccount(e.cfg),
+		ctx:          e.ctx,
+		height:       e.height,
+		blocktime:    e.blocktime,
+		difficulty:   e.difficulty,
+		txs:          e.txs,
+		api:          e.api,
+		gcli:         e.gcli,
+		receipts:     e.receipts,
+		driverCache:  make(map[string]drivers.Driver),
+		currTxIdx:    -1,
+		cfg:          e.cfg,
+		exec:         e.exec,
+	}
+}
+
+// 以parent的状态为基础预执行交易
+func (e *executor) execTxSpeculative(parent *StateDB, tx *types.Transaction, index int) (r *parallelResult) {
+	r = &parallelResult{index: index}
+	defer func() {
+		if err := recover(); err != nil {
+			elog.Debug("execTxSpeculative", "index", index, "err", err)
+			r.rerun = true
+		}
+	}()
+	opt := &StateDBOption{Height: e.height}
+	statedb := NewStateDB(parent.client, parent.stateHash, nil, opt).(*StateDB)
+	statedb.parent = parent
+	statedb.readKeys = make(map[string]struct{})
+	e.stateDB = statedb
+	e.coinsAccount.SetDB(statedb)
+	r.readKeys = statedb.readKeys
+	//执行时同时写localdb的交易不能并行
+	if e.isExecLocalSameTime(tx, index) {
+		r.rerun = true
+		return r
+	}
+	r.receipt, r.err = e.execTx(e.exec, tx, index)
+	if api.IsAPIEnvError(r.err) {
+		r.rerun = true
+		return r
+	}
+	//执行panic可能是访问localdb导致, 以顺序执行的结果为准
+	for _, l := range r.receipt.GetLogs() {
+		if l.Ty == types.TyLogErr && string(l.Log) == types.ErrExecPanic.Error() {
+			r.rerun = true
+			break
+		}
+	}
+	return r
+}
+
+// execTxsParallel 并行执行一组非交易组交易, 返回和顺序执行一致的收据以及下一笔交易的索引
+func (e *executor) execTxsParallel(txs []*types.Transaction, index int) ([]*types.Receipt, int, error) {
+	parent := e.stateDB.(*StateDB)
+	results := make([]*parallelResult, len(txs))
+	workers := e.exec.parallelWorkers
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	ch := make(chan int, len(txs))
+	for i := range txs {
+		ch <- i
+	}
+	close(ch)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker := e.newParallelExecutor()
+			for i := range ch {
+				//预测交易索引, 假设之前的交易全部执行成功
+				results[i] = worker.execTxSpeculative(parent, txs[i], index+i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	receipts := make([]*types.Receipt, 0, len(txs))
+	written := make(map[string]struct{})
+	var rerun int
+	for i, tx := range txs {
+		r := results[i]
+		receipt, err := r.receipt, r.err
+		if r.rerun || r.index != index || r.isConflict(written) {
+			rerun++
+			receipt, err = e.execTx(e.exec, tx, index)
+			if api.IsAPIEnvError(err) {
+				return nil, 0, err
+			}
+		} else if err == nil {
+			for _, kv := range receipt.KV {
+				if err := e.stateDB.Set(kv.Key, kv.Value); err != nil {
+					panic(err)
+				}
+			}
+		}
+		if err != nil {
+			receipts = append(receipts, types.NewErrReceipt(err))
+			continue
+		}
+		for _, kv := range receipt.KV {
+			written[string(kv.Key)] = struct{}{}
+		}
+		receipts = append(receipts, receipt)
+		index++
+	}
+	elog.Debug("execTxsParallel", "height", e.height, "txs", len(txs), "rerun", rerun)
+	return receipts, index, nil
+}
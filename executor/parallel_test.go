@@ -0,0 +1,79 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func execBlocks(t *testing.T, parallel bool, blocks []*types.Block) []*types.BlockDetail {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	cfg.GetModuleConfig().Exec.EnableParallelExec = parallel
+	cfg.GetModuleConfig().Exec.ParallelExecWorkers = 4
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	prev := cfg.GetMinTxFeeRate()
+	cfg.SetMinFee(100000)
+	defer cfg.SetMinFee(prev)
+	mock33.WaitHeight(0)
+	stateHash := mock33.GetBlock(0).StateHash
+	var details []*types.BlockDetail
+	for _, block := range blocks {
+		block = types.Clone(block).(*types.Block)
+		detail, _, err := util.ExecBlock(mock33.GetClient(), stateHash, block, false, true, false)
+		require.Nil(t, err)
+		details = append(details, detail)
+		stateHash = detail.Block.StateHash
+	}
+	return details
+}
+
+func TestExecTxsParallel(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	genkey := testnode.NewWithConfig(cfg, nil)
+	genesis := genkey.GetBlock(0)
+	priv := genkey.GetGenesisKey()
+	genkey.Close()
+
+	n := 20
+	addrs := make([]string, n)
+	privs := make([]crypto.PrivKey, n)
+	var txs1 []*types.Transaction
+	for i := 0; i < n; i++ {
+		addrs[i], privs[i] = util.Genaddress()
+		txs1 = append(txs1, util.CreateCoinsTx(cfg, priv, addrs[i], 10*types.DefaultCoinPrecision))
+	}
+	block1 := util.CreateNewBlock(cfg, genesis, txs1)
+
+	var txs2 []*types.Transaction
+	for i := 0; i < n; i++ {
+		to, _ := util.Genaddress()
+		txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[i], to, types.DefaultCoinPrecision))
+	}
+	//存在冲突的交易
+	for i := 0; i < 5; i++ {
+		txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[i], addrs[i+1], types.DefaultCoinPrecision))
+	}
+	//余额不足的交易
+	txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[n-1], addrs[0], 100*types.DefaultCoinPrecision))
+	block2 := util.CreateNewBlock(cfg, block1, txs2)
+
+	seq := execBlocks(t, false, []*types.Block{block1, block2})
+	par := execBlocks(t, true, []*types.Block{block1, block2})
+	for i := range seq {
+		assert.Equal(t, seq[i].Block.StateHash, par[i].Block.StateHash)
+		assert.Equal(t, len(seq[i].Block.Txs), len(par[i].Block.Txs))
+		assert.Equal(t, types.Encode(&types.BlockDetail{Receipts: seq[i].Receipts}),
+			types.Encode(&types.BlockDetail{Receipts: par[i].Receipts}))
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const evmLogIndex = "evmlogindex"
+
+func init() {
+	RegisterPlugin(evmLogIndex, &evmLogIndexPlugin{})
+}
+
+//按区块保存evm日志的合约地址和topic布隆过滤器, 用于eth_getLogs按区间查询时跳过不相关的区块
+//flag 记录索引的起始高度+1, 即开启插件后第一个包含evm日志的区块, 起始高度之前的区块没有索引
+type evmLogIndexPlugin struct {
+	pluginBase
+}
+
+func (p *evmLogIndexPlugin) CheckEnable(executor *executor, enable bool) (kvs []*types.KeyValue, ok bool, err error) {
+	return nil, enable, nil
+}
+
+func (p *evmLogIndexPlugin) ExecLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	bloom, err := calcEvmLogBloom(data)
+	if err != nil || bloom == nil {
+		return nil, err
+	}
+	kvs := []*types.KeyValue{{Key: types.CalcEvmLogBloomKey(data.Block.Height), Value: bloom.Bytes()}}
+	kv, err := p.checkStartFlag(executor, data.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	if kv != nil {
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+func (p *evmLogIndexPlugin) ExecDelLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	kvs := []*types.KeyValue{{Key: types.CalcEvmLogBloomKey(data.Block.Height)}}
+	flag, err := loadFlag(executor.localDB, types.FlagEvmLogIndex)
+	if err != nil {
+		return nil, err
+	}
+	if flag == data.Block.Height+1 {
+		kvs = append(kvs, &types.KeyValue{Key: types.FlagEvmLogIndex})
+	}
+	return kvs, nil
+}
+
+// 首次记录布隆过滤器时记录起始高度, 多个节点实例共享插件, 不缓存flag
+func (p *evmLogIndexPlugin) checkStartFlag(executor *executor, height int64) (*types.KeyValue, error) {
+	flag, err := loadFlag(executor.localDB, types.FlagEvmLogIndex)
+	if err != nil || flag != 0 {
+		return nil, err
+	}
+	return types.FlagKV(types.FlagEvmLogIndex, height+1), nil
+}
+
+// 区块中没有evm日志时返回nil
+func calcEvmLogBloom(data *types.BlockDetail) (*etypes.Bloom, error) {
+	var bloom etypes.Bloom
+	var count int
+	for i, tx := range data.Block.Txs {
+		contractAddr, logs, err := types.DecodeEVMTxLogs(tx, data.Receipts[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		bloom.Add(common.HexToAddress(contractAddr).Bytes())
+		for _, l := range logs {
+			for _, topic := range l.Topic {
+				bloom.Add(common.BytesToHash(topic).Bytes())
+			}
+		}
+		count += len(logs)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	return &bloom, nil
+}
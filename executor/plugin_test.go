@@ -6,6 +6,8 @@ import (
 
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,3 +74,56 @@ func TestPluginBase(t *testing.T) {
 	_, _, err = base.checkFlag(executor, k, true)
 	assert.NoError(t, err)
 }
+
+func TestEvmLogIndexPlugin(t *testing.T) {
+	exec, _ := initEnv(types.GetDefaultCfgstring())
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	ctx := &executorCtx{height: 5, blocktime: time.Now().Unix(), difficulty: 1}
+	contract := "0x3ea9e4f2a3d1c4e1d5e24ae3b1f7dd93a58acd11"
+	topic := common.HexToHash("0x01").Bytes()
+	evmLog := &types.EVMLog{Topic: [][]byte{topic}, Data: []byte("data")}
+	//log类型由evm合约注册
+	types.RegisterEVMLogTy(603, 605)
+	txs := []*types.Transaction{
+		{Execer: []byte("evm"), Payload: types.Encode(&types.EVMContractAction4Chain33{ContractAddr: contract})},
+		{Execer: []byte("coins")},
+	}
+	detail := &types.BlockDetail{
+		Block: &types.Block{Txs: txs, Height: 5},
+		Receipts: []*types.ReceiptData{
+			{Ty: types.ExecOk, Logs: []*types.ReceiptLog{{Ty: 605, Log: types.Encode(evmLog)}}},
+			{Ty: types.ExecOk},
+		},
+	}
+	plugin := globalPlugins[evmLogIndex]
+	executor := newExecutor(ctx, exec, kvdb, txs, nil)
+	kvs, err := plugin.ExecLocal(executor, detail)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kvs))
+	for _, kv := range kvs {
+		assert.NoError(t, kvdb.Set(kv.Key, kv.Value))
+	}
+	flag, err := loadFlag(kvdb, types.FlagEvmLogIndex)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), flag)
+	value, err := kvdb.Get(types.CalcEvmLogBloomKey(5))
+	assert.NoError(t, err)
+	bloom := etypes.BytesToBloom(value)
+	assert.True(t, bloom.Test(common.HexToAddress(contract).Bytes()))
+	assert.True(t, bloom.Test(topic))
+	assert.False(t, bloom.Test(common.HexToHash("0x02").Bytes()))
+
+	//执行失败的交易不记录日志, 已有起始高度时不再记录flag
+	detail.Block.Height = 6
+	detail.Receipts[0].Ty = types.ExecPack
+	kvs, err = plugin.ExecLocal(executor, detail)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(kvs))
+
+	detail.Block.Height = 5
+	kvs, err = plugin.ExecDelLocal(executor, detail)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(kvs))
+	assert.Nil(t, kvs[1].Value)
+}
@@ -24,6 +24,12 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时, 只读的区块状态缓存, 以及当前交易读取的key集合
+	parent   *StateDB
+	readKeys map[string]struct{}
+	//交易追踪时记录读取的状态数据
+	tracing bool
+	reads   []*types.KeyValue
 }
 
 // StateDBOption state db option enable mvcc
@@ -108,11 +114,30 @@ func (s *StateDB) resetTx() {
 func (s *StateDB) Get(key []byte) ([]byte, error) {
 	v, err := s.get(key)
 	//debugAccount("==get==", key, v)
+	if s.tracing {
+		s.reads = append(s.reads, &types.KeyValue{Key: append([]byte{}, key...), Value: v})
+	}
 	return v, err
 }
 
-func (s *StateDB) get(key []byte) ([]byte, error) {
+//startTrace 开始记录读取的状态数据, 清除之前的记录
+func (s *StateDB) startTrace() {
+	s.tracing = true
+	s.reads = nil
+}
+
+//stopTrace 停止记录并返回期间读取的状态数据
+func (s *StateDB) stopTrace() []*types.KeyValue {
+	reads := s.reads
+	s.tracing = false
+	s.reads = nil
+	return reads
+}
 
+func (s *StateDB) get(key []byte) ([]byte, error) {
+	if s.readKeys != nil {
+		s.readKeys[string(key)] = struct{}{}
+	}
 	if s.intx {
 		if value, exist, err := s.txcache.Get(key); exist {
 			return value, err
@@ -121,6 +146,12 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 	if value, exist, err := s.cache.Get(key); exist {
 		return value, err
 	}
+	//并行执行期间parent不会被修改, 可以并发读取
+	if s.parent != nil {
+		if value, exist, err := s.parent.cache.Get(key); exist {
+			return value, err
+		}
+	}
 	//mvcc 是有效的情况下，直接从mvcc中获取
 	if s.version >= 0 {
 		data, err := s.local.GetV(key, s.version)
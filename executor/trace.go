@@ -0,0 +1,257 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/client/api"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+)
+
+const (
+	traceStepFee  = "fee"
+	traceStepExec = "exec"
+)
+
+//txTracer 记录追踪执行期间每个步骤的状态读写以及日志
+type txTracer struct {
+	name    string
+	from    string
+	state   *StateDB
+	steps   []*types.TraceStep
+	results map[string][]byte
+	err     error
+}
+
+func newTxTracer(state *StateDB, name, from string) *txTracer {
+	return &txTracer{name: name, from: from, state: state, results: make(map[string][]byte)}
+}
+
+func (t *txTracer) reset() {
+	t.steps = nil
+	t.results = make(map[string][]byte)
+}
+
+//addStep 记录一个执行步骤, kvStart, logStart 为receipt中本步骤之前已经存在的数据
+func (t *txTracer) addStep(name string, tx *types.Transaction, receipt *types.Receipt, kvStart, logStart int, err error) {
+	step := &types.TraceStep{Name: name, TxHash: tx.Hash(), Reads: t.state.stopTrace()}
+	if receipt != nil {
+		if kvStart < len(receipt.KV) {
+			step.Writes = receipt.KV[kvStart:]
+		}
+		if logStart < len(receipt.Logs) {
+			step.Logs = receipt.Logs[logStart:]
+		}
+	}
+	if err != nil {
+		step.Err = err.Error()
+	}
+	t.steps = append(t.steps, step)
+}
+
+//txTrace 汇总单笔交易的执行步骤
+func (t *txTracer) txTrace(tx *types.Transaction, receipt *types.Receipt, err error) *types.TxTrace {
+	hash := tx.Hash()
+	trace := &types.TxTrace{Hash: hash, Execer: string(tx.Execer), Ty: types.ExecErr, Result: t.results[string(hash)]}
+	if receipt != nil {
+		trace.Ty = receipt.Ty
+	}
+	if err != nil {
+		trace.Err = err.Error()
+	}
+	for _, step := range t.steps {
+		if string(step.TxHash) == string(hash) {
+			trace.Steps = append(trace.Steps, step)
+		}
+	}
+	return trace
+}
+
+//traceExecFee 追踪执行时记录手续费扣除步骤
+func (e *executor) traceExecFee(tx *types.Transaction, index int) (*types.Receipt, error) {
+	if e.tracer == nil {
+		return e.execFee(tx, index)
+	}
+	e.tracer.state.startTrace()
+	feelog, err := e.execFee(tx, index)
+	e.tracer.addStep(traceStepFee, tx, feelog, 0, 0, err)
+	return feelog, err
+}
+
+//traceExecTxOne 追踪执行时记录执行器执行步骤, 并为执行器设置注册的tracer
+func (e *executor) traceExecTxOne(feelog *types.Receipt, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if e.tracer == nil {
+		return e.execTxOne(feelog, tx, index)
+	}
+	driver := e.loadDriver(tx, index)
+	tracer, err := drivers.NewTracer(driver.GetDriverName(), e.tracer.name)
+	if err != nil {
+		e.tracer.err = err
+	}
+	driver.SetTraceContext(&drivers.TraceContext{Tracer: tracer, From: e.tracer.from})
+	defer driver.SetTraceContext(nil)
+
+	kvStart, logStart := len(feelog.KV), len(feelog.Logs)
+	e.tracer.state.startTrace()
+	receipt, err := e.execTxOne(feelog, tx, index)
+	e.tracer.addStep(traceStepExec, tx, receipt, kvStart, logStart, err)
+	if tracer != nil {
+		result, rerr := tracer.Result()
+		if rerr != nil {
+			e.tracer.err = rerr
+		}
+		e.tracer.results[string(tx.Hash())] = result
+	}
+	return receipt, err
+}
+
+//traceCall 追踪预执行调用, 不检查交易也不扣除手续费, 执行结果全部回滚
+func (e *executor) traceCall(tx *types.Transaction, index int) (*types.Receipt, error) {
+	e.begin()
+	defer e.rollback()
+	return e.traceExecTxOne(&types.Receipt{Ty: types.ExecPack}, tx, index)
+}
+
+func (exec *Executor) procTraceTx(msg *queue.Message) {
+	//panic 处理
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Error("trace tx panic error", "err", r, "stack", GetStack())
+			msg.Reply(exec.client.NewMessage("", types.EventTraceTx, types.ErrExecPanic))
+			return
+		}
+	}()
+	traces, err := exec.traceTxList(msg.GetData().(*types.ReqTraceTx))
+	if err != nil {
+		msg.Reply(exec.client.NewMessage("", types.EventTraceTx, err))
+		return
+	}
+	msg.Reply(exec.client.NewMessage("", types.EventTraceTx, traces))
+}
+
+//traceTxList 在父区块状态上按区块执行顺序重新执行交易列表, 记录指定交易的执行追踪
+//执行结果不会写入statedb以及localdb
+func (exec *Executor) traceTxList(req *types.ReqTraceTx) (*types.TxTraces, error) {
+	datas := req.GetTxList()
+	if datas == nil || len(datas.Txs) == 0 || int(req.Index) >= len(datas.Txs) {
+		return nil, types.ErrInvalidParam
+	}
+	if req.From != "" && (req.Index < 0 || datas.Txs[req.Index].GroupCount != 0) {
+		return nil, types.ErrInvalidParam
+	}
+	ctx := &executorCtx{
+		stateHash:  datas.StateHash,
+		height:     datas.Height,
+		blocktime:  datas.BlockTime,
+		difficulty: datas.Difficulty,
+		mainHash:   datas.MainHash,
+		mainHeight: datas.MainHeight,
+		parentHash: datas.ParentHash,
+	}
+	var localdb dbm.KVDB
+	if !exec.disableLocal {
+		//追踪执行只读localdb
+		localdb = NewLocalDB(exec.client, true)
+		defer localdb.(*LocalDB).Close()
+	}
+	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
+	execute.enableMVCC(nil)
+	tracer := newTxTracer(execute.stateDB.(*StateDB), req.Tracer, req.From)
+	isTraced := func(start, count int) bool {
+		return req.Index < 0 || (int(req.Index) >= start && int(req.Index) < start+count)
+	}
+	traces := &types.TxTraces{}
+	index := 0
+	for i := 0; i < len(datas.Txs); i++ {
+		if req.Index >= 0 && i > int(req.Index) {
+			break
+		}
+		tx := datas.Txs[i]
+		if tx.GroupCount < 0 || tx.GroupCount == 1 || tx.GroupCount > 20 {
+			if isTraced(i, 1) {
+				traces.Traces = append(traces.Traces, tracer.txTrace(tx, nil, types.ErrTxGroupCount))
+			}
+			continue
+		}
+		if tx.GroupCount == 0 {
+			traced := isTraced(i, 1)
+			if traced {
+				tracer.reset()
+				execute.tracer = tracer
+			}
+			var receipt *types.Receipt
+			var err error
+			if traced && req.From != "" {
+				receipt, err = execute.traceCall(tx, index)
+			} else {
+				receipt, err = execute.execTx(exec, tx, index)
+			}
+			execute.tracer = nil
+			if api.IsAPIEnvError(err) {
+				return nil, err
+			}
+			if traced {
+				if tracer.err != nil {
+					return nil, tracer.err
+				}
+				traces.Traces = append(traces.Traces, tracer.txTrace(tx, receipt, err))
+			}
+			if err == nil {
+				index++
+			}
+			continue
+		}
+		//所有tx.GroupCount > 0 的交易都是错误的交易
+		count := int(tx.GroupCount)
+		if !execute.cfg.IsFork(datas.Height, "ForkTxGroup") || i+count > len(datas.Txs) {
+			if isTraced(i, 1) {
+				err := types.ErrTxGroupNotSupport
+				if i+count > len(datas.Txs) {
+					err = types.ErrTxGroupCount
+				}
+				traces.Traces = append(traces.Traces, tracer.txTrace(tx, nil, err))
+			}
+			continue
+		}
+		traced := isTraced(i, count)
+		if traced {
+			tracer.reset()
+			execute.tracer = tracer
+		}
+		receipts, err := execute.execTxGroup(datas.Txs[i:i+count], index)
+		execute.tracer = nil
+		if api.IsAPIEnvError(err) {
+			return nil, err
+		}
+		if traced {
+			if tracer.err != nil {
+				return nil, tracer.err
+			}
+			for n := 0; n < count; n++ {
+				var receipt *types.Receipt
+				if n < len(receipts) {
+					receipt = receipts[n]
+				}
+				traces.Traces = append(traces.Traces, tracer.txTrace(datas.Txs[i+n], receipt, err))
+			}
+		}
+		i = i + count - 1
+		if err == nil {
+			index += count
+		}
+	}
+	//只追踪单笔交易时, 交易组中只返回指定的交易
+	if req.Index >= 0 {
+		hash := string(datas.Txs[req.Index].Hash())
+		for _, trace := range traces.Traces {
+			if string(trace.Hash) == hash {
+				return &types.TxTraces{Traces: []*types.TxTrace{trace}}, nil
+			}
+		}
+	}
+	return traces, nil
+}
@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/require"
+)
+
+type testTracer struct {
+	name string
+}
+
+func (t *testTracer) Result() ([]byte, error) {
+	return []byte(`{"tracer":"` + t.name + `"}`), nil
+}
+
+func TestTraceTx(t *testing.T) {
+	drivers.RegisterTracer("coins", func(name string) (drivers.Tracer, error) {
+		if name == "" {
+			return nil, nil
+		}
+		if name != "testTracer" {
+			return nil, types.ErrNotSupport
+		}
+		return &testTracer{name: name}, nil
+	})
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	prev := cfg.GetMinTxFeeRate()
+	cfg.SetMinFee(100000)
+	defer cfg.SetMinFee(prev)
+	mock33.WaitHeight(0)
+	genesis := mock33.GetBlock(0)
+	priv := mock33.GetGenesisKey()
+	to, _ := util.Genaddress()
+	txs := []*types.Transaction{
+		util.CreateCoinsTx(cfg, priv, to, types.DefaultCoinPrecision),
+		util.CreateCoinsTx(cfg, priv, to, 2*types.DefaultCoinPrecision),
+		//余额不足的交易
+		util.CreateCoinsTx(cfg, priv, to, 200000000*types.DefaultCoinPrecision),
+	}
+	block := util.CreateNewBlock(cfg, genesis, txs)
+	detail, _, err := util.ExecBlock(mock33.GetClient(), genesis.StateHash, types.Clone(block).(*types.Block), false, true, false)
+	require.Nil(t, err)
+	list := &types.ExecTxList{
+		StateHash:  genesis.StateHash,
+		ParentHash: block.ParentHash,
+		Txs:        block.Txs,
+		BlockTime:  block.BlockTime,
+		Height:     block.Height,
+		Difficulty: uint64(block.Difficulty),
+	}
+
+	api := mock33.GetAPI()
+	traces, err := api.TraceTx(&types.ReqTraceTx{TxList: list, Index: -1})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(traces.Traces))
+	for i, trace := range traces.Traces {
+		require.Equal(t, txs[i].Hash(), trace.Hash)
+		require.Equal(t, detail.Receipts[i].Ty, trace.Ty)
+		require.Equal(t, 2, len(trace.Steps))
+		require.Equal(t, "fee", trace.Steps[0].Name)
+		require.Equal(t, "exec", trace.Steps[1].Name)
+		require.True(t, len(trace.Steps[0].Reads) > 0)
+		require.True(t, len(trace.Steps[0].Writes) > 0)
+		require.Equal(t, types.Encode(&types.ReceiptData{Logs: detail.Receipts[i].Logs}),
+			types.Encode(&types.ReceiptData{Logs: append(trace.Steps[0].Logs, trace.Steps[1].Logs...)}))
+		require.Nil(t, trace.Result)
+	}
+	require.True(t, len(traces.Traces[0].Steps[1].Writes) > 0)
+	require.Equal(t, int32(types.ExecPack), traces.Traces[2].Ty)
+	require.Nil(t, traces.Traces[2].Steps[1].Writes)
+	require.Equal(t, types.ErrNoBalance.Error(), traces.Traces[2].Steps[1].Err)
+
+	//单笔交易追踪需要在之前交易执行后的状态上进行
+	traces, err = api.TraceTx(&types.ReqTraceTx{TxList: list, Index: 1, Tracer: "testTracer"})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(traces.Traces))
+	require.Equal(t, txs[1].Hash(), traces.Traces[0].Hash)
+	require.Equal(t, `{"tracer":"testTracer"}`, string(traces.Traces[0].Result))
+	_, err = api.TraceTx(&types.ReqTraceTx{TxList: list, Index: 1, Tracer: "unknown"})
+	require.Equal(t, types.ErrNotSupport, err)
+	_, err = api.TraceTx(&types.ReqTraceTx{TxList: list, Index: 3})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	//预执行调用不扣除手续费
+	traces, err = api.TraceTx(&types.ReqTraceTx{TxList: list, Index: 0, From: mock33.GetGenesisAddress()})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(traces.Traces))
+	require.Equal(t, 1, len(traces.Traces[0].Steps))
+	require.Equal(t, "exec", traces.Traces[0].Steps[0].Name)
+	require.Equal(t, int32(types.ExecOk), traces.Traces[0].Ty)
+}
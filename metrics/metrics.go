@@ -5,6 +5,7 @@ import (
 
 	chain33log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/metrics/influxdb"
+	"github.com/33cn/chain33/metrics/prometheus"
 	"github.com/33cn/chain33/types"
 	go_metrics "github.com/rcrowley/go-metrics"
 )
@@ -19,6 +20,14 @@ type influxDBPara struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+type prometheusPara struct {
+	// http服务监听地址
+	ListenAddr string `json:"listenAddr,omitempty"`
+	// 指标输出路径, 默认/metrics
+	Path      string `json:"path,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
 var (
 	log = chain33log.New("module", "chain33 metrics")
 )
@@ -53,6 +62,18 @@ func StartMetrics(cfg *types.Chain33Config) {
 			influxdbcfg.Username,
 			influxdbcfg.Password,
 			"")
+	case "prometheus":
+		sub := cfg.GetSubConfig().Metrics
+		subcfg, ok := sub[metrics.DataEmitMode]
+		if !ok {
+			log.Error("nil parameter for prometheus")
+			return
+		}
+		var promcfg prometheusPara
+		types.MustDecode(subcfg, &promcfg)
+		log.Info("StartMetrics with prometheus", "listenAddr", promcfg.ListenAddr,
+			"path", promcfg.Path, "namespace", promcfg.Namespace)
+		go prometheus.Serve(go_metrics.DefaultRegistry, promcfg.ListenAddr, promcfg.Path, promcfg.Namespace)
 	default:
 		log.Error("startMetrics", "The dataEmitMode set is not supported now ", metrics.DataEmitMode)
 		return
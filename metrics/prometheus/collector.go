@@ -0,0 +1,87 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	// 汇总类型统计的分位点
+	quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999}
+
+	typeCounter = "counter"
+	typeGauge   = "gauge"
+	typeSummary = "summary"
+)
+
+// collector 将go-metrics中的统计项按prometheus文本格式输出
+type collector struct {
+	buff      *bytes.Buffer
+	namespace string
+}
+
+func newCollector(namespace string) *collector {
+	return &collector{
+		buff:      &bytes.Buffer{},
+		namespace: namespace,
+	}
+}
+
+func (c *collector) addCounter(name string, count int64) {
+	c.writeType(name, typeCounter)
+	c.writeValue(name, "", float64(count))
+}
+
+func (c *collector) addGauge(name string, value float64) {
+	c.writeType(name, typeGauge)
+	c.writeValue(name, "", value)
+}
+
+// addMeter meter累计值按counter输出, 速率按gauge输出
+func (c *collector) addMeter(name string, m metrics.Meter) {
+	c.addCounter(name, m.Count())
+	c.addGauge(name+"_rate1m", m.Rate1())
+}
+
+// addHistogram histogram和timer均按summary输出
+func (c *collector) addHistogram(name string, h metrics.Histogram) {
+	c.addSummary(name, h.Count(), h.Sum(), h.Percentiles(quantiles))
+}
+
+func (c *collector) addTimer(name string, t metrics.Timer) {
+	c.addSummary(name, t.Count(), t.Sum(), t.Percentiles(quantiles))
+}
+
+func (c *collector) addSummary(name string, count, sum int64, values []float64) {
+	c.writeType(name, typeSummary)
+	for i, q := range quantiles {
+		c.writeValue(name, fmt.Sprintf(`{quantile="%s"}`, strconv.FormatFloat(q, 'f', -1, 64)), values[i])
+	}
+	c.writeValue(name+"_sum", "", float64(sum))
+	c.writeValue(name+"_count", "", float64(count))
+}
+
+func (c *collector) writeType(name, typ string) {
+	fmt.Fprintf(c.buff, "# TYPE %s %s\n", c.metricName(name), typ)
+}
+
+func (c *collector) writeValue(name, labels string, value float64) {
+	fmt.Fprintf(c.buff, "%s%s %s\n", c.metricName(name), labels, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// metricName prometheus指标名只能包含字母, 数字, 下划线和冒号
+func (c *collector) metricName(name string) string {
+	if c.namespace != "" {
+		name = c.namespace + "_" + name
+	}
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
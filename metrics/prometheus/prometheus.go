@@ -0,0 +1,69 @@
+package prometheus
+
+import (
+	"net/http"
+	"sort"
+
+	chain33log "github.com/33cn/chain33/common/log/log15"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	log = chain33log.New("module", "prometheus")
+)
+
+// Handler 返回以prometheus文本格式输出registry中所有统计项的http handler
+func Handler(reg metrics.Registry, namespace string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, err := w.Write(Gather(reg, namespace))
+		if err != nil {
+			log.Debug("Handler", "write err", err)
+		}
+	})
+}
+
+// Gather 按名称排序输出registry中的统计项
+func Gather(reg metrics.Registry, namespace string) []byte {
+	all := make(map[string]interface{})
+	reg.Each(func(name string, i interface{}) {
+		all[name] = i
+	})
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c := newCollector(namespace)
+	for _, name := range names {
+		switch m := all[name].(type) {
+		case metrics.Counter:
+			c.addCounter(name, m.Snapshot().Count())
+		case metrics.Gauge:
+			c.addGauge(name, float64(m.Snapshot().Value()))
+		case metrics.GaugeFloat64:
+			c.addGauge(name, m.Snapshot().Value())
+		case metrics.Meter:
+			c.addMeter(name, m.Snapshot())
+		case metrics.Histogram:
+			c.addHistogram(name, m.Snapshot())
+		case metrics.Timer:
+			c.addTimer(name, m.Snapshot())
+		}
+	}
+	return c.buff.Bytes()
+}
+
+// Serve 在指定地址启动prometheus http服务
+func Serve(reg metrics.Registry, addr, path, namespace string) {
+	if path == "" {
+		path = "/metrics"
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, Handler(reg, namespace))
+	log.Info("Starting prometheus metrics server", "addr", addr, "path", path)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Error("Prometheus metrics server failed", "err", err)
+	}
+}
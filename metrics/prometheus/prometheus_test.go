@@ -0,0 +1,50 @@
+package prometheus
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGather(t *testing.T) {
+	reg := metrics.NewRegistry()
+	metrics.NewRegisteredCounter("mempool/reject/ErrTxExpire", reg).Inc(3)
+	metrics.NewRegisteredGauge("blockchain/height", reg).Update(100)
+	metrics.NewRegisteredGaugeFloat64("test/float", reg).Update(1.5)
+	metrics.NewRegisteredMeter("disk/write", reg).Mark(10)
+	h := metrics.NewRegisteredHistogram("blockchain/reorg/depth", reg, metrics.NewUniformSample(100))
+	h.Update(1)
+	h.Update(3)
+	metrics.NewRegisteredTimer("rpc/jsonrpc/Chain33.GetBlocks/latency", reg).Update(2)
+
+	out := string(Gather(reg, "chain33"))
+	require.Contains(t, out, "# TYPE chain33_mempool_reject_ErrTxExpire counter\nchain33_mempool_reject_ErrTxExpire 3\n")
+	require.Contains(t, out, "# TYPE chain33_blockchain_height gauge\nchain33_blockchain_height 100\n")
+	require.Contains(t, out, "# TYPE chain33_test_float gauge\nchain33_test_float 1.5\n")
+	require.Contains(t, out, "# TYPE chain33_disk_write counter\nchain33_disk_write 10\n")
+	require.Contains(t, out, "# TYPE chain33_disk_write_rate1m gauge\n")
+	require.Contains(t, out, "# TYPE chain33_blockchain_reorg_depth summary\n")
+	require.Contains(t, out, "chain33_blockchain_reorg_depth_sum 4\n")
+	require.Contains(t, out, "chain33_blockchain_reorg_depth_count 2\n")
+	require.Contains(t, out, `chain33_blockchain_reorg_depth{quantile="0.5"}`)
+	require.Contains(t, out, "# TYPE chain33_rpc_jsonrpc_Chain33_GetBlocks_latency summary\n")
+
+	// 按名称排序输出
+	require.True(t, strings.Index(out, "chain33_blockchain_height") < strings.Index(out, "chain33_disk_write"))
+}
+
+func TestHandler(t *testing.T) {
+	reg := metrics.NewRegistry()
+	metrics.NewRegisteredCounter("mempool/reject/ErrDupTx", reg).Inc(1)
+
+	rec := httptest.NewRecorder()
+	Handler(reg, "").ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(rec.Body)
+	require.Nil(t, err)
+	require.Equal(t, "text/plain; version=0.0.4", rec.Header().Get("Content-Type"))
+	require.Equal(t, "# TYPE mempool_reject_ErrDupTx counter\nmempool_reject_ErrDupTx 1\n", string(body))
+}
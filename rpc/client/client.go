@@ -386,6 +386,22 @@ func (c *ChannelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyG
 	return resp, nil
 }
 
+// GetStateProof 获取状态数据的merkle证明, 未指定stateHash时使用最新区块的状态
+func (c *ChannelClient) GetStateProof(in *types.ReqStateProof) (*types.StateProof, error) {
+	if in == nil || len(in.Key) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	req := &types.ReqStateProof{Key: in.Key, StateHash: in.StateHash}
+	if len(req.StateHash) == 0 {
+		header, err := c.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		req.StateHash = header.GetStateHash()
+	}
+	return c.StoreGetProof(req)
+}
+
 // DecodeRawTransaction decode rawtransaction
 func (c *ChannelClient) DecodeRawTransaction(param *types.ReqDecodeRawTransaction) (*types.Transaction, error) {
 	var tx types.Transaction
@@ -520,6 +536,127 @@ func (c *ChannelClient) SignWalletRecoverTx(req *types.ReqSignWalletRecoverTx) (
 	return reply, nil
 }
 
+func (c *ChannelClient) getHTLCScript(param *types.ReqGetHTLCAddr) ([]byte, []byte, error) {
+
+	hashLock, err := common.FromHex(param.GetHashLock())
+	if err != nil {
+		log.Error("getHTLCScript", "hashLock", param.GetHashLock(), "from hex err", err)
+		return nil, nil, types.ErrFromHex
+	}
+	receiverPub, err := common.FromHex(param.GetReceiverPubKey())
+	if err != nil {
+		log.Error("getHTLCScript", "receiver pubKey", param.GetReceiverPubKey(), "from hex err", err)
+		return nil, nil, types.ErrFromHex
+	}
+	refundPub, err := common.FromHex(param.GetRefundPubKey())
+	if err != nil {
+		log.Error("getHTLCScript", "refund pubKey", param.GetRefundPubKey(), "from hex err", err)
+		return nil, nil, types.ErrFromHex
+	}
+	header, err := c.GetLastHeader()
+	if err != nil {
+		log.Error("getHTLCScript", "get last header err", err)
+		return nil, nil, err
+	}
+	err = btcscript.CheckLockTimeFork(c.GetConfig(), header.GetHeight()+1, param.GetLockTime())
+	if err != nil {
+		log.Error("getHTLCScript", "lockTime", param.GetLockTime(), "err", err)
+		return nil, nil, err
+	}
+	htlcScript, err := script.NewHTLCScript(hashLock, receiverPub, refundPub, param.GetLockTime())
+	if err != nil {
+		log.Error("getHTLCScript", "new htlc script err", err)
+		return nil, nil, err
+	}
+	return htlcScript, hashLock, nil
+}
+
+// GetHTLCAddr get hash time lock contract chain33 address
+func (c *ChannelClient) GetHTLCAddr(req *types.ReqGetHTLCAddr) (*types.ReplyString, error) {
+
+	if len(req.GetHashLock()) <= 0 || len(req.GetReceiverPubKey()) <= 0 ||
+		len(req.GetRefundPubKey()) <= 0 || req.GetLockTime() < 1 {
+		log.Error("GetHTLCAddr", "invalid req", req.String())
+		return nil, types.ErrInvalidParam
+	}
+	htlcScript, _, err := c.getHTLCScript(req)
+	if err != nil {
+		log.Error("GetHTLCAddr", "getHTLCScript err", err)
+		return nil, err
+	}
+	reply := &types.ReplyString{}
+	reply.Data = address.PubKeyToAddr(address.GetDefaultAddressID(), script.Script2PubKey(htlcScript))
+	return reply, nil
+}
+
+// SignHTLCTx sign hash time lock contract transaction, claim with preimage or refund after lock time
+func (c *ChannelClient) SignHTLCTx(req *types.ReqSignHTLCTx) (*types.ReplySignRawTx, error) {
+
+	if req.GetHtlcParam() == nil || len(req.GetRawTx()) <= 0 {
+		log.Error("SignHTLCTx", "invalid req", req.String())
+		return nil, types.ErrInvalidParam
+	}
+	privKeyHex := req.PrivKey
+	if privKeyHex == "" {
+		reply, err := c.ExecWalletFunc("wallet", "DumpPrivkey", &types.ReqString{Data: req.SignAddr})
+		if err != nil {
+			log.Error("SignHTLCTx", "execWalletFunc err", err)
+			return nil, err
+		}
+		privKeyHex = reply.(*types.ReplyString).GetData()
+	}
+
+	htlcScript, hashLock, err := c.getHTLCScript(req.GetHtlcParam())
+	if err != nil {
+		log.Error("SignHTLCTx", "getHTLCScript err", err)
+		return nil, err
+	}
+	var preimage []byte
+	if len(req.GetPreimage()) > 0 {
+		preimage, err = common.FromHex(req.GetPreimage())
+		if err != nil {
+			log.Error("SignHTLCTx", "preimage", req.GetPreimage(), "from hex err", err)
+			return nil, types.ErrFromHex
+		}
+		if !bytes.Equal(common.Sha256(preimage), hashLock) {
+			log.Error("SignHTLCTx", "preimage", req.GetPreimage(), "err", "hash lock mismatch")
+			return nil, types.ErrInvalidParam
+		}
+	}
+	tx := &types.Transaction{}
+	txBytes, err := common.FromHex(req.GetRawTx())
+	if err != nil || types.Decode(txBytes, tx) != nil {
+		log.Error("SignHTLCTx", "rawTx", req.GetRawTx(), "decode raw tx err", err)
+		return nil, types.ErrDecode
+	}
+	tx.Signature = nil
+	signMsg := types.Encode(tx)
+	privKey, err := common.FromHex(privKeyHex)
+	if err != nil {
+		log.Error("SignHTLCTx", "privKey from hex err", err)
+		return nil, types.ErrFromHex
+	}
+	var sig, pubKey []byte
+	if len(preimage) > 0 {
+		sig, pubKey, err = script.GetHTLCClaimSignature(signMsg, privKey, htlcScript, preimage)
+	} else {
+		sig, pubKey, err = script.GetHTLCRefundSignature(signMsg, privKey, htlcScript, req.GetHtlcParam().GetLockTime())
+	}
+	if err != nil {
+		log.Error("SignHTLCTx", "get htlc sig err", err)
+		return nil, err
+	}
+
+	tx.Signature = &types.Signature{
+		Ty:        types.EncodeSignID(btcscript.ID, address.GetDefaultAddressID()),
+		Signature: sig,
+		Pubkey:    pubKey,
+	}
+	reply := &types.ReplySignRawTx{}
+	reply.TxHex = hex.EncodeToString(types.Encode(tx))
+	return reply, nil
+}
+
 // GetCryptoList 获取加密算法列表
 func (c *ChannelClient) GetCryptoList() *types.CryptoList {
 	names, ids := crypto.GetCryptoList()
@@ -7,6 +7,7 @@ package client
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/common/crypto"
@@ -21,6 +22,7 @@ import (
 	slog "github.com/33cn/chain33/common/log"
 	"github.com/33cn/chain33/pluginmgr"
 	qmock "github.com/33cn/chain33/queue/mocks"
+	"github.com/33cn/chain33/system/crypto/btcscript/script"
 	cty "github.com/33cn/chain33/system/dapp/coins/types"
 	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
@@ -493,6 +495,71 @@ func TestChannelClient_SignWalletRecoverTx(t *testing.T) {
 	require.Equal(t, types.ErrInvalidParam, err)
 }
 
+func TestChannelClient_HTLC(t *testing.T) {
+	cli := &ChannelClient{}
+	req := &types.ReqGetHTLCAddr{}
+	_, err := cli.GetHTLCAddr(req)
+	require.Equal(t, types.ErrInvalidParam, err)
+	_, err = cli.SignHTLCTx(&types.ReqSignHTLCTx{})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	addr1, priv1 := util.Genaddress()
+	_, priv2 := util.Genaddress()
+	preimage := crypto.CRandBytes(32)
+	req.HashLock = common.ToHex(common.Sha256(preimage))
+	req.ReceiverPubKey = common.ToHex(priv1.PubKey().Bytes())
+	req.RefundPubKey = common.ToHex(priv2.PubKey().Bytes())
+	req.LockTime = 100
+
+	// 分叉之前不支持区块高度类型的锁定时间
+	chainCfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	chainAPI := new(mocks.QueueProtocolAPI)
+	chainAPI.On("GetLastHeader").Return(&types.Header{Height: 10}, nil)
+	chainAPI.On("GetConfig").Return(chainCfg)
+	cli.QueueProtocolAPI = chainAPI
+	_, err = cli.GetHTLCAddr(req)
+	require.Equal(t, script.ErrHeightLockTimeNotEnabled, err)
+
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	tx := util.CreateNoneTx(cfg, nil)
+	mockAPI := new(mocks.QueueProtocolAPI)
+	mockAPI.On("GetLastHeader").Return(&types.Header{Height: 10}, nil)
+	mockAPI.On("GetConfig").Return(cfg)
+	cli.QueueProtocolAPI = mockAPI
+	addr, err := cli.GetHTLCAddr(req)
+	require.Nil(t, err)
+	mockAPI.On("ExecWalletFunc", "wallet", "DumpPrivkey",
+		&types.ReqString{Data: addr1}).Return(&types.ReplyString{Data: hex.EncodeToString(priv1.Bytes())}, nil)
+	signReq := &types.ReqSignHTLCTx{
+		RawTx:     hex.EncodeToString(types.Encode(tx)),
+		HtlcParam: req,
+		SignAddr:  addr1,
+		Preimage:  common.ToHex(crypto.CRandBytes(32)),
+	}
+	_, err = cli.SignHTLCTx(signReq)
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	signReq.Preimage = common.ToHex(preimage)
+	reply, err := cli.SignHTLCTx(signReq)
+	require.Nil(t, err)
+	txByte, err := common.FromHex(reply.TxHex)
+	require.Nil(t, err)
+	require.Nil(t, types.Decode(txByte, tx))
+	require.True(t, tx.CheckSign(0))
+	require.Equal(t, addr.Data, tx.From())
+
+	// 超时退回
+	signReq.Preimage = ""
+	signReq.SignAddr = ""
+	signReq.PrivKey = hex.EncodeToString(priv2.Bytes())
+	reply, err = cli.SignHTLCTx(signReq)
+	require.Nil(t, err)
+	txByte, err = common.FromHex(reply.TxHex)
+	require.Nil(t, err)
+	require.Nil(t, types.Decode(txByte, tx))
+	require.Equal(t, addr.Data, tx.From())
+}
+
 func TestQueueProtocol_GetCryptoList(t *testing.T) {
 	q := &ChannelClient{}
 	list := q.GetCryptoList()
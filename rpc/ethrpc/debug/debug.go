@@ -0,0 +1,221 @@
+package debug
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	rpcclient "github.com/33cn/chain33/rpc/client"
+	"github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	log = log15.New("module", "ethrpc_debug")
+	//ErrGenesisNotTraceable 创世区块没有父区块状态, 无法追踪
+	ErrGenesisNotTraceable = errors.New("ErrGenesisNotTraceable")
+)
+
+type debugHandler struct {
+	cli rpcclient.ChannelClient
+	cfg *ctypes.Chain33Config
+}
+
+//NewDebugAPI create a debug api
+func NewDebugAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) interface{} {
+	d := &debugHandler{}
+	d.cli.Init(c, api)
+	d.cfg = cfg
+	return d
+}
+
+func tracerName(config *types.TraceConfig) string {
+	if config == nil {
+		return ""
+	}
+	return config.Tracer
+}
+
+//getBlock 获取指定高度的区块, 高度为空或者latest时获取最新区块
+func (d *debugHandler) getBlock(number string) (*ctypes.Block, error) {
+	if len(common.FromHex(number)) == 0 {
+		header, err := d.cli.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		return d.getBlockByHeight(header.GetHeight())
+	}
+	return d.getBlockByHeight(new(big.Int).SetBytes(common.FromHex(number)).Int64())
+}
+
+func (d *debugHandler) getBlockByHeight(height int64) (*ctypes.Block, error) {
+	details, err := d.cli.GetBlocks(&ctypes.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.GetItems()) == 0 {
+		return nil, ctypes.ErrBlockNotFound
+	}
+	return details.GetItems()[0].GetBlock(), nil
+}
+
+//blockTxList 以父区块的状态构造区块交易的执行列表
+func (d *debugHandler) blockTxList(block *ctypes.Block) (*ctypes.ExecTxList, error) {
+	if block.GetHeight() <= 0 {
+		return nil, ErrGenesisNotTraceable
+	}
+	headers, err := d.cli.GetHeaders(&ctypes.ReqBlocks{Start: block.Height - 1, End: block.Height - 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.GetItems()) == 0 {
+		return nil, ctypes.ErrBlockNotFound
+	}
+	return &ctypes.ExecTxList{
+		StateHash:  headers.GetItems()[0].GetStateHash(),
+		ParentHash: block.ParentHash,
+		MainHash:   block.MainHash,
+		MainHeight: block.MainHeight,
+		Txs:        block.Txs,
+		BlockTime:  block.BlockTime,
+		Height:     block.Height,
+		Difficulty: uint64(block.Difficulty),
+	}, nil
+}
+
+func (d *debugHandler) traceBlock(block *ctypes.Block, config *types.TraceConfig) ([]*types.TxTraceResult, error) {
+	list, err := d.blockTxList(block)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*types.TxTraceResult, 0, len(list.Txs))
+	if len(list.Txs) == 0 {
+		return results, nil
+	}
+	traces, err := d.cli.TraceTx(&ctypes.ReqTraceTx{TxList: list, Index: -1, Tracer: tracerName(config)})
+	if err != nil {
+		log.Error("traceBlock", "height", block.Height, "err", err)
+		return nil, err
+	}
+	for _, trace := range traces.GetTraces() {
+		results = append(results, types.TxTraceToResult(trace))
+	}
+	return results, nil
+}
+
+//TraceTransaction debug_traceTransaction 在父区块状态上重新执行交易所在区块, 返回交易的执行追踪
+func (d *debugHandler) TraceTransaction(txhash common.Hash, config *types.TraceConfig) (*types.TxTraceResult, error) {
+	log.Debug("TraceTransaction", "txhash", txhash, "config", config)
+	detail, err := d.cli.QueryTx(&ctypes.ReqHash{Hash: txhash.Bytes()})
+	if err != nil {
+		return nil, err
+	}
+	block, err := d.getBlockByHeight(detail.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	list, err := d.blockTxList(block)
+	if err != nil {
+		return nil, err
+	}
+	traces, err := d.cli.TraceTx(&ctypes.ReqTraceTx{TxList: list, Index: int32(detail.GetIndex()), Tracer: tracerName(config)})
+	if err != nil {
+		log.Error("TraceTransaction", "txhash", txhash, "err", err)
+		return nil, err
+	}
+	if len(traces.GetTraces()) == 0 {
+		return nil, ctypes.ErrNotFound
+	}
+	return types.TxTraceToResult(traces.GetTraces()[0]), nil
+}
+
+//TraceBlockByNumber debug_traceBlockByNumber 追踪指定高度区块中的全部交易
+func (d *debugHandler) TraceBlockByNumber(number string, config *types.TraceConfig) ([]*types.TxTraceResult, error) {
+	log.Debug("TraceBlockByNumber", "number", number, "config", config)
+	block, err := d.getBlock(number)
+	if err != nil {
+		return nil, err
+	}
+	return d.traceBlock(block, config)
+}
+
+//TraceBlockByHash debug_traceBlockByHash 追踪指定哈希区块中的全部交易
+func (d *debugHandler) TraceBlockByHash(hash common.Hash, config *types.TraceConfig) ([]*types.TxTraceResult, error) {
+	log.Debug("TraceBlockByHash", "hash", hash, "config", config)
+	details, err := d.cli.GetBlockByHashes(&ctypes.ReqHashes{Hashes: [][]byte{hash.Bytes()}})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.GetItems()) == 0 || details.GetItems()[0] == nil {
+		return nil, ctypes.ErrBlockNotFound
+	}
+	return d.traceBlock(details.GetItems()[0].GetBlock(), config)
+}
+
+//TraceCall debug_traceCall 在指定区块状态上预执行evm调用, 不扣除手续费, 执行结果不提交
+func (d *debugHandler) TraceCall(msg types.CallMsg, tag *string, config *types.TraceConfig) (*types.TxTraceResult, error) {
+	log.Debug("TraceCall", "msg", msg, "tag", tag, "config", config)
+	exec := d.cfg.ExecName("evm")
+	if ctypes.LoadExecutorType(exec) == nil {
+		return nil, ctypes.ErrNotSupport
+	}
+	if msg.To == "" {
+		msg.To = address.ExecAddress(exec)
+	}
+	//未指定调用者时使用零地址
+	if msg.From == "" {
+		msg.From = common.Address{}.String()
+	}
+	var amount uint64
+	if msg.Value != nil && msg.Value.ToInt() != nil {
+		amount = msg.Value.ToInt().Uint64()
+	}
+	action := &ctypes.EVMContractAction4Chain33{Amount: amount, ContractAddr: msg.To}
+	if msg.Gas != nil {
+		action.GasLimit = uint64(*msg.Gas)
+	}
+	if msg.Data != nil {
+		action.Note = msg.Data.String()
+		if msg.To == address.ExecAddress(exec) { //创建合约
+			action.Code = *msg.Data
+		} else {
+			action.Para = *msg.Data
+		}
+	}
+	tx := &ctypes.Transaction{Execer: []byte(exec), Payload: ctypes.Encode(action), To: address.ExecAddress(exec), ChainID: d.cfg.GetChainID()}
+	tx.Nonce = rand.New(rand.NewSource(time.Now().UnixNano())).Int63()
+
+	var number string
+	if tag != nil {
+		number = *tag
+	}
+	block, err := d.getBlock(number)
+	if err != nil {
+		return nil, err
+	}
+	list := &ctypes.ExecTxList{
+		StateHash:  block.StateHash,
+		ParentHash: block.Hash(d.cfg),
+		MainHash:   block.MainHash,
+		MainHeight: block.MainHeight,
+		Txs:        []*ctypes.Transaction{tx},
+		BlockTime:  time.Now().Unix(),
+		Height:     block.Height + 1,
+		Difficulty: uint64(block.Difficulty),
+	}
+	traces, err := d.cli.TraceTx(&ctypes.ReqTraceTx{TxList: list, Index: 0, Tracer: tracerName(config), From: msg.From})
+	if err != nil {
+		log.Error("TraceCall", "err", err)
+		return nil, err
+	}
+	if len(traces.GetTraces()) == 0 {
+		return nil, ctypes.ErrNotFound
+	}
+	return types.TxTraceToResult(traces.GetTraces()[0]), nil
+}
@@ -0,0 +1,86 @@
+package debug
+
+import (
+	"testing"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugHandler(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+
+	tx1 := &ctypes.Transaction{Execer: []byte("coins"), Nonce: 1}
+	tx2 := &ctypes.Transaction{Execer: []byte("coins"), Nonce: 2}
+	block := &ctypes.Block{Height: 2, ParentHash: []byte("parent"), Txs: []*ctypes.Transaction{tx1, tx2}}
+	trace := func(tx *ctypes.Transaction) *ctypes.TxTrace {
+		return &ctypes.TxTrace{
+			Hash:   tx.Hash(),
+			Execer: "coins",
+			Ty:     ctypes.ExecOk,
+			Steps: []*ctypes.TraceStep{
+				{Name: "fee", TxHash: tx.Hash(), Reads: []*ctypes.KeyValue{{Key: []byte("k"), Value: []byte("v")}}},
+				{Name: "exec", TxHash: tx.Hash(), Writes: []*ctypes.KeyValue{{Key: []byte("k"), Value: []byte("v2")}},
+					Logs: []*ctypes.ReceiptLog{{Ty: 1, Log: []byte("log")}}},
+			},
+			Result: []byte(`{"calls":[]}`),
+		}
+	}
+	parentList := func(list *ctypes.ExecTxList) bool {
+		return string(list.StateHash) == "parentState" && list.Height == 2 && len(list.Txs) == 2
+	}
+
+	qapi := &clientMocks.QueueProtocolAPI{}
+	qapi.On("QueryTx", &ctypes.ReqHash{Hash: tx2.Hash()}).Return(&ctypes.TransactionDetail{Height: 2, Index: 1}, nil)
+	qapi.On("GetBlocks", &ctypes.ReqBlocks{Start: 2, End: 2}).Return(&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{{Block: block}}}, nil)
+	qapi.On("GetHeaders", &ctypes.ReqBlocks{Start: 1, End: 1}).Return(&ctypes.Headers{Items: []*ctypes.Header{{Height: 1, StateHash: []byte("parentState")}}}, nil)
+	qapi.On("GetLastHeader").Return(&ctypes.Header{Height: 2}, nil)
+	qapi.On("GetBlockByHashes", mock.Anything).Return(&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{{Block: block}}}, nil)
+	qapi.On("TraceTx", mock.MatchedBy(func(req *ctypes.ReqTraceTx) bool {
+		return req.Index == 1 && req.Tracer == "callTracer" && parentList(req.TxList)
+	})).Return(&ctypes.TxTraces{Traces: []*ctypes.TxTrace{trace(tx2)}}, nil)
+	qapi.On("TraceTx", mock.MatchedBy(func(req *ctypes.ReqTraceTx) bool {
+		return req.Index == -1 && req.Tracer == "" && parentList(req.TxList)
+	})).Return(&ctypes.TxTraces{Traces: []*ctypes.TxTrace{trace(tx1), trace(tx2)}}, nil)
+	handler := NewDebugAPI(cfg, q.Client(), qapi).(*debugHandler)
+
+	result, err := handler.TraceTransaction(common.BytesToHash(tx2.Hash()), &types.TraceConfig{Tracer: "callTracer"})
+	require.Nil(t, err)
+	require.Equal(t, common.BytesToHash(tx2.Hash()), result.TxHash)
+	require.Equal(t, int32(ctypes.ExecOk), result.Ty)
+	require.Equal(t, 2, len(result.Steps))
+	require.Equal(t, "fee", result.Steps[0].Name)
+	require.Equal(t, []byte("k"), []byte(result.Steps[0].Reads[0].Key))
+	require.Equal(t, 0, len(result.Steps[0].Writes))
+	require.Equal(t, []byte("v2"), []byte(result.Steps[1].Writes[0].Value))
+	require.Equal(t, int32(1), result.Steps[1].Logs[0].Ty)
+	require.Equal(t, `{"calls":[]}`, string(result.Result))
+
+	results, err := handler.TraceBlockByNumber("0x2", nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(results))
+	require.Equal(t, common.BytesToHash(tx1.Hash()), results[0].TxHash)
+	results, err = handler.TraceBlockByNumber("latest", nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(results))
+	results, err = handler.TraceBlockByHash(common.BytesToHash([]byte("blockhash")), nil)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(results))
+
+	//创世区块无法追踪
+	qapi.On("GetBlocks", &ctypes.ReqBlocks{Start: 0, End: 0}).Return(&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{{Block: &ctypes.Block{}}}}, nil)
+	_, err = handler.TraceBlockByNumber("0x0", nil)
+	require.Equal(t, ErrGenesisNotTraceable, err)
+
+	//未加载evm执行器
+	_, err = handler.TraceCall(types.CallMsg{To: "0x0000000000000000000000000000000000000001"}, nil, nil)
+	require.Equal(t, ctypes.ErrNotSupport, err)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/log/log15"
@@ -36,10 +37,13 @@ type ethHandler struct {
 	cfg        *ctypes.Chain33Config
 	grpcCli    ctypes.Chain33Client
 	evmChainID int64
+	filters    *filterManager
 }
 
 var (
 	log = log15.New("module", "ethrpc_eth")
+	//mempool未配置maxNonceGap时的默认值
+	defaultMaxNonceGap int64 = 64
 )
 
 //NewEthAPI new eth api
@@ -47,6 +51,7 @@ func NewEthAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtoc
 	e := &ethHandler{}
 	e.cli.Init(c, api)
 	e.cfg = cfg
+	e.filters = newFilterManager()
 
 	e.evmChainID = secp256k1eth.GetEvmChainID()
 	grpcBindAddr := e.cfg.GetModuleConfig().RPC.GrpcBindAddr
@@ -59,10 +64,17 @@ func NewEthAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtoc
 	//TODO 改进为内部推送
 	//推送用途
 	e.grpcCli = ctypes.NewChain33Client(conn)
-
+	go e.filters.timeoutLoop()
 	return e
 }
 
+//StopAPI 停止eth接口的后台任务, 随rpc服务关闭调用
+func StopAPI(api interface{}) {
+	if e, ok := api.(*ethHandler); ok {
+		e.filters.stop()
+	}
+}
+
 //GetBalance eth_getBalance  tag:"latest", "earliest" or "pending"
 func (e *ethHandler) GetBalance(address string, tag *string) (hexutil.Big, error) {
 	var req ctypes.ReqBalance
@@ -80,6 +92,61 @@ func (e *ethHandler) GetBalance(address string, tag *string) (hexutil.Big, error
 	return hexutil.Big(*bn), nil
 }
 
+//GetProof eth_getProof 获取账户余额的merkle证明, chain33合约存储不是独立的状态树, 不支持storageKeys
+func (e *ethHandler) GetProof(address common.Address, storageKeys []string, tag string) (*types.AccountResult, error) {
+	log.Debug("eth_getProof", "address", address, "tag", tag)
+	if len(storageKeys) > 0 {
+		return nil, ctypes.ErrNotSupport
+	}
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	height, err := parseBlockNumber(tag, header.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	if height != header.GetHeight() {
+		headers, err := e.cli.GetHeaders(&ctypes.ReqBlocks{Start: height, End: height})
+		if err != nil {
+			return nil, err
+		}
+		if len(headers.GetItems()) == 0 {
+			return nil, ctypes.ErrBlockNotFound
+		}
+		header = headers.GetItems()[0]
+	}
+	key := account.NewCoinsAccount(e.cfg).AccountKey(address.String())
+	proof, err := e.cli.GetStateProof(&ctypes.ReqStateProof{Key: key, StateHash: header.GetStateHash()})
+	if err != nil {
+		return nil, err
+	}
+	//账户不存在时返回不存在证明, 账户各字段为空
+	var acc ctypes.Account
+	err = ctypes.Decode(proof.GetValue(), &acc)
+	if err != nil {
+		return nil, err
+	}
+	//转换成精度为18
+	balance := new(big.Int).Mul(big.NewInt(acc.GetBalance()), new(big.Int).SetUint64(1e10))
+	result := &types.AccountResult{
+		Address:      address,
+		AccountProof: []string{hexutil.Encode(ctypes.Encode(proof))},
+		Balance:      (*hexutil.Big)(balance),
+		CodeHash:     ethcrypto.Keccak256Hash(nil),
+		StorageHash:  etypes.EmptyRootHash,
+		StorageProof: []types.StorageResult{},
+	}
+	//未加载evm合约时, nonce为0, 合约代码为空
+	if nonce, err := e.GetTransactionCount(address.String(), tag); err == nil {
+		result.Nonce = nonce
+	}
+	if code, err := e.GetCode(&address, tag); err == nil && code != nil && len(*code) > 0 {
+		result.CodeHash = ethcrypto.Keccak256Hash(*code)
+	}
+	return result, nil
+}
+
 //nolint
 func (e *ethHandler) ChainId() (hexutil.Big, error) {
 	bigID := big.NewInt(e.evmChainID)
@@ -353,11 +420,15 @@ func (e *ethHandler) SendRawTransaction(rawData string) (hexutil.Bytes, error) {
 		log.Error("eth_sendRawTransaction", "nonce too low,tx.From", txFrom, "txnonce", ntx.Nonce(), "stateNonce", nonce)
 		return nil, fmt.Errorf("nonce too low")
 	}
-	if ntx.Nonce() > uint64(nonce) { //非平行链下 允许更高的nonce 通过，在mempool中排序等待
-		log.Error("eth_sendRawTransaction", "nonce too high,tx.From", txFrom, "txnonce", ntx.Nonce(), "stateNonce", nonce)
+	if ntx.Nonce() > uint64(nonce) { //非平行链下 允许一定距离内更高的nonce 通过，在mempool中等待前序nonce的交易
+		log.Debug("eth_sendRawTransaction", "nonce too high,tx.From", txFrom, "txnonce", ntx.Nonce(), "stateNonce", nonce)
 		if e.cfg.IsPara() { //平行链架构下，交易是要发到主链共识的，无法校验交易的nonce是否正确
 			return nil, fmt.Errorf("nonce too high")
 		}
+		if ntx.Nonce() > uint64(nonce)+uint64(e.maxNonceGap()) {
+			log.Error("eth_sendRawTransaction", "nonce too high,tx.From", txFrom, "txnonce", ntx.Nonce(), "stateNonce", nonce)
+			return nil, fmt.Errorf("nonce too high")
+		}
 	}
 
 	if !ethcrypto.VerifySignature(pubkey, txSha3.Bytes(), sig[:64]) {
@@ -379,6 +450,14 @@ func (e *ethHandler) SendRawTransaction(rawData string) (hexutil.Bytes, error) {
 
 }
 
+//maxNonceGap 允许交易nonce超前当前nonce的最大距离, 与mempool配置保持一致
+func (e *ethHandler) maxNonceGap() int64 {
+	if gap := e.cfg.GetModuleConfig().Mempool.MaxNonceGap; gap > 0 {
+		return gap
+	}
+	return defaultMaxNonceGap
+}
+
 //Sign method:eth_sign
 func (e *ethHandler) Sign(address string, digestHash *hexutil.Bytes) (string, error) {
 	//导出私钥
@@ -114,6 +114,51 @@ func TestEthHandler_GetBalance(t *testing.T) {
 	assert.Equal(t, balanceHexStr.String(), "0x4563918244f40000")
 }
 
+func TestEthHandler_GetProof(t *testing.T) {
+	api := &clientMocks.QueueProtocolAPI{}
+	handler := &ethHandler{cfg: ethCli.cfg}
+	handler.cli.Init(q.Client(), api)
+
+	addr := common.HexToAddress("0x1E79307966B830bCdfEAB1Ed09871d248c2fE171")
+	_, err := handler.GetProof(addr, []string{"0x01"}, "latest")
+	assert.Equal(t, ctypes.ErrNotSupport, err)
+
+	stateHash := []byte("statehash1")
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 2, StateHash: []byte("statehash2")}, nil)
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 1, End: 1}).Return(&ctypes.Headers{Items: []*ctypes.Header{{Height: 1, StateHash: stateHash}}}, nil)
+	key := []byte("mavl-coins-bty-" + strings.ToLower(addr.String()))
+	acc := &ctypes.Account{Addr: addr.String(), Balance: 5e8}
+	proof := &ctypes.StateProof{Key: key, Value: ctypes.Encode(acc), StateHash: stateHash, Proof: &ctypes.MAVLProof{RootHash: stateHash}}
+	api.On("StoreGetProof", &ctypes.ReqStateProof{Key: key, StateHash: stateHash}).Return(proof, nil)
+	result, err := handler.GetProof(addr, nil, "0x1")
+	require.Nil(t, err)
+	assert.Equal(t, "0x4563918244f40000", result.Balance.String())
+	assert.Equal(t, hexutil.Uint64(0), result.Nonce)
+	assert.Equal(t, crypto.Keccak256Hash(nil), result.CodeHash)
+	require.Len(t, result.AccountProof, 1)
+	var decoded ctypes.StateProof
+	require.Nil(t, ctypes.Decode(hexutil.MustDecode(result.AccountProof[0]), &decoded))
+	assert.Equal(t, proof.String(), decoded.String())
+
+	//区块不存在
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 0, End: 0}).Return(&ctypes.Headers{}, nil)
+	_, err = handler.GetProof(addr, nil, "0x0")
+	assert.Equal(t, ctypes.ErrBlockNotFound, err)
+
+	//账户不存在时返回不存在证明
+	absentAddr := common.HexToAddress("0x2E79307966B830bCdfEAB1Ed09871d248c2fE172")
+	absentKey := []byte("mavl-coins-bty-" + strings.ToLower(absentAddr.String()))
+	absence := &ctypes.StateProof{Key: absentKey, StateHash: stateHash, Absence: &ctypes.AbsenceProof{Key: absentKey, RootHash: stateHash}}
+	api.On("StoreGetProof", &ctypes.ReqStateProof{Key: absentKey, StateHash: stateHash}).Return(absence, nil)
+	result, err = handler.GetProof(absentAddr, nil, "0x1")
+	require.Nil(t, err)
+	assert.Equal(t, "0x0", result.Balance.String())
+	assert.Equal(t, hexutil.Uint64(0), result.Nonce)
+	require.Len(t, result.AccountProof, 1)
+	require.Nil(t, ctypes.Decode(hexutil.MustDecode(result.AccountProof[0]), &decoded))
+	assert.Equal(t, absence.String(), decoded.String())
+}
+
 func TestEthHandler_GetBlock(t *testing.T) {
 	var resp ctypes.BlockDetails
 	var item ctypes.BlockDetail
@@ -0,0 +1,430 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	//单次eth_getLogs查询的最大区块数
+	maxLogsBlockRange = 10000
+	//按区间获取区块详情时, 单次获取的最大区块数
+	logsFetchBatch = 100
+	//超过该时间未轮询的过滤器会被删除
+	filterTimeout = 5 * time.Minute
+)
+
+var (
+	errFilterNotFound  = errors.New("filter not found")
+	errExceedMaxRange  = fmt.Errorf("block range exceeds the limit %d", maxLogsBlockRange)
+	errBlockHashFilter = errors.New("blockHash can not be used with fromBlock or toBlock")
+)
+
+type filterType int
+
+const (
+	logsFilter filterType = iota
+	blocksFilter
+	pendingTxFilter
+)
+
+type filter struct {
+	typ      filterType
+	deadline time.Time
+	crit     types.FilterQuery
+	//已经返回过结果的最新高度
+	lastHeight int64
+	//已经返回过的mempool交易
+	pendingTxs map[string]bool
+}
+
+type filterManager struct {
+	mu       sync.Mutex
+	filters  map[rpc.ID]*filter
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func newFilterManager() *filterManager {
+	return &filterManager{filters: make(map[rpc.ID]*filter), done: make(chan struct{})}
+}
+
+// 停止超时检测, rpc服务关闭时调用
+func (m *filterManager) stop() {
+	m.stopOnce.Do(func() { close(m.done) })
+}
+
+func (m *filterManager) install(f *filter) rpc.ID {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := rpc.NewID()
+	f.deadline = time.Now().Add(filterTimeout)
+	m.filters[id] = f
+	return id
+}
+
+func (m *filterManager) uninstall(id rpc.ID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.filters[id]
+	delete(m.filters, id)
+	return ok
+}
+
+// 获取过滤器状态的副本并刷新超时时间, 查询过程中不持有锁
+func (m *filterManager) get(id rpc.ID) (filter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.filters[id]
+	if !ok {
+		return filter{}, errFilterNotFound
+	}
+	f.deadline = time.Now().Add(filterTimeout)
+	return *f, nil
+}
+
+// 查询完成后更新过滤器的轮询进度, 过滤器已被删除时忽略
+func (m *filterManager) update(id rpc.ID, lastHeight int64, pendingTxs map[string]bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.filters[id]
+	if !ok {
+		return
+	}
+	if lastHeight > f.lastHeight {
+		f.lastHeight = lastHeight
+	}
+	if pendingTxs != nil {
+		f.pendingTxs = pendingTxs
+	}
+}
+
+// 删除超时的过滤器
+func (m *filterManager) timeoutLoop() {
+	ticker := time.NewTicker(filterTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+		}
+		m.mu.Lock()
+		now := time.Now()
+		for id, f := range m.filters {
+			if now.After(f.deadline) {
+				delete(m.filters, id)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+//GetLogs eth_getLogs 按条件查询历史日志
+//params:[{"fromBlock":"0x1","toBlock":"latest","address":"0x...","topics":["0x..."]}]
+func (e *ethHandler) GetLogs(query types.FilterQuery) ([]*types.EvmLog, error) {
+	log.Debug("eth_getLogs", "query", query)
+	if query.BlockHash != nil {
+		if query.FromBlock != "" || query.ToBlock != "" {
+			return nil, errBlockHashFilter
+		}
+		details, err := e.cli.GetBlockByHashes(&ctypes.ReqHashes{Hashes: [][]byte{query.BlockHash.Bytes()}})
+		if err != nil {
+			return nil, err
+		}
+		logs := []*types.EvmLog{}
+		for _, detail := range details.GetItems() {
+			if detail == nil {
+				continue
+			}
+			logs = append(logs, types.FilterLogs(types.BlockDetailToEvmLogs(detail, e.cfg), query.Addresses, query.Topics)...)
+		}
+		return logs, nil
+	}
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	from, err := parseBlockNumber(query.FromBlock, header.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseBlockNumber(query.ToBlock, header.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	if to > header.GetHeight() {
+		to = header.GetHeight()
+	}
+	if from > to {
+		return []*types.EvmLog{}, nil
+	}
+	return e.getLogs(from, to, &query)
+}
+
+// 查询区间[from, to]内的日志, 调用方保证from <= to, 有布隆过滤器索引的区块只获取可能包含日志的区块
+func (e *ethHandler) getLogs(from, to int64, query *types.FilterQuery) ([]*types.EvmLog, error) {
+	logs := []*types.EvmLog{}
+	if to-from+1 > maxLogsBlockRange {
+		return nil, errExceedMaxRange
+	}
+	heights, err := e.filterBlocksByBloom(from, to, query)
+	if err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(heights); {
+		//连续的区块一次获取
+		end := start + 1
+		for end < len(heights) && end-start < logsFetchBatch && heights[end] == heights[end-1]+1 {
+			end++
+		}
+		req := &ctypes.ReqBlocks{Start: heights[start], End: heights[end-1], IsDetail: true}
+		details, err := e.cli.GetBlocks(req)
+		if err != nil {
+			log.Error("eth_getLogs", "start", req.Start, "end", req.End, "err", err)
+			return nil, err
+		}
+		for _, detail := range details.GetItems() {
+			logs = append(logs, types.FilterLogs(types.BlockDetailToEvmLogs(detail, e.cfg), query.Addresses, query.Topics)...)
+		}
+		start = end
+	}
+	return logs, nil
+}
+
+// 根据localdb中的布隆过滤器索引, 返回区间内可能包含满足条件日志的区块高度
+// 索引起始高度之前的区块没有索引, 需要全部扫描
+func (e *ethHandler) filterBlocksByBloom(from, to int64, query *types.FilterQuery) ([]int64, error) {
+	var heights []int64
+	start, err := e.getLogIndexStart()
+	if err != nil {
+		return nil, err
+	}
+	if start < 0 || start > to {
+		for h := from; h <= to; h++ {
+			heights = append(heights, h)
+		}
+		return heights, nil
+	}
+	for h := from; h < start; h++ {
+		heights = append(heights, h)
+	}
+	if from < start {
+		from = start
+	}
+	req := &ctypes.LocalDBGet{}
+	for h := from; h <= to; h++ {
+		req.Keys = append(req.Keys, ctypes.CalcEvmLogBloomKey(h))
+	}
+	reply, err := e.cli.LocalGet(req)
+	if err != nil {
+		return nil, err
+	}
+	for i, value := range reply.GetValues() {
+		//索引建立后没有记录布隆过滤器的区块不包含日志
+		if len(value) == 0 {
+			continue
+		}
+		if types.BloomFilter(etypes.BytesToBloom(value), query.Addresses, query.Topics) {
+			heights = append(heights, from+int64(i))
+		}
+	}
+	return heights, nil
+}
+
+// 获取日志索引的起始高度, 未建立索引返回-1
+func (e *ethHandler) getLogIndexStart() (int64, error) {
+	reply, err := e.cli.LocalGet(&ctypes.LocalDBGet{Keys: [][]byte{ctypes.FlagEvmLogIndex}})
+	if err != nil {
+		return 0, err
+	}
+	if len(reply.GetValues()) == 0 || len(reply.GetValues()[0]) == 0 {
+		return -1, nil
+	}
+	var flag ctypes.Int64
+	if err := ctypes.Decode(reply.GetValues()[0], &flag); err != nil {
+		return 0, err
+	}
+	return flag.GetData() - 1, nil
+}
+
+//NewFilter eth_newFilter 创建日志过滤器, 通过eth_getFilterChanges获取新产生的日志
+func (e *ethHandler) NewFilter(query types.FilterQuery) (rpc.ID, error) {
+	log.Debug("eth_newFilter", "query", query)
+	if query.BlockHash != nil {
+		return "", errBlockHashFilter
+	}
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return "", err
+	}
+	return e.filters.install(&filter{typ: logsFilter, crit: query, lastHeight: header.GetHeight()}), nil
+}
+
+//NewBlockFilter eth_newBlockFilter 创建新区块过滤器
+func (e *ethHandler) NewBlockFilter() (rpc.ID, error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return "", err
+	}
+	return e.filters.install(&filter{typ: blocksFilter, lastHeight: header.GetHeight()}), nil
+}
+
+//NewPendingTransactionFilter eth_newPendingTransactionFilter 创建mempool交易过滤器
+func (e *ethHandler) NewPendingTransactionFilter() (rpc.ID, error) {
+	f := &filter{typ: pendingTxFilter}
+	//创建时已在mempool中的交易不再返回
+	if _, err := e.getPendingTxs(f); err != nil {
+		return "", err
+	}
+	return e.filters.install(f), nil
+}
+
+//UninstallFilter eth_uninstallFilter
+func (e *ethHandler) UninstallFilter(id rpc.ID) bool {
+	return e.filters.uninstall(id)
+}
+
+//GetFilterChanges eth_getFilterChanges 获取过滤器自上次轮询以来的变化
+//日志过滤器返回日志, 区块过滤器返回区块hash, 交易过滤器返回交易hash
+func (e *ethHandler) GetFilterChanges(id rpc.ID) (interface{}, error) {
+	f, err := e.filters.get(id)
+	if err != nil {
+		return nil, err
+	}
+	var changes interface{}
+	switch f.typ {
+	case pendingTxFilter:
+		changes, err = e.getPendingTxs(&f)
+	case blocksFilter:
+		changes, err = e.getNewBlocks(&f)
+	default:
+		changes, err = e.getNewLogs(&f)
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.filters.update(id, f.lastHeight, f.pendingTxs)
+	return changes, nil
+}
+
+// 返回上次轮询之后新增区块中满足条件的日志
+func (e *ethHandler) getNewLogs(f *filter) ([]*types.EvmLog, error) {
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	from := f.lastHeight + 1
+	to := header.GetHeight()
+	if f.crit.ToBlock != "" {
+		end, err := parseBlockNumber(f.crit.ToBlock, header.GetHeight())
+		if err != nil {
+			return nil, err
+		}
+		if end < to {
+			to = end
+		}
+	}
+	if f.crit.FromBlock != "" {
+		begin, err := parseBlockNumber(f.crit.FromBlock, header.GetHeight())
+		if err != nil {
+			return nil, err
+		}
+		if begin > from {
+			from = begin
+		}
+	}
+	if from > to {
+		return []*types.EvmLog{}, nil
+	}
+	if to-from+1 > maxLogsBlockRange {
+		to = from + maxLogsBlockRange - 1
+	}
+	logs, err := e.getLogs(from, to, &f.crit)
+	if err != nil {
+		return nil, err
+	}
+	f.lastHeight = to
+	return logs, nil
+}
+
+//GetFilterLogs eth_getFilterLogs 获取日志过滤器条件下的全部日志
+func (e *ethHandler) GetFilterLogs(id rpc.ID) ([]*types.EvmLog, error) {
+	f, err := e.filters.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if f.typ != logsFilter {
+		return nil, errFilterNotFound
+	}
+	return e.GetLogs(f.crit)
+}
+
+// 返回上次轮询之后新增区块的hash
+func (e *ethHandler) getNewBlocks(f *filter) ([]common.Hash, error) {
+	hashes := []common.Hash{}
+	header, err := e.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	if header.GetHeight() <= f.lastHeight {
+		return hashes, nil
+	}
+	start := f.lastHeight + 1
+	if header.GetHeight()-start+1 > ctypes.MaxHeaderCountPerTime {
+		start = header.GetHeight() - ctypes.MaxHeaderCountPerTime + 1
+	}
+	headers, err := e.cli.GetHeaders(&ctypes.ReqBlocks{Start: start, End: header.GetHeight()})
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range headers.GetItems() {
+		hashes = append(hashes, common.BytesToHash(h.GetHash()))
+	}
+	f.lastHeight = header.GetHeight()
+	return hashes, nil
+}
+
+// 返回上次轮询之后新进入mempool的交易hash
+func (e *ethHandler) getPendingTxs(f *filter) ([]common.Hash, error) {
+	hashes := []common.Hash{}
+	reply, err := e.cli.GetMempool(&ctypes.ReqGetMempool{})
+	if err != nil {
+		return nil, err
+	}
+	pending := make(map[string]bool)
+	for _, tx := range reply.GetTxs() {
+		hash := tx.Hash()
+		pending[string(hash)] = true
+		if !f.pendingTxs[string(hash)] {
+			hashes = append(hashes, common.BytesToHash(hash))
+		}
+	}
+	//只保留当前mempool中的交易, 避免占用内存无限增长
+	f.pendingTxs = pending
+	return hashes, nil
+}
+
+// 解析区块高度参数, 支持latest, pending, earliest
+func parseBlockNumber(tag string, latest int64) (int64, error) {
+	switch tag {
+	case "", "latest", "pending":
+		return latest, nil
+	case "earliest":
+		return 0, nil
+	}
+	num, err := hexutil.DecodeUint64(tag)
+	if err != nil {
+		return 0, err
+	}
+	return int64(num), nil
+}
@@ -0,0 +1,172 @@
+package eth
+
+import (
+	"testing"
+	"time"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	etypes "github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEvmLogBlock(height int64, contract common.Address, topic common.Hash) *ctypes.BlockDetail {
+	tx := &ctypes.Transaction{
+		Execer:  []byte("evm"),
+		Payload: ctypes.Encode(&ctypes.EVMContractAction4Chain33{ContractAddr: contract.Hex()}),
+		Nonce:   height,
+	}
+	evmLog := &ctypes.EVMLog{Topic: [][]byte{topic.Bytes()}, Data: []byte("data")}
+	//log类型由evm合约注册
+	ctypes.RegisterEVMLogTy(603, 605)
+	return &ctypes.BlockDetail{
+		Block: &ctypes.Block{Height: height, Txs: []*ctypes.Transaction{tx}},
+		Receipts: []*ctypes.ReceiptData{{
+			Ty:   ctypes.ExecOk,
+			Logs: []*ctypes.ReceiptLog{{Ty: 605, Log: ctypes.Encode(evmLog)}},
+		}},
+	}
+}
+
+func TestEthHandler_GetLogs(t *testing.T) {
+	api := &clientMocks.QueueProtocolAPI{}
+	handler := &ethHandler{cfg: ethCli.cfg, filters: newFilterManager()}
+	handler.cli.Init(q.Client(), api)
+
+	addr1 := common.HexToAddress("0x3ea9e4f2a3d1c4e1d5e24ae3b1f7dd93a58acd11")
+	addr2 := common.HexToAddress("0x6ee3b9ef1cbcad40b9e2c4ea2a4f14e12d41ad4c")
+	t1, t2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	//索引从高度3开始建立, 高度1的日志需要扫描区块获取
+	block1 := newEvmLogBlock(1, addr1, t2)
+	block5 := newEvmLogBlock(5, addr1, t1)
+	emptyBlock := &ctypes.BlockDetail{Block: &ctypes.Block{}}
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 10}, nil)
+	api.On("LocalGet", &ctypes.LocalDBGet{Keys: [][]byte{ctypes.FlagEvmLogIndex}}).Return(
+		&ctypes.LocalReplyValue{Values: [][]byte{ctypes.Encode(&ctypes.Int64{Data: 4})}}, nil)
+	blooms := &ctypes.LocalDBGet{}
+	values := &ctypes.LocalReplyValue{}
+	for h := int64(3); h <= 10; h++ {
+		blooms.Keys = append(blooms.Keys, ctypes.CalcEvmLogBloomKey(h))
+		var bloom ethtypes.Bloom
+		switch h {
+		case 5:
+			bloom.Add(addr1.Bytes())
+			bloom.Add(t1.Bytes())
+		case 7:
+			bloom.Add(addr2.Bytes())
+			bloom.Add(t2.Bytes())
+		default:
+			values.Values = append(values.Values, nil)
+			continue
+		}
+		values.Values = append(values.Values, bloom.Bytes())
+	}
+	api.On("LocalGet", blooms).Return(values, nil)
+	api.On("GetBlocks", &ctypes.ReqBlocks{Start: 0, End: 2, IsDetail: true}).Return(
+		&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{emptyBlock, block1, emptyBlock}}, nil)
+	api.On("GetBlocks", &ctypes.ReqBlocks{Start: 5, End: 5, IsDetail: true}).Return(
+		&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{block5}}, nil)
+	api.On("GetBlocks", &ctypes.ReqBlocks{Start: 7, End: 7, IsDetail: true}).Return(
+		&ctypes.BlockDetails{Items: []*ctypes.BlockDetail{newEvmLogBlock(7, addr1, t2)}}, nil)
+
+	logs, err := handler.GetLogs(etypes.FilterQuery{FromBlock: "earliest", Addresses: []common.Address{addr1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(logs))
+	assert.Equal(t, uint64(1), uint64(logs[0].BlockNumber))
+	assert.Equal(t, uint64(5), uint64(logs[1].BlockNumber))
+	assert.Equal(t, addr1, *logs[1].Address)
+	assert.Equal(t, []common.Hash{t1}, logs[1].Topics)
+
+	logs, err = handler.GetLogs(etypes.FilterQuery{FromBlock: "0x0", Topics: [][]common.Hash{{t1}}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(logs))
+	assert.Equal(t, common.BytesToHash(block5.Block.Txs[0].Hash()), logs[0].TxHash)
+
+	//布隆过滤器误判的区块获取后会被过滤掉
+	logs, err = handler.GetLogs(etypes.FilterQuery{FromBlock: "0x0", Addresses: []common.Address{addr2}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(logs))
+
+	_, err = handler.GetLogs(etypes.FilterQuery{FromBlock: "0x0", ToBlock: "0x1", BlockHash: &t1})
+	assert.Equal(t, errBlockHashFilter, err)
+	logs, err = handler.GetLogs(etypes.FilterQuery{FromBlock: "0x20"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(logs))
+}
+
+func TestEthHandler_Filter(t *testing.T) {
+	api := &clientMocks.QueueProtocolAPI{}
+	handler := &ethHandler{cfg: ethCli.cfg, filters: newFilterManager()}
+	handler.cli.Init(q.Client(), api)
+
+	tx1 := &ctypes.Transaction{Execer: []byte("coins"), Nonce: 1}
+	tx2 := &ctypes.Transaction{Execer: []byte("coins"), Nonce: 2}
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 10}, nil).Twice()
+	api.On("GetLastHeader").Return(&ctypes.Header{Height: 12}, nil)
+	api.On("GetMempool", &ctypes.ReqGetMempool{}).Return(&ctypes.ReplyTxList{Txs: []*ctypes.Transaction{tx1}}, nil).Once()
+	api.On("GetMempool", &ctypes.ReqGetMempool{}).Return(&ctypes.ReplyTxList{Txs: []*ctypes.Transaction{tx1, tx2}}, nil)
+	headers := &ctypes.Headers{Items: []*ctypes.Header{{Height: 11, Hash: []byte("11")}, {Height: 12, Hash: []byte("12")}}}
+	api.On("GetHeaders", &ctypes.ReqBlocks{Start: 11, End: 12}).Return(headers, nil)
+	api.On("LocalGet", &ctypes.LocalDBGet{Keys: [][]byte{ctypes.FlagEvmLogIndex}}).Return(&ctypes.LocalReplyValue{Values: [][]byte{nil}}, nil)
+	api.On("GetBlocks", &ctypes.ReqBlocks{Start: 11, End: 12, IsDetail: true}).Return(&ctypes.BlockDetails{}, nil)
+
+	blockID, err := handler.NewBlockFilter()
+	require.NoError(t, err)
+	logID, err := handler.NewFilter(etypes.FilterQuery{})
+	require.NoError(t, err)
+	_, err = handler.NewFilter(etypes.FilterQuery{BlockHash: &common.Hash{}})
+	assert.Equal(t, errBlockHashFilter, err)
+	txID, err := handler.NewPendingTransactionFilter()
+	require.NoError(t, err)
+
+	changes, err := handler.GetFilterChanges(blockID)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{common.BytesToHash([]byte("11")), common.BytesToHash([]byte("12"))}, changes)
+	changes, err = handler.GetFilterChanges(blockID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(changes.([]common.Hash)))
+
+	changes, err = handler.GetFilterChanges(txID)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{common.BytesToHash(tx2.Hash())}, changes)
+
+	changes, err = handler.GetFilterChanges(logID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(changes.([]*etypes.EvmLog)))
+	_, err = handler.GetFilterLogs(txID)
+	assert.Equal(t, errFilterNotFound, err)
+
+	assert.True(t, handler.UninstallFilter(blockID))
+	assert.False(t, handler.UninstallFilter(blockID))
+	_, err = handler.GetFilterChanges(blockID)
+	assert.Equal(t, errFilterNotFound, err)
+}
+
+func TestFilterManagerStop(t *testing.T) {
+	m := newFilterManager()
+	done := make(chan struct{})
+	go func() {
+		m.timeoutLoop()
+		close(done)
+	}()
+	StopAPI(&ethHandler{filters: m})
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timeoutLoop not stopped")
+	}
+	//重复停止
+	m.stop()
+
+	//轮询过程中过滤器被删除时不再更新
+	id := m.install(&filter{typ: blocksFilter, lastHeight: 1})
+	f, err := m.get(id)
+	require.Nil(t, err)
+	require.True(t, m.uninstall(id))
+	m.update(id, f.lastHeight+1, nil)
+	_, err = m.get(id)
+	require.Equal(t, errFilterNotFound, err)
+}
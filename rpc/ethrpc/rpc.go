@@ -13,9 +13,11 @@ import (
 	"github.com/33cn/chain33/common/utils"
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/rpc/ethrpc/admin"
+	"github.com/33cn/chain33/rpc/ethrpc/debug"
 	"github.com/33cn/chain33/rpc/ethrpc/eth"
 	rpcNet "github.com/33cn/chain33/rpc/ethrpc/net"
 	"github.com/33cn/chain33/rpc/ethrpc/personal"
+	"github.com/33cn/chain33/rpc/ethrpc/txpool"
 	"github.com/33cn/chain33/rpc/ethrpc/web3"
 	ctypes "github.com/33cn/chain33/types"
 	"github.com/ethereum/go-ethereum/node"
@@ -28,6 +30,8 @@ const (
 	personalNameSpace   = "personal"
 	adminNameSpace      = "admin"
 	web3NameSpace       = "web3"
+	txpoolNameSpace     = "txpool"
+	debugNameSpace      = "debug"
 	subRpctype          = "eth"
 	defaultEthRPCPort   = 8545
 	defaultEthWsRPCPort = 8546
@@ -45,6 +49,8 @@ var (
 		personalNameSpace: personal.NewPersonalAPI,
 		adminNameSpace:    admin.NewAdminAPI,
 		web3NameSpace:     web3.NewWeb3API,
+		txpoolNameSpace:   txpool.NewTxPoolAPI,
+		debugNameSpace:    debug.NewDebugAPI,
 	}
 )
 
@@ -52,9 +58,9 @@ type subConfig struct {
 	//ethereum json rpc bindaddr
 	Enable     bool     `json:"enable,omitempty"`
 	HTTPAddr   string   `json:"httpAddr,omitempty"`
-	HTTPAPI    []string `json:"httpApi,omitempty"` //eth,admin,net,web3/personal
+	HTTPAPI    []string `json:"httpApi,omitempty"` //eth,admin,net,web3,personal,txpool,debug
 	WsAddr     string   `json:"wsAddr,omitempty"`
-	WsAPI      []string `json:"wsApi,omitempty"` //eth,admin,net,web3/personal
+	WsAPI      []string `json:"wsApi,omitempty"` //eth,admin,net,web3,personal,txpool,debug
 	Web3CliVer string   `json:"web3CliVer,omitempty"`
 }
 
@@ -89,20 +95,21 @@ func isWebsocket(r *http.Request) bool {
 type rpcHandler struct {
 	http.Handler
 	server *rpc.Server
+	apis   []interface{}
 }
 
 //initRpcHandler 注册eth rpc
 func initRPCHandler(apis rpcAPIs, cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) *rpcHandler {
-	server := rpc.NewServer()
+	handler := &rpcHandler{server: rpc.NewServer()}
 	if len(apis) == 0 {
 		apis = defaultApis
 	}
 	for namespace, newAPI := range apis {
-		server.RegisterName(namespace, newAPI(cfg, c, api))
-	}
-	return &rpcHandler{
-		server: server,
+		service := newAPI(cfg, c, api)
+		handler.server.RegisterName(namespace, service)
+		handler.apis = append(handler.apis, service)
 	}
+	return handler
 }
 
 //NewHTTPServer eth json rpcserver object
@@ -204,10 +211,14 @@ func (h *httpServer) Start() (int, error) {
 
 //Close close service
 func (h *httpServer) Close() {
-	if h.wsHander != nil {
-		h.wsHander.server.Stop()
-	} else if h.wsHander != nil {
-		h.wsHander.server.Stop()
+	for _, handler := range []*rpcHandler{h.httpHandler, h.wsHander} {
+		if handler == nil {
+			continue
+		}
+		handler.server.Stop()
+		for _, api := range handler.apis {
+			eth.StopAPI(api)
+		}
 	}
 }
 
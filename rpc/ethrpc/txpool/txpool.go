@@ -0,0 +1,218 @@
+package txpool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	rpcclient "github.com/33cn/chain33/rpc/client"
+	etypes "github.com/33cn/chain33/rpc/ethrpc/types"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var log = log15.New("module", "ethrpc_txpool")
+
+type txPoolHandler struct {
+	cli     rpcclient.ChannelClient
+	cfg     *ctypes.Chain33Config
+	qclient queue.Client
+}
+
+//NewTxPoolAPI create a txpool api
+func NewTxPoolAPI(cfg *ctypes.Chain33Config, c queue.Client, api client.QueueProtocolAPI) interface{} {
+	p := &txPoolHandler{}
+	p.cli.Init(c, api)
+	p.cfg = cfg
+	p.qclient = c
+	return p
+}
+
+//senderTxs 同一地址的交易按nonce划分为可打包(pending)和等待前序nonce(queued)两部分
+type senderTxs struct {
+	pending []*ctypes.Transaction
+	queued  []*ctypes.Transaction
+}
+
+//getNonce 通过EventGetEvmNonce获取地址当前的nonce, 与mempool的nonce排序逻辑一致
+func (p *txPoolHandler) getNonce(addr string) (int64, error) {
+	msg := p.qclient.NewMessage("rpc", ctypes.EventGetEvmNonce, &ctypes.ReqEvmAccountNonce{Addr: addr})
+	err := p.qclient.Send(msg, true)
+	if err != nil {
+		return 0, err
+	}
+	reply, err := p.qclient.WaitTimeout(msg, time.Second*2)
+	if err != nil {
+		return 0, err
+	}
+	switch data := reply.GetData().(type) {
+	case *ctypes.EvmAccountNonce:
+		return data.GetNonce(), nil
+	case *ctypes.Reply:
+		return 0, errors.New(string(data.GetMsg()))
+	}
+	return 0, ctypes.ErrInvalidParam
+}
+
+//groupTxs 获取mempool中的eth签名交易, 按发送地址分组并划分pending和queued
+func (p *txPoolHandler) groupTxs(from string) (map[string]*senderTxs, error) {
+	reply, err := p.cli.GetMempool(&ctypes.ReqGetMempool{IsAll: true})
+	if err != nil {
+		return nil, err
+	}
+	bySender := make(map[string][]*ctypes.Transaction)
+	for _, tx := range reply.GetTxs() {
+		//与mempool一致, 只有eth签名且非平行链的交易才按nonce排序
+		if !ctypes.IsEthSignID(tx.GetSignature().GetTy()) || bytes.HasPrefix(tx.GetExecer(), []byte(ctypes.ParaKeyX)) {
+			continue
+		}
+		sender := tx.From()
+		if from != "" && !strings.EqualFold(sender, from) {
+			continue
+		}
+		bySender[sender] = append(bySender[sender], tx)
+	}
+
+	groups := make(map[string]*senderTxs, len(bySender))
+	for sender, txs := range bySender {
+		nonce, err := p.getNonce(sender)
+		if err != nil {
+			log.Error("groupTxs", "addr", sender, "getNonce err", err)
+			return nil, err
+		}
+		sort.SliceStable(txs, func(i, j int) bool {
+			return txs[i].GetNonce() < txs[j].GetNonce()
+		})
+		group := &senderTxs{}
+		//从当前nonce开始连续的交易可以被打包, 其余交易需要等待
+		for _, tx := range txs {
+			if tx.GetNonce() == nonce {
+				group.pending = append(group.pending, tx)
+				nonce++
+				continue
+			}
+			group.queued = append(group.queued, tx)
+		}
+		groups[sender] = group
+	}
+	return groups, nil
+}
+
+func (p *txPoolHandler) formatTxs(txs []*ctypes.Transaction) map[string]*etypes.Transaction {
+	content := make(map[string]*etypes.Transaction, len(txs))
+	for _, tx := range txs {
+		etx := etypes.PendingTxToEthTx(tx, p.cfg)
+		if etx == nil {
+			continue
+		}
+		content[strconv.FormatInt(tx.GetNonce(), 10)] = etx
+	}
+	return content
+}
+
+func (p *txPoolHandler) inspectTxs(txs []*ctypes.Transaction) map[string]string {
+	content := make(map[string]string, len(txs))
+	for _, tx := range txs {
+		etx := etypes.PendingTxToEthTx(tx, p.cfg)
+		if etx == nil {
+			continue
+		}
+		to := "contract creation"
+		if etx.To != nil && *etx.To != (common.Address{}) {
+			to = etx.To.Hex()
+		}
+		content[strconv.FormatInt(tx.GetNonce(), 10)] = fmt.Sprintf("%s: %v wei + %v gas × %v wei",
+			to, etx.Value.ToInt(), uint64(etx.Gas), etx.GasPrice.ToInt())
+	}
+	return content
+}
+
+//Content txpool_content
+//返回mempool中所有eth签名交易, 按地址和nonce分组
+func (p *txPoolHandler) Content() (map[string]map[string]map[string]*etypes.Transaction, error) {
+	groups, err := p.groupTxs("")
+	if err != nil {
+		return nil, err
+	}
+	content := map[string]map[string]map[string]*etypes.Transaction{
+		"pending": make(map[string]map[string]*etypes.Transaction),
+		"queued":  make(map[string]map[string]*etypes.Transaction),
+	}
+	for sender, group := range groups {
+		addr := common.HexToAddress(sender).Hex()
+		if len(group.pending) > 0 {
+			content["pending"][addr] = p.formatTxs(group.pending)
+		}
+		if len(group.queued) > 0 {
+			content["queued"][addr] = p.formatTxs(group.queued)
+		}
+	}
+	return content, nil
+}
+
+//ContentFrom txpool_contentFrom
+//返回mempool中指定地址的eth签名交易
+func (p *txPoolHandler) ContentFrom(addr common.Address) (map[string]map[string]*etypes.Transaction, error) {
+	groups, err := p.groupTxs(addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+	content := map[string]map[string]*etypes.Transaction{
+		"pending": make(map[string]*etypes.Transaction),
+		"queued":  make(map[string]*etypes.Transaction),
+	}
+	for _, group := range groups {
+		content["pending"] = p.formatTxs(group.pending)
+		content["queued"] = p.formatTxs(group.queued)
+	}
+	return content, nil
+}
+
+//Inspect txpool_inspect
+//返回mempool中交易的摘要信息
+func (p *txPoolHandler) Inspect() (map[string]map[string]map[string]string, error) {
+	groups, err := p.groupTxs("")
+	if err != nil {
+		return nil, err
+	}
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
+	}
+	for sender, group := range groups {
+		addr := common.HexToAddress(sender).Hex()
+		if len(group.pending) > 0 {
+			content["pending"][addr] = p.inspectTxs(group.pending)
+		}
+		if len(group.queued) > 0 {
+			content["queued"][addr] = p.inspectTxs(group.queued)
+		}
+	}
+	return content, nil
+}
+
+//Status txpool_status
+//返回mempool中pending和queued交易的数量
+func (p *txPoolHandler) Status() (map[string]hexutil.Uint, error) {
+	groups, err := p.groupTxs("")
+	if err != nil {
+		return nil, err
+	}
+	var pending, queued int
+	for _, group := range groups {
+		pending += len(group.pending)
+		queued += len(group.queued)
+	}
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queued),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package txpool
+
+import (
+	"strings"
+	"testing"
+
+	clientMocks "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/crypto/secp256k1eth"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	_ "github.com/33cn/chain33/system/dapp/init"
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newEthTx(t *testing.T, priv crypto.PrivKey, cfg *ctypes.Chain33Config, nonce int64) *ctypes.Transaction {
+	transfer := &cty.CoinsAction{
+		Value: &cty.CoinsAction_Transfer{Transfer: &ctypes.AssetsTransfer{Amount: 1e8}},
+		Ty:    cty.CoinsActionTransfer,
+	}
+	tx, err := ctypes.CreateFormatTx(cfg, "coins", ctypes.Encode(transfer))
+	require.Nil(t, err)
+	tx.To = "0x0000000000000000000000000000000000000001"
+	tx.Nonce = nonce
+	tx.ChainID = cfg.GetChainID()
+	tx.Sign(ctypes.EncodeSignID(secp256k1eth.ID, 2), priv)
+	return tx
+}
+
+//nonceProcess 模拟rpc模块处理EventGetEvmNonce
+func nonceProcess(q queue.Queue, nonces map[string]int64) {
+	go func() {
+		client := q.Client()
+		client.Sub("rpc")
+		for msg := range client.Recv() {
+			if msg.Ty == ctypes.EventGetEvmNonce {
+				addr := msg.GetData().(*ctypes.ReqEvmAccountNonce).GetAddr()
+				msg.Reply(client.NewMessage("", ctypes.EventGetEvmNonce, &ctypes.EvmAccountNonce{Nonce: nonces[strings.ToLower(addr)]}))
+			}
+		}
+	}()
+}
+
+func TestTxPoolHandler(t *testing.T) {
+	cfg := ctypes.NewChain33Config(ctypes.GetDefaultCfgstring())
+	q := queue.New("test")
+	q.SetConfig(cfg)
+	defer q.Close()
+
+	c, err := crypto.Load(secp256k1eth.Name, -1)
+	require.Nil(t, err)
+	priv1, err := c.GenKey()
+	require.Nil(t, err)
+	priv2, err := c.GenKey()
+	require.Nil(t, err)
+
+	// addr1当前nonce为1, nonce 1,2可打包, nonce 4等待nonce 3
+	a1tx1 := newEthTx(t, priv1, cfg, 1)
+	a1tx2 := newEthTx(t, priv1, cfg, 2)
+	a1tx4 := newEthTx(t, priv1, cfg, 4)
+	// addr2当前nonce为0, nonce 1等待nonce 0
+	a2tx1 := newEthTx(t, priv2, cfg, 1)
+	// 非eth签名交易不参与统计
+	other, err := ctypes.CreateFormatTx(cfg, "coins", nil)
+	require.Nil(t, err)
+	addr1, addr2 := a1tx1.From(), a2tx1.From()
+	nonceProcess(q, map[string]int64{strings.ToLower(addr1): 1, strings.ToLower(addr2): 0})
+
+	qapi := &clientMocks.QueueProtocolAPI{}
+	qapi.On("GetMempool", &ctypes.ReqGetMempool{IsAll: true}).Return(&ctypes.ReplyTxList{Txs: []*ctypes.Transaction{a1tx4, a2tx1, other, a1tx2, a1tx1}}, nil)
+	handler := NewTxPoolAPI(cfg, q.Client(), qapi).(*txPoolHandler)
+
+	status, err := handler.Status()
+	require.Nil(t, err)
+	require.Equal(t, hexutil.Uint(2), status["pending"])
+	require.Equal(t, hexutil.Uint(2), status["queued"])
+
+	hexAddr1, hexAddr2 := common.HexToAddress(addr1).Hex(), common.HexToAddress(addr2).Hex()
+	content, err := handler.Content()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(content["pending"][hexAddr1]))
+	require.Equal(t, common.BytesToHash(a1tx1.Hash()), content["pending"][hexAddr1]["1"].Hash)
+	require.Equal(t, common.BytesToHash(a1tx2.Hash()), content["pending"][hexAddr1]["2"].Hash)
+	require.Nil(t, content["pending"][hexAddr1]["1"].BlockHash)
+	require.Equal(t, 1, len(content["queued"][hexAddr1]))
+	require.Equal(t, common.BytesToHash(a1tx4.Hash()), content["queued"][hexAddr1]["4"].Hash)
+	require.Nil(t, content["pending"][hexAddr2])
+	require.Equal(t, 1, len(content["queued"][hexAddr2]))
+
+	from, err := handler.ContentFrom(common.HexToAddress(addr1))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(from["pending"]))
+	require.Equal(t, 1, len(from["queued"]))
+
+	inspect, err := handler.Inspect()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(inspect["pending"][hexAddr1]))
+	require.True(t, strings.HasPrefix(inspect["queued"][hexAddr2]["1"], "0x0000000000000000000000000000000000000001: "))
+}
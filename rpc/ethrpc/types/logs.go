@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	errInvalidAddress = errors.New("invalid address in filter query")
+	errInvalidTopic   = errors.New("invalid topic in filter query")
+)
+
+//UnmarshalJSON 兼容以太坊address, topics的多种格式
+func (q *FilterQuery) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		BlockHash *common.Hash  `json:"blockHash"`
+		FromBlock string        `json:"fromBlock"`
+		ToBlock   string        `json:"toBlock"`
+		Addresses interface{}   `json:"address"`
+		Topics    []interface{} `json:"topics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	q.BlockHash = raw.BlockHash
+	q.FromBlock = raw.FromBlock
+	q.ToBlock = raw.ToBlock
+	q.Addresses = nil
+	q.Topics = nil
+	switch addr := raw.Addresses.(type) {
+	case nil:
+	case string:
+		if !common.IsHexAddress(addr) {
+			return errInvalidAddress
+		}
+		q.Addresses = append(q.Addresses, common.HexToAddress(addr))
+	case []interface{}:
+		for _, item := range addr {
+			s, ok := item.(string)
+			if !ok || !common.IsHexAddress(s) {
+				return errInvalidAddress
+			}
+			q.Addresses = append(q.Addresses, common.HexToAddress(s))
+		}
+	default:
+		return errInvalidAddress
+	}
+	for _, t := range raw.Topics {
+		var hashes []common.Hash
+		switch topic := t.(type) {
+		case nil:
+		case string:
+			hash, err := decodeTopic(topic)
+			if err != nil {
+				return err
+			}
+			hashes = append(hashes, hash)
+		case []interface{}:
+			for _, item := range topic {
+				s, ok := item.(string)
+				if !ok {
+					return errInvalidTopic
+				}
+				hash, err := decodeTopic(s)
+				if err != nil {
+					return err
+				}
+				hashes = append(hashes, hash)
+			}
+		default:
+			return errInvalidTopic
+		}
+		q.Topics = append(q.Topics, hashes)
+	}
+	return nil
+}
+
+func decodeTopic(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("%w: %s", errInvalidTopic, s)
+	}
+	return common.BytesToHash(b), nil
+}
+
+//BlockDetailToEvmLogs 获取区块中所有evm交易的日志, logIndex为日志在区块中的序号
+func BlockDetailToEvmLogs(detail *ctypes.BlockDetail, cfg *ctypes.Chain33Config) []*EvmLog {
+	var logs []*EvmLog
+	blockHash := common.BytesToHash(detail.Block.Hash(cfg))
+	for i, tx := range detail.Block.Txs {
+		contractAddr, evmLogs, err := ctypes.DecodeEVMTxLogs(tx, detail.Receipts[i])
+		if err != nil {
+			log.Error("BlockDetailToEvmLogs", "height", detail.Block.Height, "index", i, "err", err)
+			continue
+		}
+		addr := common.HexToAddress(contractAddr)
+		txHash := common.BytesToHash(tx.Hash())
+		for _, evmLog := range evmLogs {
+			elog := &EvmLog{
+				Address:     &addr,
+				BlockNumber: hexutil.Uint64(detail.Block.Height),
+				TxHash:      txHash,
+				TxIndex:     hexutil.Uint(i),
+				BlockHash:   blockHash,
+				Index:       hexutil.Uint(len(logs)),
+			}
+			for _, topic := range evmLog.Topic {
+				elog.Topics = append(elog.Topics, common.BytesToHash(topic))
+			}
+			data := hexutil.Bytes(evmLog.Data)
+			elog.Data = &data
+			logs = append(logs, elog)
+		}
+	}
+	return logs
+}
+
+//FilterLogs 按合约地址和topic筛选日志, 匹配规则与以太坊一致:
+//地址满足其一即可, topics按位置匹配, 空位置匹配任意topic
+func FilterLogs(logs []*EvmLog, addresses []common.Address, topics [][]common.Hash) []*EvmLog {
+	var ret []*EvmLog
+Logs:
+	for _, l := range logs {
+		if len(addresses) > 0 && !includesAddress(addresses, *l.Address) {
+			continue
+		}
+		if len(topics) > len(l.Topics) {
+			continue
+		}
+		for i, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			match := false
+			for _, topic := range sub {
+				if l.Topics[i] == topic {
+					match = true
+					break
+				}
+			}
+			if !match {
+				continue Logs
+			}
+		}
+		ret = append(ret, l)
+	}
+	return ret
+}
+
+//BloomFilter 判断区块的日志布隆过滤器是否可能包含满足条件的日志
+func BloomFilter(bloom etypes.Bloom, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		var included bool
+		for _, addr := range addresses {
+			if bloom.Test(addr.Bytes()) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	for _, sub := range topics {
+		included := len(sub) == 0
+		for _, topic := range sub {
+			if bloom.Test(topic.Bytes()) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
+func includesAddress(addresses []common.Address, a common.Address) bool {
+	for _, addr := range addresses {
+		if addr == a {
+			return true
+		}
+	}
+	return false
+}
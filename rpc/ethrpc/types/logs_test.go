@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterQuery(t *testing.T) {
+	addr1 := common.HexToAddress("0x3ea9e4f2a3d1c4e1d5e24ae3b1f7dd93a58acd11")
+	addr2 := common.HexToAddress("0x6ee3b9ef1cbcad40b9e2c4ea2a4f14e12d41ad4c")
+	t1, t2, t3 := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+
+	var query FilterQuery
+	data := `{"fromBlock":"0x1","toBlock":"latest","address":"` + addr1.Hex() + `","topics":[null,"` + t1.Hex() + `",["` + t2.Hex() + `","` + t3.Hex() + `"]]}`
+	require.NoError(t, json.Unmarshal([]byte(data), &query))
+	assert.Equal(t, "0x1", query.FromBlock)
+	assert.Equal(t, "latest", query.ToBlock)
+	assert.Equal(t, []common.Address{addr1}, query.Addresses)
+	assert.Equal(t, [][]common.Hash{nil, {t1}, {t2, t3}}, query.Topics)
+
+	data = `{"address":["` + addr1.Hex() + `","` + addr2.Hex() + `"]}`
+	require.NoError(t, json.Unmarshal([]byte(data), &query))
+	assert.Equal(t, []common.Address{addr1, addr2}, query.Addresses)
+	assert.Nil(t, query.Topics)
+	assert.Error(t, json.Unmarshal([]byte(`{"address":"0x01"}`), &query))
+	assert.Error(t, json.Unmarshal([]byte(`{"topics":["0x01"]}`), &query))
+
+	logs := []*EvmLog{
+		{Address: &addr1, Topics: []common.Hash{t1, t2}},
+		{Address: &addr2, Topics: []common.Hash{t1, t3}},
+		{Address: &addr1, Topics: []common.Hash{t2}},
+	}
+	assert.Equal(t, 3, len(FilterLogs(logs, nil, nil)))
+	assert.Equal(t, 2, len(FilterLogs(logs, []common.Address{addr1}, nil)))
+	assert.Equal(t, 2, len(FilterLogs(logs, nil, [][]common.Hash{{t1}})))
+	assert.Equal(t, logs[1:2], FilterLogs(logs, nil, [][]common.Hash{nil, {t3}}))
+	assert.Equal(t, logs[:2], FilterLogs(logs, nil, [][]common.Hash{nil, {t2, t3}}))
+	assert.Equal(t, 0, len(FilterLogs(logs, []common.Address{addr2}, [][]common.Hash{{t2}})))
+
+	var bloom etypes.Bloom
+	bloom.Add(addr1.Bytes())
+	bloom.Add(t1.Bytes())
+	assert.True(t, BloomFilter(bloom, nil, nil))
+	assert.True(t, BloomFilter(bloom, []common.Address{addr1, addr2}, [][]common.Hash{nil, {t1, t2}}))
+	assert.False(t, BloomFilter(bloom, []common.Address{addr2}, nil))
+	assert.False(t, BloomFilter(bloom, nil, [][]common.Hash{{t2}}))
+}
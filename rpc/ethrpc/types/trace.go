@@ -0,0 +1,77 @@
+package types
+
+import (
+	"encoding/json"
+
+	ctypes "github.com/33cn/chain33/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+//TraceConfig debug_trace* 追踪参数
+type TraceConfig struct {
+	//执行器注册的tracer名称, 为空时只返回状态读写以及日志
+	Tracer string `json:"tracer,omitempty"`
+}
+
+//TraceKV 状态数据读写
+type TraceKV struct {
+	Key   hexutil.Bytes `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+//TraceLog 执行步骤产生的receipt日志
+type TraceLog struct {
+	Ty  int32         `json:"ty"`
+	Log hexutil.Bytes `json:"log"`
+}
+
+//TraceStep 交易执行步骤, fee:手续费扣除, exec:执行器执行
+type TraceStep struct {
+	Name   string      `json:"name"`
+	Reads  []*TraceKV  `json:"reads"`
+	Writes []*TraceKV  `json:"writes"`
+	Logs   []*TraceLog `json:"logs"`
+	Error  string      `json:"error,omitempty"`
+}
+
+//TxTraceResult 交易执行追踪结果
+type TxTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Execer string          `json:"execer"`
+	Ty     int32           `json:"ty"`
+	Error  string          `json:"error,omitempty"`
+	Steps  []*TraceStep    `json:"steps"`
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+func toTraceKVs(kvs []*ctypes.KeyValue) []*TraceKV {
+	list := make([]*TraceKV, 0, len(kvs))
+	for _, kv := range kvs {
+		list = append(list, &TraceKV{Key: kv.GetKey(), Value: kv.GetValue()})
+	}
+	return list
+}
+
+//TxTraceToResult 转换执行器返回的交易追踪
+func TxTraceToResult(trace *ctypes.TxTrace) *TxTraceResult {
+	result := &TxTraceResult{
+		TxHash: common.BytesToHash(trace.GetHash()),
+		Execer: trace.GetExecer(),
+		Ty:     trace.GetTy(),
+		Error:  trace.GetErr(),
+		Steps:  make([]*TraceStep, 0, len(trace.GetSteps())),
+	}
+	if len(trace.GetResult()) != 0 {
+		result.Result = trace.GetResult()
+	}
+	for _, s := range trace.GetSteps() {
+		step := &TraceStep{Name: s.GetName(), Reads: toTraceKVs(s.GetReads()), Writes: toTraceKVs(s.GetWrites()), Error: s.GetErr()}
+		step.Logs = make([]*TraceLog, 0, len(s.GetLogs()))
+		for _, l := range s.GetLogs() {
+			step.Logs = append(step.Logs, &TraceLog{Ty: l.GetTy(), Log: l.GetLog()})
+		}
+		result.Steps = append(result.Steps, step)
+	}
+	return result
+}
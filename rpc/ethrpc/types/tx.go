@@ -573,3 +573,16 @@ func AssembleChain33Tx(etx *etypes.Transaction, sig, pubkey []byte, cfg *ctypes.
 	}
 	return chain33Tx
 }
+
+//PendingTxToEthTx mempool中未打包的chain33交易转换为eth交易格式, 区块相关字段为空
+func PendingTxToEthTx(itx *ctypes.Transaction, cfg *ctypes.Chain33Config) *Transaction {
+	txs, _, _ := TxsToEthTxs(common.Hash{}, 0, []*ctypes.Transaction{itx}, cfg, true)
+	if len(txs) == 0 {
+		return nil
+	}
+	tx := txs[0].(*Transaction)
+	tx.BlockHash = nil
+	tx.BlockNumber = nil
+	tx.TransactionIndex = nil
+	return tx
+}
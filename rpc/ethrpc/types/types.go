@@ -157,3 +157,33 @@ type EvmLogInfo struct {
 	TransactionHash  string      `json:"transactionHash,omitempty"`
 	TransactionIndex string      `json:"transactionIndex,omitempty"`
 }
+
+//FilterQuery eth_getLogs, eth_newFilter 查询条件
+//address 可以是单个地址或地址数组, topics 每个位置可以是null, 单个topic或topic数组
+type FilterQuery struct {
+	BlockHash *common.Hash     `json:"blockHash,omitempty"`
+	FromBlock string           `json:"fromBlock,omitempty"`
+	ToBlock   string           `json:"toBlock,omitempty"`
+	Addresses []common.Address `json:"address,omitempty"`
+	Topics    [][]common.Hash  `json:"topics,omitempty"`
+}
+
+//AccountResult eth_getProof 返回结果
+//accountProof 只包含一个元素, 为chain33 types.StateProof的编码, 可以通过区块头的stateHash验证
+//账户不存在时StateProof中为absence不存在证明, 其余字段为空账户的值
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+//StorageResult 合约存储证明
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
@@ -144,6 +144,11 @@ func (g *Grpc) QueryTransaction(ctx context.Context, in *pb.ReqHash) (*pb.Transa
 	return g.cli.QueryTx(in)
 }
 
+// GetTxStatus get recent status changes of tx
+func (g *Grpc) GetTxStatus(ctx context.Context, in *pb.ReqHash) (*pb.TxStatusHistory, error) {
+	return g.cli.GetTxStatus(in)
+}
+
 // GetBlocks get blocks by grpc
 func (g *Grpc) GetBlocks(ctx context.Context, in *pb.ReqBlocks) (*pb.Reply, error) {
 	reply, err := g.cli.GetBlocks(&pb.ReqBlocks{
@@ -308,6 +313,11 @@ func (g *Grpc) GetLastMemPool(ctx context.Context, in *pb.ReqNil) (*pb.ReplyTxLi
 	return g.cli.GetLastMempool()
 }
 
+// GetStateProof 获取状态数据的merkle证明
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.ReqStateProof) (*pb.StateProof, error) {
+	return g.cli.GetStateProof(in)
+}
+
 // GetProperFee return last mempool proper fee
 func (g *Grpc) GetProperFee(ctx context.Context, in *pb.ReqProperFee) (*pb.ReplyProperFee, error) {
 	return g.cli.GetProperFee(in)
@@ -595,6 +605,16 @@ func (g *Grpc) SignWalletRecoverTx(ctx context.Context, in *pb.ReqSignWalletReco
 	return g.cli.SignWalletRecoverTx(in)
 }
 
+// GetHTLCAddress get hash time lock contract addr
+func (g *Grpc) GetHTLCAddress(ctx context.Context, in *pb.ReqGetHTLCAddr) (*pb.ReplyString, error) {
+	return g.cli.GetHTLCAddr(in)
+}
+
+// SignHTLCTx sign hash time lock contract tx
+func (g *Grpc) SignHTLCTx(ctx context.Context, in *pb.ReqSignHTLCTx) (*pb.ReplySignRawTx, error) {
+	return g.cli.SignHTLCTx(in)
+}
+
 // GetChainConfig 获取chain config 参数
 func (g *Grpc) GetChainConfig(ctx context.Context, in *pb.ReqNil) (*pb.ChainConfigInfo, error) {
 	cfg := g.cli.GetConfig()
@@ -648,42 +668,82 @@ func (g *Grpc) GetPushSeqLastNum(ctx context.Context, in *pb.ReqString) (*pb.Int
 	return g.cli.GetPushSeqLastNum(in)
 }
 
+// ManagePush 暂停、恢复、删除订阅, 重置推送的sequence或者修改推送的URL, 需要订阅owner的签名
+func (g *Grpc) ManagePush(ctx context.Context, in *pb.ReqManagePush) (*pb.ReplySubscribePush, error) {
+	return g.cli.ManagePush(in)
+}
+
+// GetReorgHistory 获取主链切换的历史记录
+func (g *Grpc) GetReorgHistory(ctx context.Context, in *pb.ReqReorgHistory) (*pb.ReorgRecords, error) {
+	return g.cli.GetReorgHistory(in)
+}
+
+// GetCheckpoints 获取区块同步的检查点
+func (g *Grpc) GetCheckpoints(ctx context.Context, in *pb.ReqNil) (*pb.BlockCheckpoints, error) {
+	return g.cli.GetCheckpoints()
+}
+
 //SubEvent 订阅消息推送服务
 func (g *Grpc) SubEvent(in *pb.ReqSubscribe, resp pb.Chain33_SubEventServer) error {
+	//交易状态推送本节点mempool中所有交易的状态变化, 不支持按合约或者交易过滤
+	if PushType(in.Type) == PushTxStatus && (len(in.GetContract()) > 0 || in.GetTxFilter() != nil) {
+		return pb.ErrInvalidParam
+	}
 	sub := g.hashTopic(in.Name)
 	dataChan := make(chan *queue.Message, 128)
 	if sub == nil {
-		var subReq pb.PushSubscribeReq
-		subReq.Encode = "grpc"
-		subReq.Name = in.GetName()
-		subReq.Contract = in.GetContract()
-		subReq.Type = in.GetType()
-		reply, err := g.cli.AddPushSubscribe(&subReq)
-		if err != nil {
-			return err
-		}
-		if !reply.GetIsOk() {
-			return errors.New(reply.GetMsg())
-		}
 		sub = &subInfo{topic: in.GetName(), subType: (PushType)(in.Type).string(), subChan: make(map[chan *queue.Message]string), since: time.Now()}
-		//相关订阅信息加入到缓存中
-		g.addSubInfo(sub)
-	}
-
-	g.addSubChan(in.GetName(), dataChan)
-	defer g.delSubInfo(in.GetName(), dataChan)
-	var err error
-	for msg := range dataChan {
-		pushData, ok := msg.GetData().(*pb.PushData)
-		if ok {
-			err = resp.Send(pushData)
+		if PushType(in.Type) == PushTxStatus {
+			//交易状态变化由mempool直接推送, 先加入订阅信息, 避免订阅成功后的推送找不到主题
+			g.addSubInfo(sub)
+			g.addSubChan(in.GetName(), dataChan)
+			reply, err := g.cli.SubscribeTxStatus(&pb.ReqSubTxStatus{Name: in.GetName()})
+			if err == nil && !reply.GetIsOk() {
+				err = errors.New(string(reply.GetMsg()))
+			}
 			if err != nil {
-				break
+				g.delSubInfo(in.GetName(), dataChan)
+				return err
 			}
 		} else {
-			log.Error("grpc SubEvent", msg)
+			var subReq pb.PushSubscribeReq
+			subReq.Encode = "grpc"
+			subReq.Name = in.GetName()
+			subReq.Contract = in.GetContract()
+			subReq.TxFilter = in.GetTxFilter()
+			subReq.Type = in.GetType()
+			reply, err := g.cli.AddPushSubscribe(&subReq)
+			if err != nil {
+				return err
+			}
+			if !reply.GetIsOk() {
+				return errors.New(reply.GetMsg())
+			}
+			//相关订阅信息加入到缓存中
+			g.addSubInfo(sub)
 		}
 	}
 
-	return err
+	g.addSubChan(in.GetName(), dataChan)
+	defer func() {
+		if g.delSubInfo(in.GetName(), dataChan) && PushType(in.Type) == PushTxStatus {
+			_, _ = g.cli.SubscribeTxStatus(&pb.ReqSubTxStatus{Name: in.GetName(), Unsubscribe: true})
+		}
+	}()
+	for {
+		select {
+		case msg := <-dataChan:
+			pushData, ok := msg.GetData().(*pb.PushData)
+			if !ok {
+				log.Error("grpc SubEvent", msg)
+				continue
+			}
+			if err := resp.Send(pushData); err != nil {
+				return err
+			}
+		//订阅者断开连接
+		case <-resp.Context().Done():
+			return resp.Context().Err()
+		}
+	}
 }
@@ -177,6 +177,29 @@ func TestGetProperFee(t *testing.T) {
 	testGetProperFeeOK(t)
 }
 
+func TestGrpc_GetTxStatus(t *testing.T) {
+	in := &pb.ReqHash{Hash: []byte("hash")}
+	qapi.On("GetTxStatus", in).Return(&pb.TxStatusHistory{Hash: in.Hash, Status: pb.TxStatusPending}, nil)
+	data, err := g.GetTxStatus(getOkCtx(), in)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pb.TxStatusPending), data.Status)
+}
+
+func TestGrpc_GetReorgHistory(t *testing.T) {
+	in := &pb.ReqReorgHistory{Count: 1}
+	qapi.On("GetReorgHistory", in).Return(&pb.ReorgRecords{Records: []*pb.ReorgRecord{{Index: 1, Depth: 3}}}, nil)
+	data, err := g.GetReorgHistory(getOkCtx(), in)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), data.Records[0].Depth)
+}
+
+func TestGrpc_GetCheckpoints(t *testing.T) {
+	qapi.On("GetCheckpoints").Return(&pb.BlockCheckpoints{Items: []*pb.BlockCheckpoint{{Height: 10, Hash: []byte("hash")}}}, nil)
+	data, err := g.GetCheckpoints(getOkCtx(), &pb.ReqNil{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), data.Items[0].Height)
+}
+
 func testQueryChainError(t *testing.T) {
 	var in *pb.ChainExecutor
 
@@ -776,6 +799,10 @@ func TestGrpc_WalletRecoverScript(t *testing.T) {
 	assert.Equal(t, types.ErrInvalidParam, err)
 	_, err = g.SignWalletRecoverTx(getOkCtx(), nil)
 	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = g.GetHTLCAddress(getOkCtx(), nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = g.SignHTLCTx(getOkCtx(), nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
 }
 
 func TestGrpc_GetChainConfig(t *testing.T) {
@@ -850,6 +877,17 @@ func TestGrpc_ListPushes(t *testing.T) {
 
 }
 
+func TestGrpc_ManagePush(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	g := Grpc{}
+	qapi = new(mocks.QueueProtocolAPI)
+	qapi.On("GetConfig", mock.Anything).Return(cfg)
+	g.cli.QueueProtocolAPI = qapi
+	qapi.On("ManagePush", &types.ReqManagePush{}).Return(nil, types.ErrPushNoOwner)
+	_, err := g.ManagePush(getOkCtx(), &types.ReqManagePush{})
+	assert.Equal(t, types.ErrPushNoOwner, err)
+}
+
 func TestGrpc_AddPushSubscribe(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	g := Grpc{}
@@ -953,3 +991,64 @@ func TestGrpc_SubEvent(t *testing.T) {
 	t.Log("data:", data)
 
 }
+
+func TestGrpc_SubEventTxStatus(t *testing.T) {
+	c := queue.New("mytest")
+	chain33Cfg := types.NewChain33Config(types.ReadFile("../cmd/chain33/chain33.test.toml"))
+	c.SetConfig(chain33Cfg)
+	subs := make(chan *types.ReqSubTxStatus, 2)
+	go func() {
+		mcli := c.Client()
+		mcli.Sub("mempool")
+		for msg := range mcli.Recv() {
+			if msg.Ty != types.EventSubTxStatus {
+				continue
+			}
+			req := msg.GetData().(*types.ReqSubTxStatus)
+			msg.Reply(mcli.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+			subs <- req
+			if !req.Unsubscribe {
+				data := &types.PushData{Name: req.Name, Value: &types.PushData_TxStatus{TxStatus: &types.TxStatusHistory{Hash: []byte("hash"), Status: types.TxStatusPending}}}
+				mcli.Send(mcli.NewMessage("rpc", types.EventPushTxStatus, data), false)
+			}
+		}
+	}()
+	rpcCfg = new(types.RPC)
+	rpcCfg.GrpcBindAddr = "127.0.0.1:18803"
+
+	qcli := c.Client()
+	api, err := client.New(qcli, nil)
+	assert.Nil(t, err)
+	gapi := NewGRpcServer(qcli, api)
+	rpc := new(RPC)
+	rpc.cfg = rpcCfg
+	rpc.gapi = gapi
+	rpc.cli = qcli
+	rpc.api = api
+	go rpc.handleSysEvent()
+	defer gapi.Close()
+	go gapi.Listen()
+
+	time.Sleep(time.Millisecond * 500)
+	conn, err := grpc.Dial("127.0.0.1:18803", grpc.WithInsecure())
+	assert.Nil(t, err)
+	gcli := types.NewChain33Client(conn)
+	//交易状态推送不支持过滤条件
+	stream, err := gcli.SubEvent(context.Background(), &types.ReqSubscribe{Name: "test-filter", Type: int32(PushTxStatus), TxFilter: &types.PushTxFilter{}})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err = gcli.SubEvent(ctx, &types.ReqSubscribe{Name: "test-status", Type: int32(PushTxStatus)})
+	assert.Nil(t, err)
+	data, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, "test-status", data.Name)
+	assert.Equal(t, int32(types.TxStatusPending), data.GetTxStatus().Status)
+	assert.False(t, (<-subs).Unsubscribe)
+
+	//订阅结束后取消mempool的推送
+	cancel()
+	assert.True(t, (<-subs).Unsubscribe)
+}
@@ -73,6 +73,25 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			writeError(w, r, 0, `Unauthozied`)
 			return
 		}
+		//推送订阅的websocket和SSE连接, 和jrpc方法一样受白名单和黑名单限制
+		switch r.URL.Path {
+		case pushWSPath, pushSSEPath, pushAckPath:
+			if !net.ParseIP(ip).IsLoopback() && (checkJrpcFuncBlacklist(pushStreamFuncName) || !checkJrpcFuncWhitelist(pushStreamFuncName)) {
+				writeError(w, r, 0, fmt.Sprintf(`The %s method is not authorized!`, pushStreamFuncName))
+				return
+			}
+		}
+		switch r.URL.Path {
+		case pushWSPath:
+			j.streams.serveWS(w, r)
+			return
+		case pushSSEPath:
+			j.streams.serveSSE(w, r)
+			return
+		case pushAckPath:
+			j.streams.serveAck(w, r)
+			return
+		}
 		if r.URL.Path == "/" {
 			data, err := ioutil.ReadAll(r.Body)
 			if err != nil {
@@ -100,7 +119,7 @@ func (j *JSONRPCServer) Listen() (int, error) {
 					return
 				}
 			}
-			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r})
+			serverCodec := newMetricsCodec(jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r}), j.methods)
 			w.Header().Set("Content-type", "application/json")
 			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
 				w.Header().Set("Content-Encoding", "gzip")
@@ -211,6 +211,33 @@ func (c *Chain33) QueryTransaction(in rpctypes.QueryParm, result *interface{}) e
 	return nil
 }
 
+// GetTxStatus get recent status changes of tx in mempool and blockchain
+func (c *Chain33) GetTxStatus(in rpctypes.QueryParm, result *interface{}) error {
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetTxStatus(&types.ReqHash{Hash: hash})
+	if err != nil {
+		return err
+	}
+	status := &rpctypes.TxStatus{
+		Hash:   common.ToHex(reply.GetHash()),
+		Status: types.TxStatusName(reply.GetStatus()),
+		Events: make([]*rpctypes.TxStatusEvent, 0, len(reply.GetEvents())),
+	}
+	for _, event := range reply.GetEvents() {
+		status.Events = append(status.Events, &rpctypes.TxStatusEvent{
+			Status: types.TxStatusName(event.GetStatus()),
+			Reason: event.GetReason(),
+			Height: event.GetHeight(),
+			Time:   event.GetTime(),
+		})
+	}
+	*result = status
+	return nil
+}
+
 // GetBlocks get block information
 func (c *Chain33) GetBlocks(in rpctypes.BlockParam, result *interface{}) error {
 	reply, err := c.cli.GetBlocks(&types.ReqBlocks{Start: in.Start, End: in.End, IsDetail: in.Isdetail, Pid: []string{""}})
@@ -741,6 +768,28 @@ func (c *Chain33) GetProperFee(in *types.ReqProperFee, result *interface{}) erro
 	return nil
 }
 
+// GetStateProof 获取状态数据的merkle证明
+func (c *Chain33) GetStateProof(in *rpctypes.ReqStateProof, result *interface{}) error {
+	if in == nil || in.Key == "" {
+		return types.ErrInvalidParam
+	}
+	stateHash, err := common.FromHex(in.StateHash)
+	if err != nil {
+		return err
+	}
+	reply, err := c.cli.GetStateProof(&types.ReqStateProof{Key: []byte(in.Key), StateHash: stateHash})
+	if err != nil {
+		return err
+	}
+	*result = &rpctypes.StateProof{
+		Key:       in.Key,
+		Value:     common.ToHex(reply.GetValue()),
+		StateHash: common.ToHex(reply.GetStateHash()),
+		Proof:     common.ToHex(types.Encode(reply)),
+	}
+	return nil
+}
+
 // GetBlockOverview get overview of block
 // GetBlockOverview(parm *types.ReqHash) (*types.BlockOverview, error)
 func (c *Chain33) GetBlockOverview(in rpctypes.QueryParm, result *interface{}) error {
@@ -1295,6 +1344,81 @@ func (c *Chain33) GetPushSeqLastNum(in *types.ReqString, result *interface{}) er
 	return nil
 }
 
+// ManagePush 暂停、恢复、删除订阅, 重置推送的sequence或者修改推送的URL, 需要订阅owner的签名
+func (c *Chain33) ManagePush(in *rpctypes.ReqManagePush, result *interface{}) error {
+	if in == nil || in.Signature == nil {
+		return types.ErrInvalidParam
+	}
+	pubkey, err := common.FromHex(in.Signature.Pubkey)
+	if err != nil {
+		return err
+	}
+	sign, err := common.FromHex(in.Signature.Signature)
+	if err != nil {
+		return err
+	}
+	req := &types.ReqManagePush{
+		Name:         in.Name,
+		Op:           in.Op,
+		LastSequence: in.LastSequence,
+		URL:          in.URL,
+		Nonce:        in.Nonce,
+		Signature:    &types.Signature{Ty: in.Signature.Ty, Pubkey: pubkey, Signature: sign},
+	}
+	resp, err := c.cli.ManagePush(req)
+	if err != nil {
+		return err
+	}
+	*result = resp
+	return nil
+}
+
+// GetReorgHistory 获取主链切换的历史记录
+func (c *Chain33) GetReorgHistory(in *types.ReqReorgHistory, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	reply, err := c.cli.GetReorgHistory(in)
+	if err != nil {
+		return err
+	}
+	records := &rpctypes.ReorgRecords{Records: make([]*rpctypes.ReorgRecord, 0, len(reply.GetRecords()))}
+	for _, record := range reply.GetRecords() {
+		records.Records = append(records.Records, &rpctypes.ReorgRecord{
+			Index:          record.GetIndex(),
+			OldTipHash:     common.ToHex(record.GetOldTipHash()),
+			OldTipHeight:   record.GetOldTipHeight(),
+			NewTipHash:     common.ToHex(record.GetNewTipHash()),
+			NewTipHeight:   record.GetNewTipHeight(),
+			AncestorHash:   common.ToHex(record.GetAncestorHash()),
+			AncestorHeight: record.GetAncestorHeight(),
+			Depth:          record.GetDepth(),
+			Time:           record.GetTime(),
+			Sequence:       record.GetSequence(),
+			Incomplete:     record.GetIncomplete(),
+		})
+	}
+	*result = records
+	return nil
+}
+
+// GetCheckpoints 获取区块同步的检查点
+func (c *Chain33) GetCheckpoints(in *types.ReqNil, result *interface{}) error {
+	reply, err := c.cli.GetCheckpoints()
+	if err != nil {
+		return err
+	}
+	checkpoints := &rpctypes.Checkpoints{Items: make([]*rpctypes.Checkpoint, 0, len(reply.GetItems()))}
+	for _, item := range reply.GetItems() {
+		checkpoints.Items = append(checkpoints.Items, &rpctypes.Checkpoint{
+			Height: item.GetHeight(),
+			Hash:   common.ToHex(item.GetHash()),
+		})
+	}
+	*result = checkpoints
+	return nil
+}
+
 func convertBlockDetails(details []*types.BlockDetail, retDetails *rpctypes.BlockDetails, isDetail bool, coinPercision int64) error {
 	for _, item := range details {
 		var bdtl rpctypes.BlockDetail
@@ -1624,6 +1748,22 @@ func (c *Chain33) SignWalletRecoverTx(req *types.ReqSignWalletRecoverTx, result
 	return err
 }
 
+// GetHTLCAddress get hash time lock contract chain33 addr
+func (c *Chain33) GetHTLCAddress(req *types.ReqGetHTLCAddr, result *interface{}) error {
+
+	reply, err := c.cli.GetHTLCAddr(req)
+	*result = reply.GetData()
+	return err
+}
+
+// SignHTLCTx sign hash time lock contract claim or refund transaction
+func (c *Chain33) SignHTLCTx(req *types.ReqSignHTLCTx, result *interface{}) error {
+
+	reply, err := c.cli.SignHTLCTx(req)
+	*result = reply.GetTxHex()
+	return err
+}
+
 // GetChainConfig 获取chain config 参数
 func (c *Chain33) GetChainConfig(in *types.ReqNil, result *interface{}) error {
 	cfg := c.cli.GetConfig()
@@ -576,6 +576,72 @@ func TestChain33_QueryTransaction(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, api)
 }
 
+func TestChain33_GetTxStatus(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	hash := []byte("hash")
+	api.On("GetTxStatus", &types.ReqHash{Hash: hash}).Return(&types.TxStatusHistory{
+		Hash:   hash,
+		Status: types.TxStatusPacked,
+		Events: []*types.TxStatusEvent{
+			{Status: types.TxStatusPending, Time: 1},
+			{Status: types.TxStatusPacked, Height: 10, Time: 2},
+		},
+	}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetTxStatus(rpctypes.QueryParm{Hash: common.ToHex(hash)}, &testResult)
+	assert.Nil(t, err)
+	status := testResult.(*rpctypes.TxStatus)
+	assert.Equal(t, common.ToHex(hash), status.Hash)
+	assert.Equal(t, "packed", status.Status)
+	assert.Equal(t, 2, len(status.Events))
+	assert.Equal(t, "pending", status.Events[0].Status)
+	assert.Equal(t, int64(10), status.Events[1].Height)
+}
+
+func TestChain33_GetReorgHistory(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	req := &types.ReqReorgHistory{Count: 10}
+	api.On("GetReorgHistory", req).Return(&types.ReorgRecords{Records: []*types.ReorgRecord{
+		{Index: 1, OldTipHash: []byte("old"), OldTipHeight: 12, NewTipHash: []byte("new"), NewTipHeight: 13,
+			AncestorHash: []byte("ancestor"), AncestorHeight: 10, Depth: 2, Time: 1, Sequence: 20, Incomplete: true},
+	}}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetReorgHistory(nil, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	err = testChain33.GetReorgHistory(req, &testResult)
+	assert.Nil(t, err)
+	records := testResult.(*rpctypes.ReorgRecords)
+	assert.Equal(t, 1, len(records.Records))
+	assert.Equal(t, common.ToHex([]byte("old")), records.Records[0].OldTipHash)
+	assert.Equal(t, common.ToHex([]byte("ancestor")), records.Records[0].AncestorHash)
+	assert.Equal(t, int64(2), records.Records[0].Depth)
+	assert.Equal(t, int64(20), records.Records[0].Sequence)
+	assert.True(t, records.Records[0].Incomplete)
+}
+
+func TestChain33_GetCheckpoints(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	api.On("GetCheckpoints").Return(&types.BlockCheckpoints{Items: []*types.BlockCheckpoint{
+		{Height: 100, Hash: []byte("checkpoint")},
+	}}, nil)
+	testChain33 := newTestChain33(api)
+	var testResult interface{}
+	err := testChain33.GetCheckpoints(&types.ReqNil{}, &testResult)
+	assert.Nil(t, err)
+	checkpoints := testResult.(*rpctypes.Checkpoints)
+	assert.Equal(t, 1, len(checkpoints.Items))
+	assert.Equal(t, int64(100), checkpoints.Items[0].Height)
+	assert.Equal(t, common.ToHex([]byte("checkpoint")), checkpoints.Items[0].Hash)
+}
+
 func TestChain33_QueryTransactionOk(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	data := rpctypes.QueryParm{
@@ -1575,6 +1641,31 @@ func TestChain33_GetTotalCoins(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_GetStateProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	err := client.GetStateProof(&rpctypes.ReqStateProof{}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	stateHash := []byte("statehash")
+	proof := &types.StateProof{Key: []byte("key"), Value: []byte("value"), StateHash: stateHash, Proof: &types.MAVLProof{RootHash: stateHash}}
+	api.On("GetLastHeader").Return(&types.Header{StateHash: stateHash}, nil)
+	api.On("StoreGetProof", &types.ReqStateProof{Key: []byte("key"), StateHash: stateHash}).Return(proof, nil)
+	err = client.GetStateProof(&rpctypes.ReqStateProof{Key: "key"}, &testResult)
+	assert.NoError(t, err)
+	reply := testResult.(*rpctypes.StateProof)
+	assert.Equal(t, common.ToHex(stateHash), reply.StateHash)
+	assert.Equal(t, common.ToHex([]byte("value")), reply.Value)
+	assert.Equal(t, common.ToHex(types.Encode(proof)), reply.Proof)
+
+	api.On("StoreGetProof", mock.Anything).Return(nil, types.ErrNotFound)
+	err = client.GetStateProof(&rpctypes.ReqStateProof{Key: "key", StateHash: "0x1234"}, &testResult)
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
 func TestChain33_GetFatalFailure(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1643,6 +1734,23 @@ func TestChain33_GetSeqCallBackLastNum(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChain33_ManagePush(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestChain33(api)
+	var testResult interface{}
+	err := client.ManagePush(&rpctypes.ReqManagePush{Name: "test"}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	api.On("ManagePush", &types.ReqManagePush{Name: "test", Op: 1, Nonce: 1,
+		Signature: &types.Signature{Ty: 1, Pubkey: []byte("pubkey"), Signature: []byte("sign")}}).Return(&types.ReplySubscribePush{IsOk: true}, nil)
+	err = client.ManagePush(&rpctypes.ReqManagePush{Name: "test", Op: 1, Nonce: 1,
+		Signature: &rpctypes.Signature{Ty: 1, Pubkey: common.ToHex([]byte("pubkey")), Signature: common.ToHex([]byte("sign"))}}, &testResult)
+	assert.NoError(t, err)
+	assert.True(t, testResult.(*types.ReplySubscribePush).IsOk)
+}
+
 func TestChain33_ConvertExectoAddr(t *testing.T) {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -1856,6 +1964,10 @@ func TestChain33_WalletRecoverScript(t *testing.T) {
 	require.Equal(t, types.ErrInvalidParam, err)
 	err = chain33.SignWalletRecoverTx(nil, &result)
 	require.Equal(t, types.ErrInvalidParam, err)
+	err = chain33.GetHTLCAddress(nil, &result)
+	require.Equal(t, types.ErrInvalidParam, err)
+	err = chain33.SignHTLCTx(nil, &result)
+	require.Equal(t, types.ErrInvalidParam, err)
 }
 
 func TestChain33_GetChainConfig(t *testing.T) {
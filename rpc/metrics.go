@@ -0,0 +1,82 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"net/rpc"
+	"reflect"
+	"sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// markRPCMetrics 统计每个rpc接口的调用耗时和错误次数
+func markRPCMetrics(server, method string, start time.Time, failed bool) {
+	name := "rpc/" + server + "/" + method
+	metrics.GetOrRegisterTimer(name+"/latency", nil).UpdateSince(start)
+	if failed {
+		metrics.GetOrRegisterCounter(name+"/error", nil).Inc(1)
+	}
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// rpcMethods 记录已注册的jsonrpc接口, 只有已注册的接口单独统计
+type rpcMethods struct {
+	mu      sync.RWMutex
+	methods map[string]bool
+}
+
+func newRPCMethods() *rpcMethods {
+	return &rpcMethods{methods: make(map[string]bool)}
+}
+
+// add 按照net/rpc的规则记录可以被调用的方法
+func (m *rpcMethods) add(name string, rcvr interface{}) {
+	typ := reflect.TypeOf(rcvr)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := 0; i < typ.NumMethod(); i++ {
+		mtype := typ.Method(i).Type
+		if typ.Method(i).PkgPath != "" || mtype.NumIn() != 3 || mtype.In(2).Kind() != reflect.Ptr ||
+			mtype.NumOut() != 1 || mtype.Out(0) != errorType {
+			continue
+		}
+		m.methods[name+"."+typ.Method(i).Name] = true
+	}
+}
+
+func (m *rpcMethods) has(method string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.methods[method]
+}
+
+// metricsCodec 包装jsonrpc的ServerCodec, 在返回结果时记录接口统计
+type metricsCodec struct {
+	rpc.ServerCodec
+	methods *rpcMethods
+	start   time.Time
+}
+
+func newMetricsCodec(codec rpc.ServerCodec, methods *rpcMethods) rpc.ServerCodec {
+	return &metricsCodec{ServerCodec: codec, methods: methods}
+}
+
+func (c *metricsCodec) ReadRequestHeader(r *rpc.Request) error {
+	c.start = time.Now()
+	return c.ServerCodec.ReadRequestHeader(r)
+}
+
+func (c *metricsCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	method := r.ServiceMethod
+	// 未注册的接口统一记录, 避免任意请求的方法名产生大量统计项
+	if !c.methods.has(method) {
+		method = "unknown"
+	}
+	markRPCMetrics("jsonrpc", method, c.start, r.Error != "")
+	return c.ServerCodec.WriteResponse(r, body)
+}
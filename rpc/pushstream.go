@@ -0,0 +1,360 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	pushWSPath     = "/push/ws"
+	pushSSEPath    = "/push/sse"
+	pushAckPath    = "/push/ack"
+	pushEncodeJSON = "jrpc"
+	//推送连接的接口在jrpc方法白名单和黑名单中使用的名称
+	pushStreamFuncName = "AttachPush"
+)
+
+//客户端确认推送数据的超时时间, 需要小于blockchain等待推送结果的时间
+var pushStreamAckTimeout = 30 * time.Second
+
+var errPushStreamClosed = errors.New("ErrPushStreamClosed")
+
+//使用默认的来源检查, 浏览器只能从同源的页面发起连接
+var wsUpgrader = websocket.Upgrader{}
+
+//pushStream 附加到推送订阅的websocket或者SSE连接, blockchain每次推送一笔数据, 客户端确认后才推送下一笔,
+//SSE客户端通过附加时下发的token确认数据
+type pushStream struct {
+	name   string
+	token  string
+	dataCh chan *queue.Message
+	ackCh  chan int64
+}
+
+//pushStreams 推送订阅名称到连接的映射, 每个订阅同一时间只能附加一个连接
+type pushStreams struct {
+	mu      sync.Mutex
+	streams map[string]*pushStream
+	qclient queue.Client
+	api     client.QueueProtocolAPI
+}
+
+func newPushStreams(c queue.Client, api client.QueueProtocolAPI) *pushStreams {
+	return &pushStreams{
+		streams: make(map[string]*pushStream),
+		qclient: c,
+		api:     api,
+	}
+}
+
+//parseAttachPush 从 ?name=xx&nonce=xx&pubkey=xx&signature=xx&signType=xx 解析owner签名的附加请求,
+//签名数据和ManagePush相同, op为6, 公钥和签名使用hex编码, signType默认为secp256k1
+func parseAttachPush(r *http.Request) (*types.ReqAttachPush, error) {
+	query := r.URL.Query()
+	req := &types.ReqAttachPush{Name: query.Get("name"), Attach: true}
+	if req.Name == "" {
+		return nil, types.ErrInvalidParam
+	}
+	nonce, err := strconv.ParseInt(query.Get("nonce"), 10, 64)
+	if err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	req.Nonce = nonce
+	sign := &types.Signature{Ty: types.SECP256K1}
+	if ty := query.Get("signType"); ty != "" {
+		signType, err := strconv.ParseInt(ty, 10, 32)
+		if err != nil {
+			return nil, types.ErrInvalidParam
+		}
+		sign.Ty = int32(signType)
+	}
+	if sign.Pubkey, err = common.FromHex(query.Get("pubkey")); err != nil {
+		return nil, err
+	}
+	if sign.Signature, err = common.FromHex(query.Get("signature")); err != nil {
+		return nil, err
+	}
+	req.Signature = sign
+	return req, nil
+}
+
+func newPushStreamToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return common.ToHex(token), nil
+}
+
+func (s *pushStreams) attach(r *http.Request) (*pushStream, *types.PushSubscribeReq, error) {
+	req, err := parseAttachPush(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	token, err := newPushStreamToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	name := req.Name
+	s.mu.Lock()
+	if s.streams[name] != nil {
+		s.mu.Unlock()
+		return nil, nil, types.ErrPushStreamAttached
+	}
+	stream := &pushStream{
+		name:   name,
+		token:  token,
+		dataCh: make(chan *queue.Message, 1),
+		ackCh:  make(chan int64, 1),
+	}
+	s.streams[name] = stream
+	s.mu.Unlock()
+
+	subscribe, err := s.api.AttachPush(req)
+	if err != nil {
+		s.mu.Lock()
+		delete(s.streams, name)
+		s.mu.Unlock()
+		return nil, nil, err
+	}
+	return stream, subscribe, nil
+}
+
+//detach 断开连接后, 未确认的推送数据返回失败, blockchain从最后确认的sequence之后重新推送
+func (s *pushStreams) detach(stream *pushStream) {
+	s.mu.Lock()
+	if s.streams[stream.name] == stream {
+		delete(s.streams, stream.name)
+	}
+	s.mu.Unlock()
+	select {
+	case msg := <-stream.dataCh:
+		s.reply(msg, errPushStreamClosed)
+	default:
+	}
+	if _, err := s.api.AttachPush(&types.ReqAttachPush{Name: stream.name}); err != nil {
+		log.Error("pushStreams detach", "name", stream.name, "err", err)
+	}
+}
+
+//deliver 将blockchain推送的数据交给附加的连接
+func (s *pushStreams) deliver(msg *queue.Message) {
+	data, ok := msg.GetData().(*types.PushStreamData)
+	if !ok {
+		s.reply(msg, types.ErrInvalidParam)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stream := s.streams[data.GetName()]
+	if stream == nil {
+		s.reply(msg, types.ErrPushNotAttached)
+		return
+	}
+	select {
+	case stream.dataCh <- msg:
+	default:
+		s.reply(msg, queue.ErrQueueChannelFull)
+	}
+}
+
+func (s *pushStreams) reply(msg *queue.Message, err error) {
+	reply := &types.Reply{IsOk: err == nil}
+	if err != nil {
+		reply.Msg = []byte(err.Error())
+	}
+	msg.Reply(s.qclient.NewMessage("blockchain", types.EventPushStream, reply))
+}
+
+//ack SSE客户端通过token确认推送数据
+func (s *pushStreams) ack(name, token string, seq int64) error {
+	s.mu.Lock()
+	stream := s.streams[name]
+	s.mu.Unlock()
+	if stream == nil {
+		return types.ErrPushNotAttached
+	}
+	if subtle.ConstantTimeCompare([]byte(stream.token), []byte(token)) != 1 {
+		return types.ErrPushNotOwner
+	}
+	stream.ack(seq)
+	return nil
+}
+
+//ack 确认推送数据, seq小于0时确认当前等待确认的数据
+func (stream *pushStream) ack(seq int64) {
+	select {
+	case stream.ackCh <- seq:
+	default:
+	}
+}
+
+//serve 发送推送数据并等待客户端确认, 将结果返回给blockchain, 发送失败或者确认超时后关闭连接
+func (s *pushStreams) serve(stream *pushStream, done <-chan struct{}, send func(*types.PushStreamData) error) {
+	for {
+		select {
+		case msg := <-stream.dataCh:
+			data := msg.GetData().(*types.PushStreamData)
+			err := send(data)
+			if err == nil {
+				err = stream.waitAck(data.Seq, done)
+			}
+			s.reply(msg, err)
+			if err != nil {
+				log.Error("pushStreams serve", "name", stream.name, "seq", data.Seq, "err", err)
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (stream *pushStream) waitAck(seq int64, done <-chan struct{}) error {
+	timer := time.NewTimer(pushStreamAckTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case ack := <-stream.ackCh:
+			//忽略之前数据的重复确认
+			if ack < 0 || ack == seq {
+				return nil
+			}
+		case <-timer.C:
+			return types.ErrTimeout
+		case <-done:
+			return errPushStreamClosed
+		}
+	}
+}
+
+//serveWS 通过 /push/ws 附加到推送订阅, 参数见parseAttachPush, jrpc编码的数据使用文本消息, 其他编码使用二进制消息,
+//客户端在同一个连接上回复 ok 或者推送的sequence 确认收到数据
+func (s *pushStreams) serveWS(w http.ResponseWriter, r *http.Request) {
+	stream, subscribe, err := s.attach(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer s.detach(stream)
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error("pushStreams serveWS", "name", stream.name, "err", err)
+		return
+	}
+	defer conn.Close()
+	log.Info("pushStreams serveWS", "name", subscribe.Name, "type", subscribe.Type, "encode", subscribe.Encode)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if seq, ok := parsePushAck(message); ok {
+				stream.ack(seq)
+			}
+		}
+	}()
+	s.serve(stream, done, func(data *types.PushStreamData) error {
+		msgType := websocket.BinaryMessage
+		if data.Encode == pushEncodeJSON {
+			msgType = websocket.TextMessage
+		}
+		_ = conn.SetWriteDeadline(time.Now().Add(pushStreamAckTimeout))
+		return conn.WriteMessage(msgType, data.Data)
+	})
+}
+
+//serveSSE 通过 /push/sse 附加到推送订阅, 参数见parseAttachPush, 第一个事件attached的数据为确认使用的token,
+//之后的事件id为推送的sequence, 客户端通过 /push/ack?name=xx&token=xx&seq=xx 确认收到数据
+func (s *pushStreams) serveSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	stream, subscribe, err := s.attach(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer s.detach(stream)
+	log.Info("pushStreams serveSSE", "name", subscribe.Name, "type", subscribe.Type, "encode", subscribe.Encode)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if _, err = fmt.Fprintf(w, "event: attached\ndata: %s\n\n", stream.token); err != nil {
+		return
+	}
+	flusher.Flush()
+	s.serve(stream, r.Context().Done(), func(data *types.PushStreamData) error {
+		if err := writeSSEEvent(w, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+}
+
+//serveAck SSE客户端确认收到的推送数据
+func (s *pushStreams) serveAck(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	seq, err := strconv.ParseInt(query.Get("seq"), 10, 64)
+	if err == nil {
+		err = s.ack(query.Get("name"), query.Get("token"), seq)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+func parsePushAck(message []byte) (int64, bool) {
+	ack := strings.TrimSpace(string(message))
+	if ack == "ok" || ack == "OK" {
+		return -1, true
+	}
+	seq, err := strconv.ParseInt(ack, 10, 64)
+	return seq, err == nil
+}
+
+//writeSSEEvent jrpc编码的数据直接发送, 其他编码的数据使用hex编码
+func writeSSEEvent(w io.Writer, data *types.PushStreamData) error {
+	payload := string(data.Data)
+	if data.Encode != pushEncodeJSON {
+		payload = common.ToHex(data.Data)
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %d\nevent: %s\n", data.Seq, PushType(data.Type).string())
+	for _, line := range strings.Split(payload, "\n") {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
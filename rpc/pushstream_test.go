@@ -0,0 +1,151 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPushStreams(t *testing.T) (*pushStreams, *mocks.QueueProtocolAPI, queue.Client, *httptest.Server) {
+	q := queue.New("test")
+	t.Cleanup(q.Close)
+	api := new(mocks.QueueProtocolAPI)
+	streams := newPushStreams(q.Client(), api)
+	mux := http.NewServeMux()
+	mux.HandleFunc(pushWSPath, streams.serveWS)
+	mux.HandleFunc(pushSSEPath, streams.serveSSE)
+	mux.HandleFunc(pushAckPath, streams.serveAck)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return streams, api, q.Client(), server
+}
+
+//附加请求的签名由blockchain校验, 这里只检查参数的解析
+const testAttachQuery = "&nonce=1&pubkey=0x01&signature=0x02"
+
+func testAttachReq(name string) *types.ReqAttachPush {
+	return &types.ReqAttachPush{Name: name, Attach: true, Nonce: 1, Signature: &types.Signature{Ty: types.SECP256K1, Pubkey: []byte{1}, Signature: []byte{2}}}
+}
+
+func deliverPushStream(t *testing.T, streams *pushStreams, cli queue.Client, data *types.PushStreamData) *types.Reply {
+	msg := queue.NewMessage(1, "rpc", types.EventPushStream, data)
+	streams.deliver(msg)
+	resp, err := cli.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	return resp.GetData().(*types.Reply)
+}
+
+func TestPushStreams_WS(t *testing.T) {
+	streams, api, cli, server := newTestPushStreams(t)
+	api.On("AttachPush", testAttachReq("ws-test")).Return(&types.PushSubscribeReq{Name: "ws-test", Encode: "jrpc"}, nil)
+	api.On("AttachPush", &types.ReqAttachPush{Name: "ws-test"}).Return(&types.PushSubscribeReq{Name: "ws-test"}, nil)
+	api.On("AttachPush", testAttachReq("ws-notexist")).Return(nil, types.ErrPushNotSubscribed)
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + pushWSPath
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL+"?name=ws-notexist"+testAttachQuery, nil)
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	//没有签名的请求直接拒绝
+	_, resp, err = websocket.DefaultDialer.Dial(wsURL+"?name=ws-test", nil)
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	//跨域的浏览器请求被拒绝
+	_, resp, err = websocket.DefaultDialer.Dial(wsURL+"?name=ws-test"+testAttachQuery, http.Header{"Origin": []string{"http://evil.example"}})
+	require.NotNil(t, err)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?name=ws-test"+testAttachQuery, nil)
+	require.Nil(t, err)
+	//同一个订阅只能附加一个连接
+	_, _, err = websocket.DefaultDialer.Dial(wsURL+"?name=ws-test"+testAttachQuery, nil)
+	require.NotNil(t, err)
+
+	done := make(chan *types.Reply)
+	go func() {
+		done <- deliverPushStream(t, streams, cli, &types.PushStreamData{Name: "ws-test", Encode: "jrpc", Seq: 10, Data: []byte(`{"seqs":[]}`)})
+	}()
+	msgType, message, err := conn.ReadMessage()
+	require.Nil(t, err)
+	require.Equal(t, websocket.TextMessage, msgType)
+	require.Equal(t, `{"seqs":[]}`, string(message))
+	//之前数据的确认被忽略
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("9")))
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("ok")))
+	require.True(t, (<-done).IsOk)
+
+	//连接断开后推送失败, 通知blockchain断开
+	require.Nil(t, conn.Close())
+	require.Eventually(t, func() bool {
+		reply := deliverPushStream(t, streams, cli, &types.PushStreamData{Name: "ws-test", Seq: 11})
+		return string(reply.Msg) == types.ErrPushNotAttached.Error()
+	}, 5*time.Second, 50*time.Millisecond)
+	api.AssertCalled(t, "AttachPush", &types.ReqAttachPush{Name: "ws-test"})
+}
+
+func TestPushStreams_SSE(t *testing.T) {
+	streams, api, cli, server := newTestPushStreams(t)
+	api.On("AttachPush", testAttachReq("sse-test")).Return(&types.PushSubscribeReq{Name: "sse-test"}, nil)
+	api.On("AttachPush", &types.ReqAttachPush{Name: "sse-test"}).Return(&types.PushSubscribeReq{Name: "sse-test"}, nil)
+
+	resp, err := http.Get(server.URL + pushSSEPath + "?name=sse-test" + testAttachQuery)
+	require.Nil(t, err)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	reader := bufio.NewReader(resp.Body)
+	readLines := func(n int) []string {
+		var lines []string
+		for len(lines) < n {
+			line, err := reader.ReadString('\n')
+			require.Nil(t, err)
+			lines = append(lines, strings.TrimSpace(line))
+		}
+		return lines
+	}
+	//第一个事件下发确认使用的token
+	lines := readLines(3)
+	require.Equal(t, "event: attached", lines[0])
+	token := strings.TrimPrefix(lines[1], "data: ")
+	require.NotEmpty(t, token)
+
+	done := make(chan *types.Reply)
+	go func() {
+		done <- deliverPushStream(t, streams, cli, &types.PushStreamData{Name: "sse-test", Type: 1, Seq: 5, Data: []byte{1, 2}})
+	}()
+	require.Equal(t, []string{"id: 5", "event: PushBlockHeader", "data: 0x0102"}, readLines(3))
+
+	//没有token不能确认
+	ack, err := http.Post(server.URL+pushAckPath+"?name=sse-test&seq=5", "text/plain", nil)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, ack.StatusCode)
+	require.Nil(t, ack.Body.Close())
+	ack, err = http.Post(server.URL+pushAckPath+"?name=sse-test&seq=5&token="+token, "text/plain", nil)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, ack.StatusCode)
+	require.Nil(t, ack.Body.Close())
+	require.True(t, (<-done).IsOk)
+
+	//确认超时后断开连接
+	pushStreamAckTimeout = 100 * time.Millisecond
+	defer func() { pushStreamAckTimeout = 30 * time.Second }()
+	reply := deliverPushStream(t, streams, cli, &types.PushStreamData{Name: "sse-test", Type: 1, Seq: 6, Data: []byte{3}})
+	require.False(t, reply.IsOk)
+	require.Equal(t, types.ErrTimeout.Error(), string(reply.Msg))
+	require.Nil(t, resp.Body.Close())
+
+	ack, err = http.Post(server.URL+pushAckPath+"?name=sse-test&seq=x", "text/plain", nil)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusBadRequest, ack.StatusCode)
+	require.Nil(t, ack.Body.Close())
+}
@@ -96,7 +96,8 @@ func (g *Grpc) addSubChan(topic string, dch chan *queue.Message) {
 	}
 }
 
-func (g *Grpc) delSubInfo(topic string, dch chan *queue.Message) {
+//delSubInfo 删除订阅者, 主题下没有订阅者时删除主题并返回true
+func (g *Grpc) delSubInfo(topic string, dch chan *queue.Message) bool {
 	g.cachelock.Lock()
 	defer g.cachelock.Unlock()
 	info, ok := g.subCache[topic]
@@ -104,9 +105,10 @@ func (g *Grpc) delSubInfo(topic string, dch chan *queue.Message) {
 		delete(info.subChan, dch)
 		if len(info.subChan) == 0 {
 			delete(g.subCache, topic)
+			return true
 		}
 	}
-
+	return false
 }
 func (g *Grpc) hashTopic(topic string) *subInfo {
 	g.cachelock.Lock()
@@ -146,9 +148,21 @@ func NewGrpcServer() *Grpcserver {
 
 // JSONRPCServer  a json rpcserver object
 type JSONRPCServer struct {
-	jrpc *Chain33
-	s    *rpc.Server
-	l    net.Listener
+	jrpc    *Chain33
+	s       *rpc.Server
+	l       net.Listener
+	streams *pushStreams
+	methods *rpcMethods
+}
+
+// RegisterName 注册jsonrpc服务, 同时记录已注册的接口用于统计
+func (s *JSONRPCServer) RegisterName(name string, rcvr interface{}) error {
+	err := s.s.RegisterName(name, rcvr)
+	if err != nil {
+		return err
+	}
+	s.methods.add(name, rcvr)
+	return nil
 }
 
 // Close json rpcserver close
@@ -269,7 +283,10 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 			return nil, err
 		}
 		// Continue processing the request
-		return handler(ctx, req)
+		start := time.Now()
+		resp, err = handler(ctx, req)
+		markRPCMetrics("grpc", info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:], start, err != nil)
+		return resp, err
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
 	if rpcCfg.EnableTLS {
@@ -296,8 +313,9 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 
 // NewJSONRPCServer new json rpcserver object
 func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServer {
-	j := &JSONRPCServer{jrpc: &Chain33{}}
+	j := &JSONRPCServer{jrpc: &Chain33{}, methods: newRPCMethods()}
 	j.jrpc.cli.Init(c, api)
+	j.streams = newPushStreams(c, j.jrpc.cli.QueueProtocolAPI)
 	if c.GetConfig().IsPara() {
 		grpcCli, err := grpcclient.NewMainChainClient(c.GetConfig(), "")
 		if err != nil {
@@ -305,9 +323,8 @@ func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServe
 		}
 		j.jrpc.mainGrpcCli = grpcCli
 	}
-	server := rpc.NewServer()
-	j.s = server
-	err := server.RegisterName("Chain33", j.jrpc)
+	j.s = rpc.NewServer()
+	err := j.RegisterName("Chain33", j.jrpc)
 	if err != nil {
 		return nil
 	}
@@ -437,6 +454,9 @@ func (r *RPC) handleSysEvent() {
 			currentNonce, _ := strconv.Atoi(nonce.Nonce)
 			msg.Reply(r.cli.NewMessage("", types.EventGetEvmNonce, &types.EvmAccountNonce{Nonce: int64(currentNonce), Addr: addr.String()}))
 
+		case types.EventPushStream:
+			r.japi.streams.deliver(msg)
+
 		default:
 			topicInfo := r.gapi.grpc.hashTopic(msg.GetData().(*types.PushData).GetName())
 			if topicInfo != nil {
@@ -459,6 +479,10 @@ func (r *RPC) handleSysEvent() {
 				//要求blockchain模块停止推送
 				log.Error("handleSysEvent", "no subscriber,all topic", r.gapi.grpc.subCache, "no topic:", msg.GetData().(*types.PushData).GetName(), "subchan:", topicInfo)
 				msg.Reply(r.cli.NewMessage("blockchain", msg.Ty, &types.Reply{IsOk: false, Msg: []byte("no subscriber")}))
+				if msg.Ty == types.EventPushTxStatus {
+					//要求mempool停止推送交易状态
+					go cli.SubscribeTxStatus(&types.ReqSubTxStatus{Name: msg.GetData().(*types.PushData).GetName(), Unsubscribe: true})
+				}
 			}
 		}
 
@@ -519,6 +543,11 @@ func (r *RPC) JRPC() *rpc.Server {
 	return r.japi.s
 }
 
+// RegisterJrpcName 注册插件的jsonrpc服务
+func (r *RPC) RegisterJrpcName(name string, rcvr interface{}) error {
+	return r.japi.RegisterName(name, rcvr)
+}
+
 // Close rpc close
 func (r *RPC) Close() {
 
@@ -642,6 +671,9 @@ func checkFilterPrintFuncBlacklist(funcName string) bool {
 // PushType ...
 type PushType int32
 
+//PushTxStatus 交易状态变化的推送类型, 由mempool推送, 不经过blockchain的区块序列推送
+const PushTxStatus PushType = 5
+
 func (pushType PushType) string() string {
-	return []string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "NotSupported"}[pushType]
+	return []string{"PushBlock", "PushBlockHeader", "PushTxReceipt", "PushTxResult", "PushEVMEvent", "PushTxStatus", "PushReorg", "NotSupported"}[pushType]
 }
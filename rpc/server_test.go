@@ -371,6 +371,15 @@ func TestRPC(t *testing.T) {
 	assert.Equal(t, client, rpc.GetQueueClient())
 	assert.NotNil(t, rpc.GRPC())
 	assert.NotNil(t, rpc.JRPC())
+	assert.True(t, rpc.japi.methods.has("Chain33.GetLastHeader"))
+}
+
+func TestRPCMethods(t *testing.T) {
+	methods := newRPCMethods()
+	methods.add("Chain33", &Chain33{})
+	assert.True(t, methods.has("Chain33.GetLastHeader"))
+	assert.False(t, methods.has("Chain33.NotExist"))
+	assert.False(t, methods.has("Unknown.GetLastHeader"))
 }
 
 func TestCheckFuncList(t *testing.T) {
@@ -21,6 +21,11 @@ type RPCServer interface {
 	JRPC() *rpc.Server
 }
 
+// jrpcRegister rpc服务支持直接注册jsonrpc接口时, 可以记录已注册的接口
+type jrpcRegister interface {
+	RegisterJrpcName(name string, rcvr interface{}) error
+}
+
 // ChannelClient interface
 type ChannelClient struct {
 	client.QueueProtocolAPI
@@ -35,7 +40,11 @@ func (c *ChannelClient) Init(name string, s RPCServer, jrpc, grpc interface{}) {
 		c.QueueProtocolAPI, _ = client.New(s.GetQueueClient(), nil)
 	}
 	if jrpc != nil {
-		s.JRPC().RegisterName(name, jrpc)
+		if r, ok := s.(jrpcRegister); ok {
+			r.RegisterJrpcName(name, jrpc)
+		} else {
+			s.JRPC().RegisterName(name, jrpc)
+		}
 	}
 	c.grpc = grpc
 	c.jrpc = jrpc
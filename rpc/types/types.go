@@ -170,6 +170,20 @@ type TxProof struct {
 	RootHash string   `json:"rootHash"`
 }
 
+// ReqStateProof 获取状态证明, stateHash为空时使用最新区块的状态
+type ReqStateProof struct {
+	Key       string `json:"key"`
+	StateHash string `json:"stateHash,omitempty"`
+}
+
+// StateProof 状态证明, proof为types.StateProof的编码, 可以通过区块头的stateHash验证, key不存在时为不存在证明
+type StateProof struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	StateHash string `json:"stateHash"`
+	Proof     string `json:"proof"`
+}
+
 // ReplyTxInfos reply tx infos
 type ReplyTxInfos struct {
 	TxInfos []*ReplyTxInfo `json:"txInfos"`
@@ -276,6 +290,16 @@ type ReqHashes struct {
 	DisableDetail bool     `json:"disableDetail"`
 }
 
+// ReqManagePush 推送订阅的管理请求, 签名的公钥和签名使用hex编码
+type ReqManagePush struct {
+	Name         string     `json:"name"`
+	Op           int32      `json:"op"`
+	LastSequence int64      `json:"lastSequence"`
+	URL          string     `json:"URL"`
+	Nonce        int64      `json:"nonce"`
+	Signature    *Signature `json:"signature"`
+}
+
 // ReqWalletTransactionList require wallet transaction list
 type ReqWalletTransactionList struct {
 	FromTx    string `json:"fromTx"`
@@ -481,3 +505,49 @@ type ChainConfigInfo struct {
 	IsPara           bool   `json:"isPara,omitempty"`
 	DefaultAddressID int32  `json:"defaultAddressID"`
 }
+
+//TxStatusEvent tx status change
+type TxStatusEvent struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+	Height int64  `json:"height,omitempty"`
+	Time   int64  `json:"time"`
+}
+
+//TxStatus recent status changes of tx
+type TxStatus struct {
+	Hash   string           `json:"hash"`
+	Status string           `json:"status"`
+	Events []*TxStatusEvent `json:"events"`
+}
+
+//ReorgRecord chain reorg record
+type ReorgRecord struct {
+	Index          int64  `json:"index"`
+	OldTipHash     string `json:"oldTipHash"`
+	OldTipHeight   int64  `json:"oldTipHeight"`
+	NewTipHash     string `json:"newTipHash"`
+	NewTipHeight   int64  `json:"newTipHeight"`
+	AncestorHash   string `json:"ancestorHash"`
+	AncestorHeight int64  `json:"ancestorHeight"`
+	Depth          int64  `json:"depth"`
+	Time           int64  `json:"time"`
+	Sequence       int64  `json:"sequence,omitempty"`
+	Incomplete     bool   `json:"incomplete,omitempty"`
+}
+
+//ReorgRecords chain reorg records
+type ReorgRecords struct {
+	Records []*ReorgRecord `json:"records"`
+}
+
+//Checkpoint block checkpoint
+type Checkpoint struct {
+	Height int64  `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+//Checkpoints block checkpoints
+type Checkpoints struct {
+	Items []*Checkpoint `json:"items"`
+}
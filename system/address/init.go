@@ -1,6 +1,7 @@
 package address
 
 import (
-	_ "github.com/33cn/chain33/system/address/btc" //init btc address driver
-	_ "github.com/33cn/chain33/system/address/eth" //init eth address driver
+	_ "github.com/33cn/chain33/system/address/btc"     //init btc address driver
+	_ "github.com/33cn/chain33/system/address/eth"     //init eth address driver
+	_ "github.com/33cn/chain33/system/address/taproot" //init taproot address driver
 )
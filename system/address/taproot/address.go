@@ -0,0 +1,113 @@
+// Package taproot 比特币taproot(P2TR)格式地址驱动, 配合schnorr签名使用
+package taproot
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/system/crypto/schnorr"
+	"github.com/btcsuite/btcd/btcec"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// ID taproot address id
+	ID = 3
+	// Name driver name
+	Name = "taproot"
+	// HRP 地址前缀, 与比特币主网一致
+	HRP = "bc"
+
+	witnessVersion = 1
+	tagTapTweak    = "TapTweak"
+)
+
+var (
+	addrCache *lru.Cache
+	// ErrInvalidTaprootAddr invalid taproot address
+	ErrInvalidTaprootAddr = errors.New("ErrInvalidTaprootAddr")
+)
+
+func init() {
+	//默认不启用, 需要配置启用高度
+	address.RegisterDriver(ID, &taproot{}, -1)
+
+	var err error
+	addrCache, err = lru.New(10240)
+	if err != nil {
+		panic(err)
+	}
+}
+
+type taproot struct{}
+
+// PubKeyToAddr public key to address
+func (t *taproot) PubKeyToAddr(pubKey []byte) string {
+	pubStr := string(pubKey)
+	if value, ok := addrCache.Get(pubStr); ok {
+		return value.(string)
+	}
+	addr, _ := encodeSegwit(HRP, witnessVersion, OutputKey(pubKey))
+	addrCache.Add(pubStr, addr)
+	return addr
+}
+
+// ValidateAddr address validation
+func (t *taproot) ValidateAddr(addr string) error {
+	_, err := t.FromString(addr)
+	return err
+}
+
+// GetName get driver name
+func (t *taproot) GetName() string {
+	return Name
+}
+
+// ToString trans to string format
+func (t *taproot) ToString(addr []byte) string {
+	str, _ := encodeSegwit(HRP, witnessVersion, addr)
+	return str
+}
+
+// FromString trans to byte format
+func (t *taproot) FromString(addr string) ([]byte, error) {
+	version, program, err := decodeSegwit(HRP, addr)
+	if err != nil || version != witnessVersion || len(program) != 32 {
+		return nil, ErrInvalidTaprootAddr
+	}
+	return program, nil
+}
+
+// FormatAddr 统一为小写格式
+func (t *taproot) FormatAddr(addr string) string {
+	return strings.ToLower(addr)
+}
+
+// OutputKey 按照BIP-86计算不含脚本路径的taproot输出公钥, Q = P + hash_TapTweak(P)*G
+//
+// 支持32字节x-only公钥和33字节压缩公钥, 其他格式的公钥无法在比特币中花费, 仅保证地址唯一性
+func OutputKey(pubKey []byte) []byte {
+	xOnly := pubKey
+	if len(pubKey) == 33 && (pubKey[0] == 2 || pubKey[0] == 3) {
+		xOnly = pubKey[1:]
+	}
+	px, py, err := schnorr.LiftX(xOnly)
+	if err != nil {
+		hash := sha256.Sum256(pubKey)
+		return hash[:]
+	}
+	curve := btcec.S256()
+	tweak := schnorr.TaggedHash(tagTapTweak, xOnly)
+	if new(big.Int).SetBytes(tweak).Cmp(curve.N) >= 0 {
+		hash := sha256.Sum256(pubKey)
+		return hash[:]
+	}
+	tx, ty := curve.ScalarBaseMult(tweak)
+	qx, _ := curve.Add(px, py, tx, ty)
+	key := make([]byte, 32)
+	qx.FillBytes(key)
+	return key
+}
@@ -0,0 +1,63 @@
+package taproot_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/system/address/taproot"
+	"github.com/33cn/chain33/system/crypto/schnorr"
+	"github.com/33cn/chain33/system/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+// https://github.com/bitcoin/bips/blob/master/bip-0086.mediawiki#test-vectors
+func TestBIP86Address(t *testing.T) {
+	d, err := address.LoadDriver(taproot.ID, -1)
+	require.Nil(t, err)
+	require.Equal(t, taproot.Name, d.GetName())
+
+	internal, _ := hex.DecodeString("cc8a4bc64d897bddc5fbc2f670f7a8ba0b386779106cf1223c6fc5d7cd6fc115")
+	output, _ := hex.DecodeString("a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c")
+	expect := "bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcr"
+	require.Equal(t, output, taproot.OutputKey(internal))
+	addr := d.PubKeyToAddr(internal)
+	require.Equal(t, expect, addr)
+	require.Nil(t, d.ValidateAddr(addr))
+	require.Nil(t, d.ValidateAddr(strings.ToUpper(addr)))
+	require.Equal(t, addr, d.FormatAddr(strings.ToUpper(addr)))
+
+	raw, err := d.FromString(addr)
+	require.Nil(t, err)
+	require.Equal(t, output, raw)
+	require.Equal(t, addr, d.ToString(raw))
+
+	//bech32校验和错误, 大小写混合, 非v1版本的bech32地址
+	for _, bad := range []string{
+		"bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcs",
+		"bc1p5cyxnuxmeuwuvkwfem96lqzszd02n6xdcjrs20cac6yqjjwudpxqkedrcR",
+		"bc1qcr8te4kr609gcawutmrza0j4xv80jy8z306fyu",
+		"1BKgJRRhokvdMuQEUbEqxHzTMWUxdU5w8B",
+	} {
+		require.Equal(t, taproot.ErrInvalidTaprootAddr, d.ValidateAddr(bad), bad)
+	}
+}
+
+func TestPubKeyToAddr(t *testing.T) {
+	d, err := address.LoadDriver(taproot.ID, -1)
+	require.Nil(t, err)
+	priv, err := secp256k1.Driver{}.GenKey()
+	require.Nil(t, err)
+	schnorrPriv, err := schnorr.Driver{}.PrivKeyFromBytes(priv.Bytes())
+	require.Nil(t, err)
+	//压缩公钥与x-only公钥对应相同的地址
+	addr := d.PubKeyToAddr(schnorrPriv.PubKey().Bytes())
+	require.Equal(t, addr, d.PubKeyToAddr(priv.PubKey().Bytes()))
+	ty, err := address.GetAddressType(addr)
+	require.Nil(t, err)
+	require.Equal(t, int32(taproot.ID), ty)
+	//默认不启用
+	_, err = address.LoadDriver(taproot.ID, 0)
+	require.Equal(t, address.ErrAddressDriverNotEnable, err)
+}
@@ -0,0 +1,100 @@
+package taproot
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// bech32m编码, 参考BIP-350, btcutil中的bech32包仅支持bech32常量
+const (
+	charset         = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32mConst    = 0x2bc830a3
+	maxBech32Length = 90
+)
+
+var errInvalidBech32m = errors.New("ErrInvalidBech32m")
+
+func polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	ret := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]>>5)
+	}
+	ret = append(ret, 0)
+	for i := 0; i < len(hrp); i++ {
+		ret = append(ret, hrp[i]&31)
+	}
+	return ret
+}
+
+// encodeSegwit 编码segwit地址, 仅用于版本号不为0的bech32m格式
+func encodeSegwit(hrp string, version byte, program []byte) (string, error) {
+	conv, err := bech32.ConvertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	data := append([]byte{version}, conv...)
+	values := append(hrpExpand(hrp), data...)
+	mod := polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ bech32mConst
+	for i := 0; i < 6; i++ {
+		data = append(data, byte(mod>>uint(5*(5-i)))&31)
+	}
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, d := range data {
+		sb.WriteByte(charset[d])
+	}
+	return sb.String(), nil
+}
+
+// decodeSegwit 解码bech32m格式的segwit地址
+func decodeSegwit(hrp, addr string) (byte, []byte, error) {
+	if len(addr) > maxBech32Length {
+		return 0, nil, errInvalidBech32m
+	}
+	lower := strings.ToLower(addr)
+	if lower != addr && strings.ToUpper(addr) != addr {
+		return 0, nil, errInvalidBech32m
+	}
+	pos := strings.LastIndexByte(lower, '1')
+	if pos < 1 || pos+7 > len(lower) || lower[:pos] != hrp {
+		return 0, nil, errInvalidBech32m
+	}
+	data := make([]byte, 0, len(lower)-pos-1)
+	for _, c := range lower[pos+1:] {
+		d := strings.IndexRune(charset, c)
+		if d < 0 {
+			return 0, nil, errInvalidBech32m
+		}
+		data = append(data, byte(d))
+	}
+	if polymod(append(hrpExpand(hrp), data...)) != bech32mConst {
+		return 0, nil, errInvalidBech32m
+	}
+	data = data[:len(data)-6]
+	if len(data) < 1 || data[0] > 16 {
+		return 0, nil, errInvalidBech32m
+	}
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	return data[0], program, nil
+}
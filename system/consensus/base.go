@@ -44,6 +44,11 @@ type Miner interface {
 	CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool
 }
 
+//HeaderChecker 支持轻节点模式的共识需要实现区块头校验, 轻节点没有状态数据, 只能使用区块头和配置校验
+type HeaderChecker interface {
+	CheckHeader(parent, current *types.Header) error
+}
+
 //BaseClient ...
 type BaseClient struct {
 	client       queue.Client
@@ -129,6 +134,12 @@ func (bc *BaseClient) Wait() {}
 
 //SetQueueClient 设置客户端队列
 func (bc *BaseClient) SetQueueClient(c queue.Client) {
+	//轻节点不出块, 只处理区块头校验等消息
+	if c.GetConfig().GetModuleConfig().BlockChain.LightMode {
+		bc.InitClient(c, func() {})
+		go bc.EventLoop()
+		return
+	}
 	bc.InitClient(c, func() {
 		//call init block
 		bc.InitBlock()
@@ -250,6 +261,15 @@ func (bc *BaseClient) EventLoop() {
 				block := msg.GetData().(*types.BlockDetail)
 				err := bc.CheckBlock(block)
 				msg.ReplyErr("EventCheckBlock", err)
+			} else if msg.Ty == types.EventCheckHeader {
+				items := msg.GetData().(*types.Headers).GetItems()
+				var err error
+				if len(items) != 2 {
+					err = types.ErrInvalidParam
+				} else {
+					err = bc.CheckHeader(items[0], items[1])
+				}
+				msg.ReplyErr("EventCheckHeader", err)
 			} else if msg.Ty == types.EventMinerStart {
 				if !atomic.CompareAndSwapInt32(&bc.minerStart, 0, 1) {
 					msg.ReplyErr("EventMinerStart", types.ErrMinerIsStared)
@@ -317,6 +337,29 @@ func (bc *BaseClient) CheckBlock(block *types.BlockDetail) error {
 	return err
 }
 
+//CheckHeader 轻节点校验区块头, 共识没有实现HeaderChecker时不支持轻节点
+func (bc *BaseClient) CheckHeader(parent, current *types.Header) error {
+	if current.Height <= 0 { //genesis header not check
+		return nil
+	}
+	if parent == nil || parent.Height+1 != current.Height {
+		return types.ErrBlockHeight
+	}
+	types.AssertConfig(bc.client)
+	cfg := bc.client.GetConfig()
+	if cfg.IsFork(current.Height, "ForkCheckBlockTime") && parent.BlockTime > current.BlockTime {
+		return types.ErrBlockTime
+	}
+	if !bytes.Equal(current.ParentHash, parent.Hash) {
+		return types.ErrParentHash
+	}
+	checker, ok := bc.child.(HeaderChecker)
+	if !ok {
+		return types.ErrActionNotSupport
+	}
+	return checker.CheckHeader(parent, current)
+}
+
 //RequestTx Mempool中取交易列表
 func (bc *BaseClient) RequestTx(listSize int, txHashList [][]byte) []*types.Transaction {
 	if bc.client == nil {
@@ -438,12 +481,21 @@ func (bc *BaseClient) WriteBlock(prev []byte, block *types.Block) error {
 
 // PreExecBlock 预执行区块, 用于raft, tendermint等共识, errReturn表示区块来源于自己还是别人
 func (bc *BaseClient) PreExecBlock(block *types.Block, errReturn bool) *types.Block {
+	return bc.preExecBlock(block, errReturn, true)
+}
+
+// PreExecBlockNoCheck 预执行区块, 不调用共识的CheckBlock, 用于执行后才能对区块签名的共识, 如poa
+func (bc *BaseClient) PreExecBlockNoCheck(block *types.Block, errReturn bool) *types.Block {
+	return bc.preExecBlock(block, errReturn, false)
+}
+
+func (bc *BaseClient) preExecBlock(block *types.Block, errReturn, checkblock bool) *types.Block {
 	lastBlock, err := bc.RequestBlock(block.Height - 1)
 	if err != nil {
 		log.Error("PreExecBlock RequestBlock fail", "err", err)
 		return nil
 	}
-	blockdetail, deltx, err := util.PreExecBlock(bc.client, lastBlock.StateHash, block, errReturn, false, true)
+	blockdetail, deltx, err := util.PreExecBlock(bc.client, lastBlock.StateHash, block, errReturn, false, checkblock)
 	if err != nil {
 		log.Error("util.PreExecBlock fail", "err", err)
 		return nil
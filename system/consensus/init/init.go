@@ -7,5 +7,6 @@ package init
 
 import (
 	//初始化
+	_ "github.com/33cn/chain33/system/consensus/poa"
 	_ "github.com/33cn/chain33/system/consensus/solo"
 )
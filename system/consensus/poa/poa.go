@@ -0,0 +1,383 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package poa 授权节点轮流出块的poa共识
+package poa
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/difficulty"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/consensus"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+/*
+poa共识:
+1. 授权出块地址列表保存在链上, 由manage执行器的配置项poa-signers维护, 配置项不存在时使用配置文件中的genesisSigners
+2. 地址列表按字典序排序, 高度为h的区块由第h%n个地址轮值出块, 区块难度为powLimitBits的两倍, 非轮值地址等待一段时间后也可以出块
+3. 出块地址对执行后的区块hash签名, 签名保存在区块头中
+4. 为防止单个节点控制出块, 每个地址在连续的n/2+1个区块中最多出一个块
+5. 相同高度的区块, 难度更大的区块即轮值地址出的区块为最优区块
+*/
+
+var plog = log.New("module", "poa")
+
+// SignersKey manage执行器中保存授权出块地址列表的配置项
+const SignersKey = "poa-signers"
+
+// 区块时间最多可以超前本地时间的秒数
+const maxFutureBlockTime = 15
+
+var (
+	// ErrNoSignature 区块没有签名
+	ErrNoSignature = errors.New("ErrPoaNoSignature")
+	// ErrNotSigner 区块签名地址不是授权出块地址
+	ErrNotSigner = errors.New("ErrPoaNotSigner")
+	// ErrNoSigners 授权出块地址列表为空
+	ErrNoSigners = errors.New("ErrPoaNoSigners")
+	// ErrDifficulty 区块难度与是否轮值出块不符
+	ErrDifficulty = errors.New("ErrPoaDifficulty")
+	// ErrRecentlySigned 出块地址最近已经出过块
+	ErrRecentlySigned = errors.New("ErrPoaRecentlySigned")
+	// ErrBlockTime 区块时间不满足出块间隔, 或者超前本地时间太多
+	ErrBlockTime = errors.New("ErrPoaBlockTime")
+)
+
+func init() {
+	drivers.Reg("poa", New)
+	drivers.QueryData.Register("poa", &Client{})
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	// 初始授权出块地址列表
+	GenesisSigners []string `json:"genesisSigners"`
+	// 本节点出块私钥, 为空表示只同步验证区块
+	SignerPrivKey string `json:"signerPrivKey"`
+	// 最小出块间隔, 单位秒
+	Period int64 `json:"period"`
+	// 非轮值地址出块前的等待时间, 单位秒
+	OutOfTurnWait int64 `json:"outOfTurnWait"`
+	WaitTxMs      int64 `json:"waitTxMs"`
+}
+
+// Client poa共识客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg    *subConfig
+	privKey   crypto.PrivKey
+	signer    string
+	sleepTime time.Duration
+	//非轮值出块时, 开始等待的父区块及时间
+	waitParent []byte
+	waitSince  time.Time
+}
+
+// New 创建poa共识
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = 1000
+	}
+	if subcfg.OutOfTurnWait == 0 {
+		subcfg.OutOfTurnWait = 3
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	poa := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		sleepTime:  time.Duration(subcfg.WaitTxMs) * time.Millisecond,
+	}
+	if subcfg.SignerPrivKey != "" {
+		priv := util.HexToPrivkey(subcfg.SignerPrivKey)
+		poa.privKey = priv
+		poa.signer = address.PubKeyToAddr(address.DefaultID, priv.PubKey().Bytes())
+		plog.Info("poa signer", "addr", poa.signer)
+	}
+	c.SetChild(poa)
+	return poa
+}
+
+// Close close
+func (client *Client) Close() {
+	client.BaseClient.Close()
+	plog.Info("consensus poa closed")
+}
+
+// GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+// CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	cfg := client.GetAPI().GetConfig()
+	tx.Execer = []byte(cfg.GetCoinExec())
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * cfg.GetCoinPrecision()
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+// ProcEvent false
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	return false
+}
+
+// GetSigners 获取区块执行后的授权出块地址列表, 即下一个区块的授权出块地址列表
+func (client *Client) GetSigners(block *types.Block) ([]string, error) {
+	keys := [][]byte{[]byte(types.ManageKey(SignersKey)), []byte(types.ConfigKey(SignersKey))}
+	reply, err := client.GetAPI().StoreGet(&types.StoreGet{StateHash: block.StateHash, Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+	signers := client.subcfg.GenesisSigners
+	for _, value := range reply.GetValues() {
+		if len(value) == 0 {
+			continue
+		}
+		var item types.ConfigItem
+		if err := types.Decode(value, &item); err != nil {
+			return nil, err
+		}
+		signers = item.GetArr().GetValue()
+		break
+	}
+	return sortSigners(signers)
+}
+
+// 排序并去重
+func sortSigners(signers []string) ([]string, error) {
+	if len(signers) == 0 {
+		return nil, ErrNoSigners
+	}
+	sorted := make([]string, len(signers))
+	copy(sorted, signers)
+	sort.Strings(sorted)
+	n := 1
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i] != sorted[n-1] {
+			sorted[n] = sorted[i]
+			n++
+		}
+	}
+	return sorted[:n], nil
+}
+
+func indexOf(signers []string, signer string) int {
+	for i, s := range signers {
+		if s == signer {
+			return i
+		}
+	}
+	return -1
+}
+
+// 高度为height的区块是否由signer轮值出块
+func isInTurn(signers []string, height int64, signer string) bool {
+	return signers[height%int64(len(signers))] == signer
+}
+
+// 轮值出块的难度为powLimitBits的两倍, 非轮值出块为powLimitBits
+func calcDifficulty(cfg *types.Chain33Config, height int64, inTurn bool) uint32 {
+	bits := cfg.GetP(height).PowLimitBits
+	if !inTurn {
+		return bits
+	}
+	target := difficulty.CompactToBig(bits)
+	return difficulty.BigToCompact(target.Rsh(target, 1))
+}
+
+// 获取从parent开始的count个区块的出块地址
+func (client *Client) recentSigners(parent *types.Block, count int) (map[string]bool, error) {
+	recents := make(map[string]bool)
+	block := parent
+	for i := 0; i < count && block.Height > 0; i++ {
+		if sig := block.GetSignature(); sig != nil {
+			recents[address.PubKeyToAddr(address.DefaultID, sig.Pubkey)] = true
+		}
+		if i+1 == count {
+			break
+		}
+		var err error
+		block, err = client.ReqBlockByHash(block.ParentHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return recents, nil
+}
+
+// 检查signer能否在parent之后出块, 返回是否轮值
+func (client *Client) checkSigner(parent *types.Block, signer string) (inTurn bool, err error) {
+	signers, err := client.GetSigners(parent)
+	if err != nil {
+		return false, err
+	}
+	if indexOf(signers, signer) < 0 {
+		return false, ErrNotSigner
+	}
+	//与clique相同, 连续的limit个区块中每个地址最多出一个块, 只有一个授权地址时limit为1, 不受限制
+	limit := len(signers)/2 + 1
+	recents, err := client.recentSigners(parent, limit-1)
+	if err != nil {
+		return false, err
+	}
+	if recents[signer] {
+		return false, ErrRecentlySigned
+	}
+	return isInTurn(signers, parent.Height+1, signer), nil
+}
+
+// CheckBlock 检查区块签名, 出块地址, 难度和区块时间
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	block := current.Block
+	if len(block.Txs) == 0 {
+		return types.ErrEmptyTx
+	}
+	sig := block.GetSignature()
+	if sig == nil {
+		return ErrNoSignature
+	}
+	cfg := client.GetAPI().GetConfig()
+	if !types.CheckSign(block.Hash(cfg), "", sig, block.Height) {
+		return types.ErrSign
+	}
+	if block.BlockTime < parent.BlockTime+client.subcfg.Period || block.BlockTime > types.Now().Unix()+maxFutureBlockTime {
+		return ErrBlockTime
+	}
+	signer := address.PubKeyToAddr(address.DefaultID, sig.Pubkey)
+	inTurn, err := client.checkSigner(parent, signer)
+	if err != nil {
+		plog.Error("CheckBlock", "height", block.Height, "signer", signer, "err", err)
+		return err
+	}
+	if block.Difficulty != calcDifficulty(cfg, block.Height, inTurn) {
+		return ErrDifficulty
+	}
+	return nil
+}
+
+// CheckHeader 轻节点校验区块头的签名, 出块地址, 难度和区块时间
+// 轻节点没有状态数据, 授权出块地址列表使用配置文件中的genesisSigners, 链上修改授权地址后需要同步修改配置
+func (client *Client) CheckHeader(parent, current *types.Header) error {
+	sig := current.GetSignature()
+	if sig == nil {
+		return ErrNoSignature
+	}
+	if !types.CheckSign(current.Hash, "", sig, current.Height) {
+		return types.ErrSign
+	}
+	if current.BlockTime < parent.BlockTime+client.subcfg.Period || current.BlockTime > types.Now().Unix()+maxFutureBlockTime {
+		return ErrBlockTime
+	}
+	signers, err := sortSigners(client.subcfg.GenesisSigners)
+	if err != nil {
+		return err
+	}
+	signer := address.PubKeyToAddr(address.DefaultID, sig.Pubkey)
+	if indexOf(signers, signer) < 0 {
+		return ErrNotSigner
+	}
+	inTurn := isInTurn(signers, current.Height, signer)
+	if current.Difficulty != calcDifficulty(client.GetAPI().GetConfig(), current.Height, inTurn) {
+		return ErrDifficulty
+	}
+	return nil
+}
+
+// 非轮值出块时, 在同一个父区块上等待OutOfTurnWait秒, 给轮值节点出块的时间
+func (client *Client) waitOutOfTurn(parentHash []byte) bool {
+	if !bytes.Equal(client.waitParent, parentHash) {
+		client.waitParent = parentHash
+		client.waitSince = types.Now()
+	}
+	return types.Since(client.waitSince) < time.Duration(client.subcfg.OutOfTurnWait)*time.Second
+}
+
+// CreateBlock 创建区块
+func (client *Client) CreateBlock() {
+	types.AssertConfig(client.GetAPI())
+	cfg := client.GetAPI().GetConfig()
+	for {
+		if client.IsClosed() {
+			break
+		}
+		time.Sleep(client.sleepTime)
+		if client.privKey == nil || !client.IsMining() || !client.IsCaughtUp() {
+			continue
+		}
+		lastBlock := client.GetCurrentBlock()
+		if types.Now().Unix() < lastBlock.BlockTime+client.subcfg.Period {
+			continue
+		}
+		inTurn, err := client.checkSigner(lastBlock, client.signer)
+		if err != nil {
+			plog.Debug("CreateBlock", "height", lastBlock.Height+1, "err", err)
+			continue
+		}
+		maxTxNum := int(cfg.GetP(lastBlock.Height + 1).MaxTxNumber)
+		txs := client.RequestTx(maxTxNum, nil)
+		txs = client.CheckTxDup(txs)
+		if len(txs) == 0 {
+			continue
+		}
+		lastHash := lastBlock.Hash(cfg)
+		if !inTurn && client.waitOutOfTurn(lastHash) {
+			continue
+		}
+		var newblock types.Block
+		newblock.ParentHash = lastHash
+		newblock.Height = lastBlock.Height + 1
+		client.AddTxsToBlock(&newblock, txs)
+		newblock.Difficulty = calcDifficulty(cfg, newblock.Height, inTurn)
+		//需要首先对交易进行排序然后再计算TxHash
+		if cfg.IsFork(newblock.GetHeight(), "ForkRootHash") {
+			newblock.Txs = types.TransactionSort(newblock.Txs)
+		}
+		newblock.BlockTime = types.Now().Unix()
+		//执行区块得到StateHash后才能签名
+		block := client.PreExecBlockNoCheck(&newblock, false)
+		if block == nil || len(block.Txs) == 0 {
+			continue
+		}
+		block.Signature = &types.Signature{
+			Ty:        types.SECP256K1,
+			Pubkey:    client.privKey.PubKey().Bytes(),
+			Signature: client.privKey.Sign(block.Hash(cfg)).Bytes(),
+		}
+		err = client.WriteBlock(lastBlock.StateHash, block)
+		plog.Info("PoaNewBlock", "height", block.Height, "txs", len(block.Txs), "inTurn", inTurn, "err", err)
+	}
+}
+
+// CmpBestBlock 相同高度的区块, 轮值出块的区块难度更大, 为最优区块
+func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool {
+	return difficulty.CalcWork(newBlock.Difficulty).Cmp(difficulty.CalcWork(cmpBlock.Difficulty)) > 0
+}
@@ -0,0 +1,261 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package poa
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	drivers "github.com/33cn/chain33/system/consensus"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/33cn/chain33/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/33cn/chain33/system/dapp/init"
+	_ "github.com/33cn/chain33/system/mempool/init"
+	_ "github.com/33cn/chain33/system/store/init"
+)
+
+func poaConfig(signers []string, key string) string {
+	cfgstr := strings.Replace(types.GetDefaultCfgstring(), `name="solo"`, `name="poa"`, 1)
+	return cfgstr + fmt.Sprintf(`
+[consensus.sub.poa]
+genesis="14KEKbYtKKQm4wMthSK9J4La4nAiidGozt"
+genesisBlockTime=1514533394
+genesisSigners=["%s"]
+signerPrivKey="%s"
+outOfTurnWait=1
+waitTxMs=50
+`, strings.Join(signers, `","`), key)
+}
+
+// 多个进程内节点, 通过relay相互同步区块
+type poaNetwork struct {
+	mu    sync.Mutex
+	nodes map[string]*testnode.Chain33Mock
+	stop  chan struct{}
+}
+
+func newPoaNetwork(t *testing.T, n int) (*poaNetwork, []string) {
+	var signers, keys []string
+	for i := 0; i < n; i++ {
+		addr, priv := util.Genaddress()
+		signers = append(signers, addr)
+		keys = append(keys, common.ToHex(priv.Bytes()))
+	}
+	net := &poaNetwork{nodes: make(map[string]*testnode.Chain33Mock), stop: make(chan struct{})}
+	for i := 0; i < n; i++ {
+		net.nodes[signers[i]] = testnode.NewWithConfig(types.NewChain33Config(poaConfig(signers, keys[i])), nil)
+	}
+	go net.relay()
+	sorted, err := sortSigners(signers)
+	require.Nil(t, err)
+	return net, sorted
+}
+
+func (net *poaNetwork) relay() {
+	for {
+		select {
+		case <-net.stop:
+			return
+		case <-time.After(50 * time.Millisecond):
+		}
+		net.mu.Lock()
+		for _, src := range net.nodes {
+			for _, dst := range net.nodes {
+				if src != dst {
+					relayBlocks(src, dst)
+				}
+			}
+		}
+		net.mu.Unlock()
+	}
+}
+
+// 将src中dst没有的区块同步给dst
+func relayBlocks(src, dst *testnode.Chain33Mock) {
+	srcHeight := src.GetLastBlock().Height
+	start := dst.GetLastBlock().Height - 1
+	if start < 1 {
+		start = 1
+	}
+	cfg := src.GetClient().GetConfig()
+	for h := start; h <= srcHeight; h++ {
+		details, err := src.GetAPI().GetBlocks(&types.ReqBlocks{Start: h, End: h, IsDetail: true})
+		if err != nil || len(details.Items) == 0 {
+			return
+		}
+		detail := details.Items[0]
+		if h <= dst.GetLastBlock().Height && bytes.Equal(dst.GetBlock(h).Hash(cfg), detail.Block.Hash(cfg)) {
+			continue
+		}
+		_, _, _, err = dst.GetBlockChain().ProcessBlock(false, detail, "peer", true, -1)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (net *poaNetwork) remove(signer string) {
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	net.nodes[signer].Close()
+	delete(net.nodes, signer)
+}
+
+func (net *poaNetwork) close() {
+	close(net.stop)
+	net.mu.Lock()
+	defer net.mu.Unlock()
+	for _, node := range net.nodes {
+		node.Close()
+	}
+}
+
+// 发送交易到所有节点, 并等待所有节点达到下一个高度
+func (net *poaNetwork) sendTx(t *testing.T, tx *types.Transaction) *types.Block {
+	net.mu.Lock()
+	var height int64
+	var nodes []*testnode.Chain33Mock
+	for _, node := range net.nodes {
+		_, err := node.GetAPI().SendTx(tx)
+		require.Nil(t, err)
+		height = node.GetLastBlock().Height + 1
+		nodes = append(nodes, node)
+	}
+	net.mu.Unlock()
+	for _, node := range nodes {
+		for i := 0; node.GetLastBlock().Height < height; i++ {
+			require.True(t, i < 300, "wait height timeout")
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	block := nodes[0].GetBlock(height)
+	cfg := nodes[0].GetClient().GetConfig()
+	for _, node := range nodes {
+		assert.Equal(t, block.Hash(cfg), node.GetBlock(height).Hash(cfg))
+	}
+	return block
+}
+
+func blockSigner(block *types.Block) string {
+	return address.PubKeyToAddr(address.DefaultID, block.Signature.Pubkey)
+}
+
+func TestPoa(t *testing.T) {
+	net, signers := newPoaNetwork(t, 3)
+	defer net.close()
+	node := net.nodes[signers[0]]
+	cfg := node.GetClient().GetConfig()
+	genkey := node.GetGenesisKey()
+
+	//所有节点在线时轮值出块
+	var block *types.Block
+	for i := 0; i < 3; i++ {
+		block = net.sendTx(t, util.CreateCoinsTx(cfg, genkey, node.GetHotAddress(), types.DefaultCoinPrecision))
+		assert.Equal(t, signers[block.Height%3], blockSigner(block))
+		assert.Equal(t, calcDifficulty(cfg, block.Height, true), block.Difficulty)
+	}
+
+	//轻节点只使用区块头和配置中的授权地址校验
+	checkHeaders(t, node, signers)
+
+	//轮值节点离线, 由最近没有出块的节点出块
+	offline := signers[(block.Height+1)%3]
+	net.remove(offline)
+	block = net.sendTx(t, util.CreateNoneTx(cfg, genkey))
+	assert.Equal(t, signers[(block.Height+1)%3], blockSigner(block))
+	assert.Equal(t, calcDifficulty(cfg, block.Height, false), block.Difficulty)
+
+	//通过manage修改出块地址列表, 下一个区块开始生效
+	block = net.sendTx(t, util.CreateManageTx(cfg, node.GetHotKey(), SignersKey, "add", signers[0]))
+	for i := 0; i < 2; i++ {
+		block = net.sendTx(t, util.CreateNoneTx(cfg, genkey))
+		assert.Equal(t, signers[0], blockSigner(block))
+		assert.Equal(t, calcDifficulty(cfg, block.Height, true), block.Difficulty)
+	}
+
+	//只验证区块的节点, 拒绝没有签名或者非授权地址签名的区块
+	details, err := node.GetAPI().GetBlocks(&types.ReqBlocks{Start: 1, End: 1, IsDetail: true})
+	require.Nil(t, err)
+	_, priv := util.Genaddress()
+	for _, sig := range []*types.Signature{nil, {Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes()}} {
+		validator := testnode.NewWithConfig(types.NewChain33Config(poaConfig(signers, "")), nil)
+		detail := types.Clone(details.Items[0]).(*types.BlockDetail)
+		expect := ErrNoSignature
+		if sig != nil {
+			sig.Signature = priv.Sign(detail.Block.Hash(cfg)).Bytes()
+			expect = ErrNotSigner
+		}
+		detail.Block.Signature = sig
+		_, _, _, err = validator.GetBlockChain().ProcessBlock(false, detail, "peer", true, -1)
+		assert.Equal(t, expect, err)
+		validator.Close()
+	}
+}
+
+func TestPoaTwoSigners(t *testing.T) {
+	net, signers := newPoaNetwork(t, 2)
+	defer net.close()
+	node := net.nodes[signers[0]]
+	cfg := node.GetClient().GetConfig()
+	genkey := node.GetGenesisKey()
+
+	block := net.sendTx(t, util.CreateNoneTx(cfg, genkey))
+	assert.Equal(t, signers[block.Height%2], blockSigner(block))
+
+	//两个授权地址时不能连续出块, 刚出过块的节点独自在线时不能继续出块
+	net.remove(signers[(block.Height+1)%2])
+	node = net.nodes[blockSigner(block)]
+	_, err := node.GetAPI().SendTx(util.CreateNoneTx(cfg, genkey))
+	require.Nil(t, err)
+	time.Sleep(3 * time.Second)
+	assert.Equal(t, block.Height, node.GetLastBlock().Height)
+}
+
+func checkHeaders(t *testing.T, node *testnode.Chain33Mock, signers []string) {
+	client := &Client{BaseClient: drivers.NewBaseClient(&types.Consensus{}), subcfg: &subConfig{GenesisSigners: signers}}
+	client.SetAPI(node.GetAPI())
+	headers, err := node.GetAPI().GetHeaders(&types.ReqBlocks{Start: 1, End: 3})
+	require.Nil(t, err)
+	items := headers.GetItems()
+	require.Equal(t, 3, len(items))
+	for i := 1; i < len(items); i++ {
+		assert.Nil(t, client.CheckHeader(items[i-1], items[i]))
+	}
+
+	header := types.Clone(items[2]).(*types.Header)
+	header.Difficulty = calcDifficulty(node.GetClient().GetConfig(), header.Height, false)
+	assert.Equal(t, ErrDifficulty, client.CheckHeader(items[1], header))
+	header.Signature = nil
+	assert.Equal(t, ErrNoSignature, client.CheckHeader(items[1], header))
+	client.subcfg.GenesisSigners = signers[1:]
+	assert.Equal(t, ErrNotSigner, client.CheckHeader(items[1], items[2]))
+}
+
+func TestCmpBestBlock(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	client := &Client{}
+	inTurn := &types.Block{Difficulty: calcDifficulty(cfg, 1, true)}
+	noTurn := &types.Block{Difficulty: calcDifficulty(cfg, 1, false)}
+	assert.True(t, client.CmpBestBlock(inTurn, noTurn))
+	assert.False(t, client.CmpBestBlock(noTurn, inTurn))
+	assert.False(t, client.CmpBestBlock(inTurn, inTurn))
+
+	signers, err := sortSigners([]string{"c", "a", "b", "a"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, signers)
+	_, err = sortSigners(nil)
+	assert.Equal(t, ErrNoSigners, err)
+	assert.True(t, isInTurn(signers, 4, "b"))
+}
@@ -99,6 +99,15 @@ func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail
 	return nil
 }
 
+//CheckHeader solo固定难度出块, 区块头没有签名, 只校验难度
+func (client *Client) CheckHeader(parent, current *types.Header) error {
+	cfg := client.GetAPI().GetConfig()
+	if current.Difficulty != cfg.GetP(0).PowLimitBits {
+		return types.ErrBlockHeaderDifficulty
+	}
+	return nil
+}
+
 //CreateBlock 创建区块
 func (client *Client) CreateBlock() {
 	issleep := true
@@ -0,0 +1,441 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bls bls12-381聚合签名加密包
+//
+// 公钥在G1上, 签名在G2上, 均采用非压缩格式编码.
+// 同一消息的聚合验证存在rogue key攻击, 参与聚合的公钥必须先通过proof of possession校验
+package bls
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/crypto"
+	bls12381 "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+//const
+const (
+	Name = "bls"
+	ID   = 259
+
+	//PrivKeySize 私钥长度
+	PrivKeySize = 32
+	//PubKeySize 公钥长度, G1非压缩格式
+	PubKeySize = 96
+	//SignatureSize 签名长度, G2非压缩格式
+	SignatureSize = 192
+)
+
+var (
+	errInvalidPrivKey   = errors.New("ErrInvalidBlsPrivKey")
+	errInvalidPubKey    = errors.New("ErrInvalidBlsPubKey")
+	errInvalidSignature = errors.New("ErrInvalidBlsSignature")
+	errEmptyAggregate   = errors.New("ErrEmptyBlsAggregate")
+	errLengthNotMatch   = errors.New("ErrBlsPubKeyMsgLengthNotMatch")
+	errDuplicateMsg     = errors.New("ErrBlsDuplicateMsg")
+	//ErrPossessionRequired 公钥未通过proof of possession校验
+	ErrPossessionRequired = errors.New("ErrBlsPossessionRequired")
+)
+
+var curveOrder = bls12381.NewG1().Q()
+
+func init() {
+	// 默认不启用, 需要配置启用高度, 如[crypto.enableHeight] bls=100
+	crypto.Register(Name, &Driver{}, crypto.WithRegOptionTypeID(ID), crypto.WithRegOptionEnableHeight(-1))
+}
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	for {
+		//多取16字节再取模, 避免分布偏差
+		k := new(big.Int).SetBytes(crypto.CRandBytes(PrivKeySize + 16))
+		k.Mod(k, curveOrder)
+		if k.Sign() == 0 {
+			continue
+		}
+		var priv PrivKeyBLS
+		k.FillBytes(priv[:])
+		return priv, nil
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥
+func (d Driver) PrivKeyFromBytes(b []byte) (crypto.PrivKey, error) {
+	if len(b) != PrivKeySize {
+		return nil, errInvalidPrivKey
+	}
+	k := new(big.Int).SetBytes(b)
+	if k.Sign() == 0 || k.Cmp(curveOrder) >= 0 {
+		return nil, errInvalidPrivKey
+	}
+	var priv PrivKeyBLS
+	copy(priv[:], b)
+	return priv, nil
+}
+
+//PubKeyFromBytes 字节转为公钥, 返回的公钥未经过proof of possession校验
+func (d Driver) PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if _, err := decodePubKey(bls12381.NewG1(), b); err != nil {
+		return nil, err
+	}
+	pub := PubKeyBLS{}
+	copy(pub.key[:], b)
+	return pub, nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (crypto.Signature, error) {
+	if _, err := decodeSignature(bls12381.NewG2(), b); err != nil {
+		return nil, err
+	}
+	var sig SignatureBLS
+	copy(sig[:], b)
+	return sig, nil
+}
+
+// Validate validate msg and signature
+func (d Driver) Validate(msg, pub, sig []byte) error {
+	return crypto.BasicValidation(d, msg, pub, sig)
+}
+
+//PopVerify 校验公钥的proof of possession, 校验通过的公钥可以参与同一消息的聚合
+func (d Driver) PopVerify(pub, proof []byte) (crypto.PubKey, error) {
+	g1 := bls12381.NewG1()
+	p, err := decodePubKey(g1, pub)
+	if err != nil {
+		return nil, err
+	}
+	s, err := decodeSignature(bls12381.NewG2(), proof)
+	if err != nil {
+		return nil, err
+	}
+	if !verify(p, pub, s, dstPop) {
+		return nil, crypto.ErrSign
+	}
+	pk := PubKeyBLS{pop: true}
+	copy(pk.key[:], pub)
+	return pk, nil
+}
+
+//Aggregate 聚合签名
+func (d Driver) Aggregate(sigs []crypto.Signature) (crypto.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errEmptyAggregate
+	}
+	g2 := bls12381.NewG2()
+	aggr := g2.Zero()
+	for _, sig := range sigs {
+		s, err := toPointG2(g2, sig)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(aggr, aggr, s)
+	}
+	var out SignatureBLS
+	copy(out[:], g2.ToBytes(aggr))
+	return out, nil
+}
+
+//AggregatePublic 聚合公钥, 所有公钥需要通过proof of possession校验
+func (d Driver) AggregatePublic(pubs []crypto.PubKey) (crypto.PubKey, error) {
+	g1 := bls12381.NewG1()
+	aggr, err := aggregatePubKeys(g1, pubs)
+	if err != nil {
+		return nil, err
+	}
+	pk := PubKeyBLS{pop: true}
+	copy(pk.key[:], g1.ToBytes(aggr))
+	return pk, nil
+}
+
+//VerifyAggregatedOne 验证同一消息的聚合签名, 所有公钥需要通过proof of possession校验
+func (d Driver) VerifyAggregatedOne(pubs []crypto.PubKey, m []byte, sig crypto.Signature) error {
+	g1 := bls12381.NewG1()
+	aggr, err := aggregatePubKeys(g1, pubs)
+	if err != nil {
+		return err
+	}
+	s, err := toPointG2(bls12381.NewG2(), sig)
+	if err != nil {
+		return err
+	}
+	if !verify(aggr, m, s, dstSign) {
+		return crypto.ErrSign
+	}
+	return nil
+}
+
+//VerifyAggregatedN 验证不同消息的聚合签名, 存在未通过proof of possession校验的公钥时要求消息互不相同
+func (d Driver) VerifyAggregatedN(pubs []crypto.PubKey, ms [][]byte, sig crypto.Signature) error {
+	if len(pubs) == 0 {
+		return errEmptyAggregate
+	}
+	if len(pubs) != len(ms) {
+		return errLengthNotMatch
+	}
+	g1, g2 := bls12381.NewG1(), bls12381.NewG2()
+	s, err := toPointG2(g2, sig)
+	if err != nil {
+		return err
+	}
+	allPop := true
+	engine := bls12381.NewPairingEngine()
+	for i, pub := range pubs {
+		pk, ok := pub.(PubKeyBLS)
+		if !ok {
+			return errInvalidPubKey
+		}
+		allPop = allPop && pk.pop
+		p, err := decodePubKey(g1, pk.key[:])
+		if err != nil {
+			return err
+		}
+		h, err := hashToG2(g2, ms[i], dstSign)
+		if err != nil {
+			return err
+		}
+		engine.AddPair(p, h)
+	}
+	if !allPop {
+		seen := make(map[string]bool, len(ms))
+		for _, m := range ms {
+			if seen[string(m)] {
+				return errDuplicateMsg
+			}
+			seen[string(m)] = true
+		}
+	}
+	engine.AddPairInv(engine.G1.One(), s)
+	if !engine.Check() {
+		return crypto.ErrSign
+	}
+	return nil
+}
+
+//VerifyBatch 批量验签, 每个签名乘以64位随机数后合并验证, 共用一次最终幂运算
+func (d Driver) VerifyBatch(msgs, pubs, sigs [][]byte) error {
+	if len(msgs) == 0 {
+		return errEmptyAggregate
+	}
+	if len(pubs) != len(msgs) || len(sigs) != len(msgs) {
+		return errLengthNotMatch
+	}
+	engine := bls12381.NewPairingEngine()
+	g1, g2 := engine.G1, engine.G2
+	aggr := g2.Zero()
+	for i := range msgs {
+		p, err := decodePubKey(g1, pubs[i])
+		if err != nil {
+			return err
+		}
+		s, err := decodeSignature(g2, sigs[i])
+		if err != nil {
+			return err
+		}
+		h, err := hashToG2(g2, msgs[i], dstSign)
+		if err != nil {
+			return err
+		}
+		r := new(big.Int).SetBytes(crypto.CRandBytes(8))
+		if r.Sign() == 0 {
+			r.SetInt64(1)
+		}
+		g1.MulScalar(p, p, r)
+		g2.MulScalar(s, s, r)
+		g2.Add(aggr, aggr, s)
+		engine.AddPair(p, h)
+	}
+	engine.AddPairInv(engine.G1.One(), aggr)
+	if !engine.Check() {
+		return crypto.ErrSign
+	}
+	return nil
+}
+
+//PrivKeyBLS PrivKey, 大端格式的标量
+type PrivKeyBLS [PrivKeySize]byte
+
+//Bytes 字节格式
+func (privKey PrivKeyBLS) Bytes() []byte {
+	s := make([]byte, PrivKeySize)
+	copy(s, privKey[:])
+	return s
+}
+
+//Sign 签名
+func (privKey PrivKeyBLS) Sign(msg []byte) crypto.Signature {
+	return privKey.sign(msg, dstSign)
+}
+
+//PopProve 生成公钥的proof of possession
+func (privKey PrivKeyBLS) PopProve() crypto.Signature {
+	return privKey.sign(privKey.PubKey().Bytes(), dstPop)
+}
+
+func (privKey PrivKeyBLS) sign(msg []byte, dst string) crypto.Signature {
+	g2 := bls12381.NewG2()
+	h, err := hashToG2(g2, msg, dst)
+	if err != nil {
+		panic(err)
+	}
+	g2.MulScalar(h, h, new(big.Int).SetBytes(privKey[:]))
+	var sig SignatureBLS
+	copy(sig[:], g2.ToBytes(h))
+	return sig
+}
+
+//PubKey 公钥, 私钥生成的公钥视为已经证明持有
+func (privKey PrivKeyBLS) PubKey() crypto.PubKey {
+	g1 := bls12381.NewG1()
+	p := g1.MulScalar(g1.New(), g1.One(), new(big.Int).SetBytes(privKey[:]))
+	pub := PubKeyBLS{pop: true}
+	copy(pub.key[:], g1.ToBytes(p))
+	return pub
+}
+
+//Equals 相等
+func (privKey PrivKeyBLS) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKeyBLS); ok {
+		return bytes.Equal(privKey[:], otherBLS[:])
+	}
+	return false
+}
+
+//PubKeyBLS PubKey
+type PubKeyBLS struct {
+	key [PubKeySize]byte
+	//是否通过了proof of possession校验
+	pop bool
+}
+
+//Bytes 字节格式
+func (pubKey PubKeyBLS) Bytes() []byte {
+	s := make([]byte, PubKeySize)
+	copy(s, pubKey.key[:])
+	return s
+}
+
+//VerifyBytes 验证字节
+func (pubKey PubKeyBLS) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	p, err := decodePubKey(bls12381.NewG1(), pubKey.key[:])
+	if err != nil {
+		return false
+	}
+	s, err := toPointG2(bls12381.NewG2(), sig)
+	if err != nil {
+		return false
+	}
+	return verify(p, msg, s, dstSign)
+}
+
+//KeyString 公钥字符串格式
+func (pubKey PubKeyBLS) KeyString() string {
+	return fmt.Sprintf("%X", pubKey.key[:])
+}
+
+//Equals 相等
+func (pubKey PubKeyBLS) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKeyBLS); ok {
+		return bytes.Equal(pubKey.key[:], otherBLS.key[:])
+	}
+	return false
+}
+
+//SignatureBLS Signature
+type SignatureBLS [SignatureSize]byte
+
+//Bytes 字节格式
+func (sig SignatureBLS) Bytes() []byte {
+	s := make([]byte, SignatureSize)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureBLS) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureBLS) String() string {
+	return fmt.Sprintf("/%X.../", sig[:])
+}
+
+//Equals 相等
+func (sig SignatureBLS) Equals(other crypto.Signature) bool {
+	if otherBLS, ok := other.(SignatureBLS); ok {
+		return bytes.Equal(sig[:], otherBLS[:])
+	}
+	return false
+}
+
+//decodePubKey 公钥必须在G1的素数阶子群中且不为无穷远点
+func decodePubKey(g1 *bls12381.G1, b []byte) (*bls12381.PointG1, error) {
+	if len(b) != PubKeySize {
+		return nil, errInvalidPubKey
+	}
+	p, err := g1.FromBytes(b)
+	if err != nil || g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, errInvalidPubKey
+	}
+	return p, nil
+}
+
+//decodeSignature 签名必须在G2的素数阶子群中且不为无穷远点
+func decodeSignature(g2 *bls12381.G2, b []byte) (*bls12381.PointG2, error) {
+	if len(b) != SignatureSize {
+		return nil, errInvalidSignature
+	}
+	p, err := g2.FromBytes(b)
+	if err != nil || g2.IsZero(p) || !g2.InCorrectSubgroup(p) {
+		return nil, errInvalidSignature
+	}
+	return p, nil
+}
+
+func toPointG2(g2 *bls12381.G2, sig crypto.Signature) (*bls12381.PointG2, error) {
+	s, ok := sig.(SignatureBLS)
+	if !ok {
+		return nil, errInvalidSignature
+	}
+	return decodeSignature(g2, s[:])
+}
+
+func aggregatePubKeys(g1 *bls12381.G1, pubs []crypto.PubKey) (*bls12381.PointG1, error) {
+	if len(pubs) == 0 {
+		return nil, errEmptyAggregate
+	}
+	aggr := g1.Zero()
+	for _, pub := range pubs {
+		pk, ok := pub.(PubKeyBLS)
+		if !ok {
+			return nil, errInvalidPubKey
+		}
+		if !pk.pop {
+			return nil, ErrPossessionRequired
+		}
+		p, err := decodePubKey(g1, pk.key[:])
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(aggr, aggr, p)
+	}
+	return aggr, nil
+}
+
+//verify e(pk, H(m)) == e(g1, sig)
+func verify(pub *bls12381.PointG1, msg []byte, sig *bls12381.PointG2, dst string) bool {
+	engine := bls12381.NewPairingEngine()
+	h, err := hashToG2(engine.G2, msg, dst)
+	if err != nil {
+		return false
+	}
+	engine.AddPair(pub, h)
+	engine.AddPairInv(engine.G1.One(), sig)
+	return engine.Check()
+}
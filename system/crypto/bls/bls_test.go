@@ -0,0 +1,149 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	bls12381 "github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+//RFC9380 J.10.1 测试向量, msg="abc"
+func TestHashToG2(t *testing.T) {
+	const dst = "QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"
+	expect := "139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8" +
+		"02c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6" +
+		"00aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16" +
+		"1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48"
+	g2 := bls12381.NewG2()
+	p, err := hashToG2(g2, []byte("abc"), dst)
+	require.Nil(t, err)
+	require.Equal(t, expect, hex.EncodeToString(g2.ToBytes(p)))
+}
+
+func TestSignVerify(t *testing.T) {
+	d := &Driver{}
+	priv, err := d.GenKey()
+	require.Nil(t, err)
+	priv2, err := d.PrivKeyFromBytes(priv.Bytes())
+	require.Nil(t, err)
+	require.True(t, priv.Equals(priv2))
+	_, err = d.PrivKeyFromBytes(make([]byte, PrivKeySize))
+	require.Equal(t, errInvalidPrivKey, err)
+
+	msg := []byte("hello bls")
+	sig := priv.Sign(msg)
+	pub := priv.PubKey()
+	require.Len(t, pub.Bytes(), PubKeySize)
+	require.Len(t, sig.Bytes(), SignatureSize)
+	require.True(t, pub.VerifyBytes(msg, sig))
+	require.False(t, pub.VerifyBytes([]byte("other"), sig))
+	require.Nil(t, d.Validate(msg, pub.Bytes(), sig.Bytes()))
+	require.Equal(t, crypto.ErrSign, d.Validate([]byte("other"), pub.Bytes(), sig.Bytes()))
+
+	pub2, err := d.PubKeyFromBytes(pub.Bytes())
+	require.Nil(t, err)
+	require.True(t, pub.Equals(pub2))
+	sig2, err := d.SignatureFromBytes(sig.Bytes())
+	require.Nil(t, err)
+	require.True(t, sig.Equals(sig2))
+
+	//不在曲线上或者无穷远点
+	bad := pub.Bytes()
+	bad[PubKeySize-1] ^= 1
+	_, err = d.PubKeyFromBytes(bad)
+	require.Equal(t, errInvalidPubKey, err)
+	_, err = d.PubKeyFromBytes(make([]byte, PubKeySize))
+	require.Equal(t, errInvalidPubKey, err)
+	_, err = d.SignatureFromBytes(make([]byte, SignatureSize))
+	require.Equal(t, errInvalidSignature, err)
+	//签名和proof of possession的域不同
+	require.False(t, pub.VerifyBytes(pub.Bytes(), priv.(PrivKeyBLS).PopProve()))
+}
+
+func TestAggregate(t *testing.T) {
+	d := &Driver{}
+	var aggr crypto.AggregateCrypto = d
+	msg := []byte("same msg")
+	var pubs, rawPubs []crypto.PubKey
+	var sigs []crypto.Signature
+	for i := 0; i < 3; i++ {
+		priv, err := d.GenKey()
+		require.Nil(t, err)
+		proof := priv.(PrivKeyBLS).PopProve()
+		pub, err := d.PopVerify(priv.PubKey().Bytes(), proof.Bytes())
+		require.Nil(t, err)
+		pubs = append(pubs, pub)
+		raw, err := d.PubKeyFromBytes(pub.Bytes())
+		require.Nil(t, err)
+		rawPubs = append(rawPubs, raw)
+		sigs = append(sigs, priv.Sign(msg))
+	}
+
+	sig, err := aggr.Aggregate(sigs)
+	require.Nil(t, err)
+	require.Nil(t, aggr.VerifyAggregatedOne(pubs, msg, sig))
+	require.Equal(t, crypto.ErrSign, aggr.VerifyAggregatedOne(pubs[:2], msg, sig))
+	aggrPub, err := aggr.AggregatePublic(pubs)
+	require.Nil(t, err)
+	require.True(t, aggrPub.VerifyBytes(msg, sig))
+	//未经过proof of possession校验的公钥不能参与同一消息的聚合
+	require.Equal(t, ErrPossessionRequired, aggr.VerifyAggregatedOne(rawPubs, msg, sig))
+	_, err = aggr.AggregatePublic(rawPubs)
+	require.Equal(t, ErrPossessionRequired, err)
+	_, err = aggr.Aggregate(nil)
+	require.Equal(t, errEmptyAggregate, err)
+
+	//错误的proof
+	_, err = d.PopVerify(pubs[0].Bytes(), sigs[0].Bytes())
+	require.Equal(t, crypto.ErrSign, err)
+}
+
+func TestVerifyAggregatedN(t *testing.T) {
+	d := &Driver{}
+	var pubs []crypto.PubKey
+	var sigs []crypto.Signature
+	var msgs [][]byte
+	for i := 0; i < 3; i++ {
+		priv, err := d.GenKey()
+		require.Nil(t, err)
+		pub, err := d.PubKeyFromBytes(priv.PubKey().Bytes())
+		require.Nil(t, err)
+		msg := []byte{byte(i)}
+		pubs = append(pubs, pub)
+		sigs = append(sigs, priv.Sign(msg))
+		msgs = append(msgs, msg)
+	}
+	sig, err := d.Aggregate(sigs)
+	require.Nil(t, err)
+	require.Nil(t, d.VerifyAggregatedN(pubs, msgs, sig))
+	require.Equal(t, errLengthNotMatch, d.VerifyAggregatedN(pubs, msgs[:2], sig))
+	require.Equal(t, crypto.ErrSign, d.VerifyAggregatedN(pubs, [][]byte{msgs[0], msgs[2], msgs[1]}, sig))
+	//未经过proof of possession校验时消息不能重复
+	require.Equal(t, errDuplicateMsg, d.VerifyAggregatedN(pubs, [][]byte{msgs[0], msgs[0], msgs[1]}, sig))
+}
+
+func TestVerifyBatch(t *testing.T) {
+	d := &Driver{}
+	var batch crypto.BatchCrypto = d
+	var msgs, pubs, sigs [][]byte
+	for i := 0; i < 4; i++ {
+		priv, err := d.GenKey()
+		require.Nil(t, err)
+		//批量验签允许重复消息
+		msg := []byte{byte(i % 2)}
+		msgs = append(msgs, msg)
+		pubs = append(pubs, priv.PubKey().Bytes())
+		sigs = append(sigs, priv.Sign(msg).Bytes())
+	}
+	require.Nil(t, batch.VerifyBatch(msgs, pubs, sigs))
+	require.Equal(t, errLengthNotMatch, batch.VerifyBatch(msgs, pubs[:3], sigs))
+	//交换签名后单个签名无效, 批量验证也必须失败
+	sigs[0], sigs[1] = sigs[1], sigs[0]
+	require.Equal(t, crypto.ErrSign, batch.VerifyBatch(msgs, pubs, sigs))
+}
@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	bls12381 "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+//签名和proof of possession采用不同的域分隔标签, 参考draft-irtf-cfrg-bls-signature的POP方案
+const (
+	dstSign = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+	dstPop  = "BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+//fp元素长度及hash_to_field时每个元素使用的字节数(L = ceil((381 + 128) / 8))
+const (
+	fpSize     = 48
+	fieldChunk = 64
+)
+
+var fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+//expandMessageXMD RFC9380 5.3.1, 基于sha256
+func expandMessageXMD(msg, dst []byte, outLen int) []byte {
+	ell := (outLen + sha256.Size - 1) / sha256.Size
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(outLen >> 8), byte(outLen), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	out := make([]byte, 0, ell*sha256.Size)
+	bi := make([]byte, sha256.Size)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:outLen]
+}
+
+//hashToG2 RFC9380 hash_to_curve, 即BLS12381G2_XMD:SHA-256_SSWU_RO_
+//
+//MapToCurve内部已经做了cofactor clearing, 由于clear_cofactor是群同态, 先清除再相加和标准流程结果一致
+func hashToG2(g2 *bls12381.G2, msg []byte, dst string) (*bls12381.PointG2, error) {
+	uniform := expandMessageXMD(msg, []byte(dst), 4*fieldChunk)
+	var points [2]*bls12381.PointG2
+	for i := range points {
+		//fp2元素编码格式为c1 || c0
+		in := make([]byte, 2*fpSize)
+		for j := 0; j < 2; j++ {
+			offset := (2*i + j) * fieldChunk
+			e := new(big.Int).SetBytes(uniform[offset : offset+fieldChunk])
+			e.Mod(e, fieldModulus)
+			e.FillBytes(in[(1-j)*fpSize : (2-j)*fpSize])
+		}
+		p, err := g2.MapToCurve(in)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = p
+	}
+	return g2.Add(g2.New(), points[0], points[1]), nil
+}
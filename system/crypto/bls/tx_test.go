@@ -0,0 +1,31 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/bls"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCheckSign(t *testing.T) {
+	d := &bls.Driver{}
+	priv, err := d.GenKey()
+	require.Nil(t, err)
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1000000, Nonce: 1}
+	tx.Sign(types.EncodeSignID(bls.ID, 0), priv)
+	require.Equal(t, bls.Name, types.GetSignName("", int(tx.Signature.Ty)))
+
+	//默认不启用
+	require.False(t, tx.CheckSign(100))
+	crypto.Init(&crypto.Config{EnableHeight: map[string]int64{bls.Name: 10}}, nil)
+	require.False(t, tx.CheckSign(9))
+	require.True(t, tx.CheckSign(10))
+	tx.Fee++
+	require.False(t, tx.CheckSign(10))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/chain33/system/crypto/secp256k1"
 	nty "github.com/33cn/chain33/system/dapp/none/types"
 	"github.com/33cn/chain33/types"
+	"github.com/btcsuite/btcd/txscript"
 
 	"github.com/33cn/chain33/common"
 
@@ -24,6 +25,9 @@ import (
 const (
 	Name = "btcscript"
 	ID   = 11
+
+	// ForkHeightLockTime 锁定时间支持区块高度的分叉名称
+	ForkHeightLockTime = "ForkBtcScriptHeightLock"
 )
 
 func init() {
@@ -50,8 +54,8 @@ func (d Driver) Validate(msg, pk, sig []byte) error {
 	}
 
 	ctx := cryptocli.GetCryptoContext()
-	// check btc script lock time , lockTime <= blockHeight
-	if ssig.LockTime > ctx.CurrBlockTime {
+	// check btc script lock time , lockTime <= blockHeight or blockTime
+	if !checkLockTime(ctx, ssig.LockTime) {
 		return errInvalidLockTime
 	}
 
@@ -87,6 +91,27 @@ func (d Driver) Validate(msg, pk, sig []byte) error {
 	return nil
 }
 
+// checkLockTime 与比特币一致, 锁定时间小于LockTimeThreshold时为区块高度, 否则为区块时间
+//
+// 分叉之前统一按照区块时间检测
+func checkLockTime(ctx cryptocli.CryptoContext, lockTime int64) bool {
+	if lockTime > ctx.CurrBlockTime {
+		return false
+	}
+	if lockTime < txscript.LockTimeThreshold && lockTime > ctx.CurrBlockHeight {
+		return ctx.API == nil || !ctx.API.GetConfig().IsFork(ctx.CurrBlockHeight, ForkHeightLockTime)
+	}
+	return true
+}
+
+// CheckLockTimeFork 分叉之前区块高度类型的锁定时间在签名校验时不生效, 构造脚本时需要拒绝
+func CheckLockTimeFork(cfg *types.Chain33Config, height, lockTime int64) error {
+	if lockTime < txscript.LockTimeThreshold && !cfg.IsFork(height, ForkHeightLockTime) {
+		return script.ErrHeightLockTimeNotEnabled
+	}
+	return nil
+}
+
 var (
 	errInvalidLockScript   = errors.New("errInvalidLockScript")
 	errInvalidBtcSignature = errors.New("errInvalidBtcSignature")
@@ -7,6 +7,7 @@ package btcscript
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/common"
@@ -230,7 +231,7 @@ func TestDriver_Validate(t *testing.T) {
 	err = d.Validate(nil, []byte("testpub"), types.Encode(sig))
 	require.Equal(t, errInvalidLockScript, err)
 	sig.LockTime = 11
-	cryptocli.SetCurrentBlock(0, 10)
+	cryptocli.SetCurrentBlock(10, 10)
 	pubKey := script.Script2PubKey(sig.LockScript)
 	err = d.Validate(nil, pubKey, types.Encode(sig))
 	require.Equal(t, errInvalidLockTime, err)
@@ -258,11 +259,43 @@ func TestDriver_Validate(t *testing.T) {
 	err = d.Validate(txMsg, pubKey, types.Encode(sig))
 	require.Equal(t, errInvalidUtxoSequence, err)
 
-	cryptocli.SetCurrentBlock(1, 11)
+	cryptocli.SetCurrentBlock(11, 11)
 	err = d.Validate(txMsg, pubKey, types.Encode(sig))
 	require.Equal(t, errInvalidBtcSignature, err)
 }
 
+func TestCheckLockTime(t *testing.T) {
+
+	localAPI := &mocks.QueueProtocolAPI{}
+	localCfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	localAPI.On("GetConfig").Return(localCfg)
+	ctx := cryptocli.CryptoContext{API: localAPI, CurrBlockHeight: 100, CurrBlockTime: txscript.LockTimeThreshold + 100}
+	// 区块高度
+	require.True(t, checkLockTime(ctx, 0))
+	require.True(t, checkLockTime(ctx, 100))
+	require.False(t, checkLockTime(ctx, 101))
+	// 区块时间
+	require.True(t, checkLockTime(ctx, txscript.LockTimeThreshold))
+	require.True(t, checkLockTime(ctx, ctx.CurrBlockTime))
+	require.False(t, checkLockTime(ctx, ctx.CurrBlockTime+1))
+
+	// 分叉之前统一按照区块时间检测
+	api := &mocks.QueueProtocolAPI{}
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	api.On("GetConfig").Return(cfg)
+	ctx.API = api
+	require.True(t, checkLockTime(ctx, 101))
+	require.False(t, checkLockTime(ctx, ctx.CurrBlockTime+1))
+	// 没有设置API时与分叉之前一致
+	ctx.API = nil
+	require.True(t, checkLockTime(ctx, 101))
+
+	// 分叉之前构造脚本时拒绝区块高度类型的锁定时间
+	require.Equal(t, script.ErrHeightLockTimeNotEnabled, CheckLockTimeFork(cfg, 100, 101))
+	require.Nil(t, CheckLockTimeFork(cfg, 100, txscript.LockTimeThreshold))
+	require.Nil(t, CheckLockTimeFork(localCfg, 100, 101))
+}
+
 var (
 	addr1    = "1MUnpSyvPuB1tie8hCGgWzjwVtHw7sNXRV"
 	privHex1 = "0xf8e994ba1d3577ebbde5ae0264df830327eb3a4187277d62e3e580afef9102b3"
@@ -1,8 +1,12 @@
 package script
 
 import (
+	"crypto/sha256"
+	"math"
+
 	"github.com/33cn/chain33/common/log"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 )
 
@@ -121,3 +125,157 @@ func GetWalletRecoverySignature(isRetrieve bool, signMsg, privKey, walletRecover
 
 	return sig, Script2PubKey(walletRecoverScript), nil
 }
+
+// NewCLTVScript absolute time lock pubKey script
+// lockTime  block height if less than 500000000, otherwise block time(unix seconds)
+// <lockTime> CHECKLOCKTIMEVERIFY DROP <Pubkey> CHECKSIG
+func NewCLTVScript(pubKey []byte, lockTime int64) (script []byte, err error) {
+
+	if !isValidLockTime(lockTime) {
+		return nil, ErrInvalidLockTime
+	}
+	addr, err := btcutil.NewAddressPubKey(pubKey, Chain33BtcParams)
+	if err != nil {
+		return nil, ErrInvalidBtcPubKey
+	}
+	builder := txscript.NewScriptBuilder()
+	builder.AddInt64(lockTime).AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).AddOp(txscript.OP_DROP).
+		AddData(addr.ScriptAddress()).AddOp(txscript.OP_CHECKSIG)
+	return buildScript(builder)
+}
+
+// NewCSVScript relative time lock pubKey script, based on none delay tx
+// relativeDelayTime  relative block height or block time, depends on the delay tx
+// <sequence> CHECKSEQUENCEVERIFY DROP <Pubkey> CHECKSIG
+func NewCSVScript(pubKey []byte, relativeDelayTime int64) (script []byte, err error) {
+
+	if !isValidSequence(relativeDelayTime) {
+		return nil, ErrInvalidLockTime
+	}
+	addr, err := btcutil.NewAddressPubKey(pubKey, Chain33BtcParams)
+	if err != nil {
+		return nil, ErrInvalidBtcPubKey
+	}
+	builder := txscript.NewScriptBuilder()
+	builder.AddInt64(relativeDelayTime).AddOp(txscript.OP_CHECKSEQUENCEVERIFY).AddOp(txscript.OP_DROP).
+		AddData(addr.ScriptAddress()).AddOp(txscript.OP_CHECKSIG)
+	return buildScript(builder)
+}
+
+// GetTimeLockSignature get signature of NewCLTVScript or NewCSVScript
+// lockTime  absolute lock time, same as cltv script, set 0 for csv script
+// utxoSequence  relative delay time, same as csv script, set 0 for cltv script
+func GetTimeLockSignature(signMsg, privKey, lockScript []byte, lockTime, utxoSequence int64) (sig []byte, pubKey []byte, err error) {
+
+	return getScriptSignature(signMsg, privKey, lockScript, lockTime, utxoSequence,
+		func(txInSig []byte) *txscript.ScriptBuilder {
+			return txscript.NewScriptBuilder().AddData(txInSig)
+		})
+}
+
+// NewHTLCScript hash time lock contract script, for cross chain atomic swap
+// hashLock  sha256 hash of the secret preimage
+// receiverPubKey  claim with preimage at any time
+// refundPubKey  refund after lock time
+// lockTime  block height if less than 500000000, otherwise block time(unix seconds)
+// IF SIZE 32 EQUALVERIFY SHA256 <hashLock> EQUALVERIFY <receiver Pubkey> CHECKSIG
+// ELSE <lockTime> CHECKLOCKTIMEVERIFY DROP <refund Pubkey> CHECKSIG ENDIF
+func NewHTLCScript(hashLock, receiverPubKey, refundPubKey []byte, lockTime int64) (script []byte, err error) {
+
+	if len(hashLock) != sha256.Size {
+		return nil, ErrInvalidHashLock
+	}
+	if !isValidLockTime(lockTime) {
+		return nil, ErrInvalidLockTime
+	}
+	receiver, err := btcutil.NewAddressPubKey(receiverPubKey, Chain33BtcParams)
+	if err != nil {
+		return nil, ErrInvalidBtcPubKey
+	}
+	refund, err := btcutil.NewAddressPubKey(refundPubKey, Chain33BtcParams)
+	if err != nil {
+		return nil, ErrInvalidBtcPubKey
+	}
+
+	builder := txscript.NewScriptBuilder()
+	// 限定原像长度, 避免跨链时原像在另一条链上无法使用
+	builder.AddOp(txscript.OP_IF).AddOp(txscript.OP_SIZE).AddInt64(htlcPreimageSize).
+		AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_SHA256).AddData(hashLock).
+		AddOp(txscript.OP_EQUALVERIFY).AddData(receiver.ScriptAddress()).AddOp(txscript.OP_CHECKSIG)
+	builder.AddOp(txscript.OP_ELSE).AddInt64(lockTime).AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+		AddOp(txscript.OP_DROP).AddData(refund.ScriptAddress()).AddOp(txscript.OP_CHECKSIG)
+	builder.AddOp(txscript.OP_ENDIF)
+	return buildScript(builder)
+}
+
+// GetHTLCClaimSignature get htlc signature of receiver with secret preimage
+func GetHTLCClaimSignature(signMsg, privKey, htlcScript, preimage []byte) (sig []byte, pubKey []byte, err error) {
+
+	if len(preimage) != htlcPreimageSize {
+		return nil, nil, ErrInvalidPreimage
+	}
+	return getScriptSignature(signMsg, privKey, htlcScript, 0, 0,
+		func(txInSig []byte) *txscript.ScriptBuilder {
+			return txscript.NewScriptBuilder().AddData(txInSig).AddData(preimage).AddOp(txscript.OP_TRUE)
+		})
+}
+
+// GetHTLCRefundSignature get htlc signature of refund after lock time
+// lockTime  same as htlc script
+func GetHTLCRefundSignature(signMsg, privKey, htlcScript []byte, lockTime int64) (sig []byte, pubKey []byte, err error) {
+
+	if !isValidLockTime(lockTime) {
+		return nil, nil, ErrInvalidLockTime
+	}
+	return getScriptSignature(signMsg, privKey, htlcScript, lockTime, 0,
+		func(txInSig []byte) *txscript.ScriptBuilder {
+			return txscript.NewScriptBuilder().AddData(txInSig).AddOp(txscript.OP_FALSE)
+		})
+}
+
+// htlcPreimageSize 哈希原像长度
+const htlcPreimageSize = 32
+
+// 锁定时间和延时在比特币交易中为uint32, 延时最高位为禁用标志
+func isValidLockTime(lockTime int64) bool {
+	return lockTime > 0 && lockTime <= math.MaxUint32
+}
+
+func isValidSequence(sequence int64) bool {
+	return sequence > 0 && sequence < wire.SequenceLockTimeDisabled
+}
+
+func buildScript(builder *txscript.ScriptBuilder) ([]byte, error) {
+	script, err := builder.Script()
+	if err != nil {
+		return nil, ErrBuildBtcScript
+	}
+	return script, nil
+}
+
+// getScriptSignature 根据锁定脚本签名, buildUnlock构造对应的解锁脚本
+func getScriptSignature(signMsg, privKey, lockScript []byte, lockTime, utxoSequence int64,
+	buildUnlock func(txInSig []byte) *txscript.ScriptBuilder) (sig []byte, pubKey []byte, err error) {
+
+	btcTx := getBindBtcTx(signMsg)
+	setBtcTx(btcTx, lockTime, utxoSequence, nil)
+	key, _ := NewBtcKeyFromBytes(privKey)
+
+	txInSig, err := txscript.RawTxInSignature(btcTx, 0, lockScript, txscript.SigHashAll, key)
+	if err != nil {
+		btcLog.Error("getScriptSignature", "sign btc tx in error", err)
+		return nil, nil, ErrGetBtcTxInSig
+	}
+	unlockScript, err := buildUnlock(txInSig).Script()
+	if err != nil {
+		btcLog.Error("getScriptSignature", "build script err", err)
+		return nil, nil, ErrBuildBtcScript
+	}
+
+	sig, err = newBtcScriptSig(lockScript, unlockScript, lockTime, utxoSequence)
+	if err != nil {
+		btcLog.Error("getScriptSignature", "new btc script sig err", err)
+		return nil, nil, ErrNewBtcScriptSig
+	}
+	return sig, Script2PubKey(lockScript), nil
+}
@@ -2,11 +2,16 @@ package script_test
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
 	cryptocli "github.com/33cn/chain33/common/crypto/client"
 	nty "github.com/33cn/chain33/system/dapp/none/types"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/33cn/chain33/system/crypto/btcscript"
@@ -93,3 +98,118 @@ func Test_MultisigScript(t *testing.T) {
 	_, err = script.NewMultiSigScript([][]byte{priv1.PubKey().Bytes(), priv2.PubKey().Bytes()}, 1)
 	require.Nil(t, err)
 }
+
+func Test_HTLCScript(t *testing.T) {
+
+	_, receiver := util.Genaddress()
+	_, refund := util.Genaddress()
+	preimage := crypto.CRandBytes(32)
+	hashLock := common.Sha256(preimage)
+	lockTime := int64(100) // block height
+
+	_, err := script.NewHTLCScript(preimage[:20], receiver.PubKey().Bytes(), refund.PubKey().Bytes(), lockTime)
+	require.Equal(t, script.ErrInvalidHashLock, err)
+	_, err = script.NewHTLCScript(hashLock, receiver.PubKey().Bytes(), refund.PubKey().Bytes(), 0)
+	require.Equal(t, script.ErrInvalidLockTime, err)
+	_, err = script.NewHTLCScript(hashLock, []byte("invalid"), refund.PubKey().Bytes(), lockTime)
+	require.Equal(t, script.ErrInvalidBtcPubKey, err)
+	htlcScript, err := script.NewHTLCScript(hashLock, receiver.PubKey().Bytes(), refund.PubKey().Bytes(), lockTime)
+	require.Nil(t, err)
+
+	api := new(mocks.QueueProtocolAPI)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api.On("GetConfig").Return(cfg)
+	cryptocli.SetQueueAPI(api)
+	tx := util.CreateNoneTx(cfg, nil)
+	signMsg := types.Encode(tx)
+	check := func(sig, pubKey []byte, err error) bool {
+		require.Nil(t, err)
+		tx.Signature = &types.Signature{
+			Ty:        btcscript.ID,
+			Pubkey:    pubKey,
+			Signature: sig,
+		}
+		return tx.CheckSign(-1)
+	}
+
+	// 接收方提供原像提取
+	cryptocli.SetCurrentBlock(1, 1)
+	require.True(t, check(script.GetHTLCClaimSignature(signMsg, receiver.Bytes(), htlcScript, preimage)))
+	require.Equal(t, address.PubKeyToAddr(address.DefaultID, script.Script2PubKey(htlcScript)), tx.From())
+	require.False(t, check(script.GetHTLCClaimSignature(signMsg, refund.Bytes(), htlcScript, preimage)))
+	require.False(t, check(script.GetHTLCClaimSignature(signMsg, receiver.Bytes(), htlcScript, crypto.CRandBytes(32))))
+	_, _, err = script.GetHTLCClaimSignature(signMsg, receiver.Bytes(), htlcScript, preimage[:31])
+	require.Equal(t, script.ErrInvalidPreimage, err)
+
+	// 发送方超时退回
+	require.False(t, check(script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, lockTime)))
+	cryptocli.SetCurrentBlock(lockTime-1, types.Now().Unix())
+	require.False(t, check(script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, lockTime)))
+	cryptocli.SetCurrentBlock(lockTime, types.Now().Unix())
+	require.True(t, check(script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, lockTime)))
+	require.False(t, check(script.GetHTLCRefundSignature(signMsg, receiver.Bytes(), htlcScript, lockTime)))
+	// 锁定时间小于脚本设定
+	require.False(t, check(script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, lockTime-1)))
+	_, _, err = script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, 0)
+	require.Equal(t, script.ErrInvalidLockTime, err)
+
+	// 非local链在分叉之前区块高度类型的锁定时间不生效, 构造脚本时需要拒绝
+	chainCfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	chainAPI := new(mocks.QueueProtocolAPI)
+	chainAPI.On("GetConfig").Return(chainCfg)
+	cryptocli.SetQueueAPI(chainAPI)
+	defer cryptocli.SetQueueAPI(api)
+	cryptocli.SetCurrentBlock(lockTime-1, types.Now().Unix())
+	require.True(t, check(script.GetHTLCRefundSignature(signMsg, refund.Bytes(), htlcScript, lockTime)))
+	require.Equal(t, script.ErrHeightLockTimeNotEnabled, btcscript.CheckLockTimeFork(chainCfg, lockTime-1, lockTime))
+	require.Nil(t, btcscript.CheckLockTimeFork(chainCfg, lockTime-1, txscript.LockTimeThreshold+lockTime))
+}
+
+func Test_TimeLockScript(t *testing.T) {
+
+	_, key := util.Genaddress()
+	_, invalidKey := util.Genaddress()
+	lockTime := int64(txscript.LockTimeThreshold + 10) // block time
+
+	cltvScript, err := script.NewCLTVScript(key.PubKey().Bytes(), lockTime)
+	require.Nil(t, err)
+	_, err = script.NewCLTVScript(key.PubKey().Bytes(), -1)
+	require.Equal(t, script.ErrInvalidLockTime, err)
+
+	api := new(mocks.QueueProtocolAPI)
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api.On("GetConfig").Return(cfg)
+	cryptocli.SetQueueAPI(api)
+	tx := util.CreateNoneTx(cfg, nil)
+	signMsg := types.Encode(tx)
+	check := func(sig, pubKey []byte, err error) bool {
+		require.Nil(t, err)
+		tx.Signature = &types.Signature{
+			Ty:        btcscript.ID,
+			Pubkey:    pubKey,
+			Signature: sig,
+		}
+		return tx.CheckSign(-1)
+	}
+
+	cryptocli.SetCurrentBlock(1, lockTime-1)
+	require.False(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), cltvScript, lockTime, 0)))
+	cryptocli.SetCurrentBlock(1, lockTime)
+	require.True(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), cltvScript, lockTime, 0)))
+	require.False(t, check(script.GetTimeLockSignature(signMsg, invalidKey.Bytes(), cltvScript, lockTime, 0)))
+	// 锁定时间类型与脚本不一致
+	require.False(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), cltvScript, 1, 0)))
+
+	// 相对延时, 基于延时交易开始高度
+	delay := int64(5)
+	csvScript, err := script.NewCSVScript(key.PubKey().Bytes(), delay)
+	require.Nil(t, err)
+	_, err = script.NewCSVScript(key.PubKey().Bytes(), 0)
+	require.Equal(t, script.ErrInvalidLockTime, err)
+	api.On("Query", nty.NoneX, nty.QueryGetDelayTxInfo, mock.Anything).Return(&nty.CommitDelayTxLog{DelayBeginHeight: 10}, nil)
+	cryptocli.SetCurrentBlock(14, lockTime)
+	require.False(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), csvScript, 0, delay)))
+	cryptocli.SetCurrentBlock(15, lockTime)
+	require.True(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), csvScript, 0, delay)))
+	require.False(t, check(script.GetTimeLockSignature(signMsg, key.Bytes(), csvScript, 0, delay-1)))
+}
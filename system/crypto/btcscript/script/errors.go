@@ -21,4 +21,13 @@ var (
 	ErrBtcKeyNotExist = errors.New("ErrBtcKeyNotExist")
 	// ErrBtcScriptNotExist btc script not exist when sign
 	ErrBtcScriptNotExist = errors.New("ErrBtcScriptNotExist")
+
+	// ErrInvalidLockTime invalid absolute lock time or relative delay time
+	ErrInvalidLockTime = errors.New("ErrInvalidLockTime")
+	// ErrInvalidHashLock hash lock must be sha256 hash
+	ErrInvalidHashLock = errors.New("ErrInvalidHashLock")
+	// ErrInvalidPreimage invalid hash lock preimage
+	ErrInvalidPreimage = errors.New("ErrInvalidPreimage")
+	// ErrHeightLockTimeNotEnabled block height lock time is not enabled before fork
+	ErrHeightLockTimeNotEnabled = errors.New("ErrHeightLockTimeNotEnabled")
 )
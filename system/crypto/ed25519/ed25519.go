@@ -15,6 +15,9 @@ import (
 )
 
 //Driver 驱动
+//
+//不实现crypto.BatchCrypto: 逐个验签使用不带cofactor的验证方程, 而随机线性组合的批量验证只能使用带cofactor的方程,
+//会接受包含小阶分量的签名, 两者结果不一致会导致节点间共识分叉, ed25519交易由worker pool并发逐个验签
 type Driver struct{}
 
 //GenKey 生成私钥
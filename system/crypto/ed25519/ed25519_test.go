@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -58,3 +59,8 @@ func TestPrivateKeyFromBytes(t *testing.T) {
 	assert.True(t, pub2.VerifyBytes(msg, sig))
 	assert.Nil(t, d.Validate(msg, pub.Bytes(), sigbytes))
 }
+
+func TestNotSupportBatch(t *testing.T) {
+	_, err := crypto.ToBatch(&Driver{})
+	assert.Equal(t, crypto.ErrNotSupportBatch, err)
+}
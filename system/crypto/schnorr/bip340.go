@@ -0,0 +1,162 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// BIP-340 tagged hash 标签
+const (
+	tagAux       = "BIP0340/aux"
+	tagNonce     = "BIP0340/nonce"
+	tagChallenge = "BIP0340/challenge"
+)
+
+var (
+	curve = btcec.S256()
+	// (p+1)/4, 用于计算平方根
+	sqrtExp = new(big.Int).Rsh(new(big.Int).Add(curve.P, big.NewInt(1)), 2)
+
+	// ErrInvalidXCoord x坐标不在曲线上或者超出范围
+	ErrInvalidXCoord = errors.New("ErrInvalidXCoord")
+	// ErrInvalidPrivKey 私钥为0或者不小于曲线阶
+	ErrInvalidPrivKey = errors.New("ErrInvalidPrivKey")
+	errInvalidNonce   = errors.New("ErrInvalidNonce")
+	errSignVerify     = errors.New("ErrSignVerify")
+)
+
+// TaggedHash BIP-340 tagged hash, sha256(sha256(tag) || sha256(tag) || msgs)
+func TaggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// LiftX 根据x坐标恢复y坐标为偶数的曲线点
+func LiftX(xBytes []byte) (*big.Int, *big.Int, error) {
+	if len(xBytes) != 32 {
+		return nil, nil, ErrInvalidXCoord
+	}
+	x := new(big.Int).SetBytes(xBytes)
+	if x.Cmp(curve.P) >= 0 {
+		return nil, nil, ErrInvalidXCoord
+	}
+	// y^2 = x^3 + 7
+	c := new(big.Int).Exp(x, big.NewInt(3), curve.P)
+	c.Add(c, big.NewInt(7))
+	c.Mod(c, curve.P)
+	y := new(big.Int).Exp(c, sqrtExp, curve.P)
+	if new(big.Int).Exp(y, big.NewInt(2), curve.P).Cmp(c) != 0 {
+		return nil, nil, ErrInvalidXCoord
+	}
+	if y.Bit(0) == 1 {
+		y.Sub(curve.P, y)
+	}
+	return x, y, nil
+}
+
+// XOnlyPubKey 私钥对应的x-only公钥
+func XOnlyPubKey(secKey []byte) ([]byte, error) {
+	d := new(big.Int).SetBytes(secKey)
+	if d.Sign() == 0 || d.Cmp(curve.N) >= 0 {
+		return nil, ErrInvalidPrivKey
+	}
+	px, _ := curve.ScalarBaseMult(secKey)
+	return bytes32(px), nil
+}
+
+// sign BIP-340 签名, msg为32字节消息摘要, aux为32字节辅助随机数
+func sign(secKey, msg, aux []byte) ([]byte, error) {
+	d := new(big.Int).SetBytes(secKey)
+	if d.Sign() == 0 || d.Cmp(curve.N) >= 0 {
+		return nil, ErrInvalidPrivKey
+	}
+	px, py := curve.ScalarBaseMult(bytes32(d))
+	if py.Bit(0) == 1 {
+		d.Sub(curve.N, d)
+	}
+	pubBytes := bytes32(px)
+
+	t := bytes32(d)
+	auxHash := TaggedHash(tagAux, aux)
+	for i := range t {
+		t[i] ^= auxHash[i]
+	}
+	k := new(big.Int).SetBytes(TaggedHash(tagNonce, t, pubBytes, msg))
+	k.Mod(k, curve.N)
+	if k.Sign() == 0 {
+		return nil, errInvalidNonce
+	}
+	rx, ry := curve.ScalarBaseMult(bytes32(k))
+	if ry.Bit(0) == 1 {
+		k.Sub(curve.N, k)
+	}
+	rBytes := bytes32(rx)
+
+	e := challenge(rBytes, pubBytes, msg)
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, curve.N)
+
+	sig := make([]byte, 64)
+	copy(sig, rBytes)
+	copy(sig[32:], bytes32(s))
+	//签名后立即验证, 防止计算错误泄露私钥
+	if !verify(pubBytes, msg, sig) {
+		return nil, errSignVerify
+	}
+	return sig, nil
+}
+
+// verify BIP-340 验签, pub为32字节x-only公钥
+func verify(pub, msg, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	px, py, err := LiftX(pub)
+	if err != nil {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	if r.Cmp(curve.P) >= 0 {
+		return false
+	}
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(curve.N) >= 0 {
+		return false
+	}
+	e := challenge(sig[:32], pub, msg)
+	// R = s*G - e*P
+	e.Sub(curve.N, e)
+	sx, sy := curve.ScalarBaseMult(bytes32(s))
+	ex, ey := curve.ScalarMult(px, py, bytes32(e))
+	rx, ry := curve.Add(sx, sy, ex, ey)
+	if rx.Sign() == 0 && ry.Sign() == 0 {
+		return false
+	}
+	return ry.Bit(0) == 0 && rx.Cmp(r) == 0
+}
+
+func challenge(r, pub, msg []byte) *big.Int {
+	e := new(big.Int).SetBytes(TaggedHash(tagChallenge, r, pub, msg))
+	return e.Mod(e, curve.N)
+}
+
+// bytes32 大整数转为32字节大端格式
+func bytes32(n *big.Int) []byte {
+	b := make([]byte, 32)
+	n.FillBytes(b)
+	return b
+}
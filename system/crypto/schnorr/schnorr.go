@@ -0,0 +1,188 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package schnorr 基于secp256k1曲线的BIP-340 schnorr签名, 兼容比特币taproot
+package schnorr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//const
+const (
+	Name = "schnorr"
+	ID   = 261
+
+	PrivKeySize   = 32
+	PubKeySize    = 32
+	SignatureSize = 64
+)
+
+func init() {
+	//默认不启用, 需要配置启用高度
+	crypto.Register(Name, &Driver{}, crypto.WithRegOptionTypeID(ID), crypto.WithRegOptionEnableHeight(-1))
+}
+
+//Driver 驱动
+type Driver struct{}
+
+//GenKey 生成私钥
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	for {
+		priv, err := d.PrivKeyFromBytes(crypto.CRandBytes(PrivKeySize))
+		if err == nil {
+			return priv, nil
+		}
+	}
+}
+
+//PrivKeyFromBytes 字节转为私钥, 与secp256k1私钥格式相同
+func (d Driver) PrivKeyFromBytes(b []byte) (privKey crypto.PrivKey, err error) {
+	if len(b) != PrivKeySize {
+		return nil, errors.New("invalid priv key byte")
+	}
+	pub, err := XOnlyPubKey(b)
+	if err != nil {
+		return nil, err
+	}
+	priv := PrivKeySchnorr{}
+	copy(priv.key[:], b)
+	copy(priv.pub[:], pub)
+	return priv, nil
+}
+
+//PubKeyFromBytes 字节转为x-only公钥
+func (d Driver) PubKeyFromBytes(b []byte) (pubKey crypto.PubKey, err error) {
+	if len(b) != PubKeySize {
+		return nil, errors.New("invalid pub key byte")
+	}
+	if _, _, err := LiftX(b); err != nil {
+		return nil, err
+	}
+	pub := PubKeySchnorr{}
+	copy(pub[:], b)
+	return pub, nil
+}
+
+//SignatureFromBytes 字节转为签名
+func (d Driver) SignatureFromBytes(b []byte) (sig crypto.Signature, err error) {
+	if len(b) != SignatureSize {
+		return nil, errors.New("invalid signature byte")
+	}
+	var s SignatureSchnorr
+	copy(s[:], b)
+	return s, nil
+}
+
+// Validate validate msg and signature
+func (d Driver) Validate(msg, pub, sig []byte) error {
+	return crypto.BasicValidation(d, msg, pub, sig)
+}
+
+//PrivKeySchnorr PrivKey, 缓存对应的x-only公钥
+type PrivKeySchnorr struct {
+	key [PrivKeySize]byte
+	pub [PubKeySize]byte
+}
+
+//Bytes 字节格式
+func (privKey PrivKeySchnorr) Bytes() []byte {
+	s := make([]byte, PrivKeySize)
+	copy(s, privKey.key[:])
+	return s
+}
+
+//Sign 签名, 对消息的sha256摘要进行BIP-340签名
+func (privKey PrivKeySchnorr) Sign(msg []byte) crypto.Signature {
+	sig, err := sign(privKey.key[:], crypto.Sha256(msg), crypto.CRandBytes(32))
+	if err != nil {
+		panic("Error signing schnorr" + err.Error())
+	}
+	var s SignatureSchnorr
+	copy(s[:], sig)
+	return s
+}
+
+//PubKey 私钥生成公钥
+func (privKey PrivKeySchnorr) PubKey() crypto.PubKey {
+	return PubKeySchnorr(privKey.pub)
+}
+
+//Equals 私钥是否相等
+func (privKey PrivKeySchnorr) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(PrivKeySchnorr); ok {
+		return bytes.Equal(privKey.key[:], otherKey.key[:])
+	}
+	return false
+}
+
+func (privKey PrivKeySchnorr) String() string {
+	return "PrivKeySchnorr{*****}"
+}
+
+//PubKeySchnorr x-only公钥, 即y坐标为偶数的曲线点的x坐标
+type PubKeySchnorr [PubKeySize]byte
+
+//Bytes 字节格式
+func (pubKey PubKeySchnorr) Bytes() []byte {
+	s := make([]byte, PubKeySize)
+	copy(s, pubKey[:])
+	return s
+}
+
+//VerifyBytes 验证字节
+func (pubKey PubKeySchnorr) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	s, ok := sig.(SignatureSchnorr)
+	if !ok {
+		return false
+	}
+	return verify(pubKey[:], crypto.Sha256(msg), s[:])
+}
+
+func (pubKey PubKeySchnorr) String() string {
+	return fmt.Sprintf("PubKeySchnorr{%X}", pubKey[:])
+}
+
+//KeyString Must return the full bytes in hex.
+// Used for map keying, etc.
+func (pubKey PubKeySchnorr) KeyString() string {
+	return fmt.Sprintf("%X", pubKey[:])
+}
+
+//Equals 公钥相等
+func (pubKey PubKeySchnorr) Equals(other crypto.PubKey) bool {
+	if otherKey, ok := other.(PubKeySchnorr); ok {
+		return bytes.Equal(pubKey[:], otherKey[:])
+	}
+	return false
+}
+
+//SignatureSchnorr Signature, R的x坐标和s
+type SignatureSchnorr [SignatureSize]byte
+
+//Bytes 字节格式
+func (sig SignatureSchnorr) Bytes() []byte {
+	s := make([]byte, SignatureSize)
+	copy(s, sig[:])
+	return s
+}
+
+//IsZero 是否是0
+func (sig SignatureSchnorr) IsZero() bool { return len(sig) == 0 }
+
+func (sig SignatureSchnorr) String() string {
+	return fmt.Sprintf("/%X.../", sig[:])
+}
+
+//Equals 相等
+func (sig SignatureSchnorr) Equals(other crypto.Signature) bool {
+	if otherSig, ok := other.(SignatureSchnorr); ok {
+		return bytes.Equal(sig[:], otherSig[:])
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package schnorr_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/address/taproot"
+	"github.com/33cn/chain33/system/crypto/schnorr"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCheckSignXOnly(t *testing.T) {
+	d := &schnorr.Driver{}
+	priv, err := d.GenKey()
+	require.Nil(t, err)
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1000000, Nonce: 1}
+	tx.Sign(types.EncodeSignID(schnorr.ID, taproot.ID), priv)
+	crypto.Init(&crypto.Config{EnableHeight: map[string]int64{schnorr.Name: 0}}, nil)
+
+	//交易中保存32字节的x-only公钥
+	xOnly := tx.Signature.Pubkey
+	require.Equal(t, schnorr.PubKeySize, len(xOnly))
+	require.True(t, tx.CheckSign(0))
+
+	//同一个点的33字节压缩公钥不能通过验签
+	tx.Signature.Pubkey = append([]byte{2}, xOnly...)
+	require.False(t, tx.CheckSign(0))
+	//不在曲线上的x坐标
+	tx.Signature.Pubkey = make([]byte, schnorr.PubKeySize)
+	tx.Signature.Pubkey[schnorr.PubKeySize-1] = 5
+	require.False(t, tx.CheckSign(0))
+	tx.Signature.Pubkey = xOnly
+	require.True(t, tx.CheckSign(0))
+
+	//交易发送地址为x-only公钥按照BIP-86生成的taproot地址
+	from := tx.From()
+	require.True(t, strings.HasPrefix(from, taproot.HRP+"1p"))
+	require.Equal(t, address.PubKeyToAddr(taproot.ID, xOnly), from)
+	drv, err := address.LoadDriver(taproot.ID, -1)
+	require.Nil(t, err)
+	program, err := drv.FromString(from)
+	require.Nil(t, err)
+	require.Equal(t, taproot.OutputKey(xOnly), program)
+}
@@ -277,7 +277,7 @@ func addImportKeyFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("label", "l", "", "label for private key")
 	cmd.MarkFlagRequired("label")
 
-	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2)")
+	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2), taproot(3)")
 }
 
 func importKey(cmd *cobra.Command, args []string) {
@@ -325,7 +325,7 @@ func NewAccountCmd() *cobra.Command {
 
 func addCreateAccountFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("label", "l", "", "account label")
-	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2)")
+	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2), taproot(3)")
 	cmd.MarkFlagRequired("label")
 }
 
@@ -434,7 +434,7 @@ func PubKeyToAddrCmd() *cobra.Command {
 	return cmd
 }
 func addPubKeyFlags(cmd *cobra.Command) {
-	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2)")
+	cmd.Flags().Int32P("addressType", "t", 0, "address type ID, btc(0), btcMultiSign(1), eth(2), taproot(3)")
 	cmd.Flags().StringP("pub", "p", "", "pub key string")
 	cmd.MarkFlagRequired("pub")
 }
@@ -9,10 +9,13 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/33cn/chain33/blockchain"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/system/crypto/secp256k1"
 	commandtypes "github.com/33cn/chain33/system/dapp/commands/types"
 	"github.com/33cn/chain33/types"
 	"github.com/spf13/cobra"
@@ -39,6 +42,13 @@ func BlockCmd() *cobra.Command {
 		AddPushSubscribeCmd(),
 		ListPushesCmd(),
 		GetPushSeqLastNumCmd(),
+		PausePushCmd(),
+		ResumePushCmd(),
+		DeletePushCmd(),
+		RewindPushCmd(),
+		SetPushURLCmd(),
+		GetReorgHistoryCmd(),
+		GetCheckpointsCmd(),
 	)
 
 	return cmd
@@ -327,6 +337,7 @@ func addPushSubscribeFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64P("lastSequence", "", 0, "lastSequence")
 	cmd.Flags().Int64P("lastHeight", "", 0, "lastHeight")
 	cmd.Flags().StringP("lastBlockHash", "", "", "lastBlockHash")
+	cmd.Flags().StringP("owner", "o", "", "owner public key(hex), the push can be managed by requests signed with its private key")
 }
 
 func addPushSubscribe(cmd *cobra.Command, args []string) {
@@ -345,6 +356,7 @@ func addPushSubscribe(cmd *cobra.Command, args []string) {
 	lastSeq, _ := cmd.Flags().GetInt64("lastSequence")
 	lastHeight, _ := cmd.Flags().GetInt64("lastHeight")
 	lastBlockHash, _ := cmd.Flags().GetString("lastBlockHash")
+	owner, _ := cmd.Flags().GetString("owner")
 	if lastSeq != 0 || lastHeight != 0 || lastBlockHash != "" {
 		if lastSeq == 0 || lastHeight == 0 || lastBlockHash == "" {
 			fmt.Println("lastSequence, lastHeight, lastBlockHash need at the same time")
@@ -364,6 +376,7 @@ func addPushSubscribe(cmd *cobra.Command, args []string) {
 		LastHeight:    lastHeight,
 		LastBlockHash: lastBlockHash,
 		Type:          int32(pushType),
+		Owner:         owner,
 	}
 
 	var res types.ReplySubscribePush
@@ -417,3 +430,140 @@ func getPushSeqLastNumCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetPushSeqLastNum", &params, &res)
 	ctx.Run()
 }
+
+// PausePushCmd pause push
+func PausePushCmd() *cobra.Command {
+	return managePushCmd("pause_push", "pause the push, signed by the owner", blockchain.PushManagePause)
+}
+
+// ResumePushCmd resume push
+func ResumePushCmd() *cobra.Command {
+	return managePushCmd("resume_push", "resume the paused or stopped push, signed by the owner", blockchain.PushManageResume)
+}
+
+// DeletePushCmd delete push
+func DeletePushCmd() *cobra.Command {
+	return managePushCmd("delete_push", "delete the push, signed by the owner", blockchain.PushManageDelete)
+}
+
+// RewindPushCmd set the sequence of last push
+func RewindPushCmd() *cobra.Command {
+	cmd := managePushCmd("rewind_push", "push again after the sequence, signed by the owner", blockchain.PushManageRewind)
+	cmd.Flags().Int64P("lastSequence", "s", 0, "sequence of last push")
+	cmd.MarkFlagRequired("lastSequence")
+	return cmd
+}
+
+// SetPushURLCmd change the URL of push
+func SetPushURLCmd() *cobra.Command {
+	cmd := managePushCmd("set_push_url", "change the URL of push, signed by the owner", blockchain.PushManageSetURL)
+	cmd.Flags().StringP("url", "u", "", "call back URL")
+	cmd.MarkFlagRequired("url")
+	return cmd
+}
+
+func managePushCmd(use, short string, op int32) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Run: func(cmd *cobra.Command, args []string) {
+			managePush(cmd, op)
+		},
+	}
+	cmd.Flags().StringP("name", "n", "", "call back name")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringP("key", "k", "", "owner private key(hex)")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("nonce", "", 0, "request nonce, must be larger than the last one, default current time in nanoseconds")
+	return cmd
+}
+
+func managePush(cmd *cobra.Command, op int32) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	key, _ := cmd.Flags().GetString("key")
+	nonce, _ := cmd.Flags().GetInt64("nonce")
+	lastSeq, _ := cmd.Flags().GetInt64("lastSequence")
+	url, _ := cmd.Flags().GetString("url")
+	if nonce <= 0 {
+		nonce = time.Now().UnixNano()
+	}
+
+	keyBytes, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv, err := secp256k1.Driver{}.PrivKeyFromBytes(keyBytes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	req := &types.ReqManagePush{
+		Name:         name,
+		Op:           op,
+		LastSequence: lastSeq,
+		URL:          url,
+		Nonce:        nonce,
+	}
+	sign := priv.Sign(blockchain.ManagePushSignData(req))
+	params := rpctypes.ReqManagePush{
+		Name:         name,
+		Op:           op,
+		LastSequence: lastSeq,
+		URL:          url,
+		Nonce:        nonce,
+		Signature: &rpctypes.Signature{
+			Ty:        types.SECP256K1,
+			Pubkey:    common.ToHex(priv.PubKey().Bytes()),
+			Signature: common.ToHex(sign.Bytes()),
+		},
+	}
+
+	var res types.ReplySubscribePush
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.ManagePush", &params, &res)
+	ctx.Run()
+}
+
+// GetReorgHistoryCmd get chain reorg history
+func GetReorgHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reorg_history",
+		Short: "Get chain reorg history, latest first",
+		Run:   getReorgHistory,
+	}
+	cmd.Flags().Int64P("start", "s", 0, "start reorg index, latest when 0")
+	cmd.Flags().Int32P("count", "c", 10, "reorg record count")
+	return cmd
+}
+
+func getReorgHistory(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	start, _ := cmd.Flags().GetInt64("start")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	params := types.ReqReorgHistory{
+		Start: start,
+		Count: count,
+	}
+	var res rpctypes.ReorgRecords
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetReorgHistory", &params, &res)
+	ctx.Run()
+}
+
+// GetCheckpointsCmd get block sync checkpoints
+func GetCheckpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoints",
+		Short: "Get block sync checkpoints",
+		Run:   getCheckpoints,
+	}
+	return cmd
+}
+
+func getCheckpoints(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res rpctypes.Checkpoints
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.GetCheckpoints", nil, &res)
+	ctx.Run()
+}
@@ -0,0 +1,89 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/system/crypto/bls"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// BlsCmd bls command
+func BlsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls",
+		Short: "bls12-381 key management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		blsGenKeyCmd(),
+	)
+	return cmd
+}
+
+type blsKeyInfo struct {
+	PrivKey string `json:"privKey"`
+	PubKey  string `json:"pubKey"`
+	Pop     string `json:"pop"`
+	Addr    string `json:"addr"`
+}
+
+// blsGenKeyCmd generate bls key
+func blsGenKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genkey",
+		Short: "generate bls key pair with proof of possession",
+		Run:   blsGenKey,
+	}
+	cmd.Flags().StringP("key", "k", "", "derive from existing bls private key(hex), generate new key if empty")
+	return cmd
+}
+
+func blsGenKey(cmd *cobra.Command, args []string) {
+	key, _ := cmd.Flags().GetString("key")
+
+	driver := bls.Driver{}
+	var priv bls.PrivKeyBLS
+	if key == "" {
+		k, err := driver.GenKey()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrap(err, "GenKey"))
+			return
+		}
+		priv = k.(bls.PrivKeyBLS)
+	} else {
+		keyBytes, err := common.FromHex(key)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrap(err, "keyFromHex"))
+			return
+		}
+		k, err := driver.PrivKeyFromBytes(keyBytes)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, errors.Wrap(err, "PrivKeyFromBytes"))
+			return
+		}
+		priv = k.(bls.PrivKeyBLS)
+	}
+
+	pub := priv.PubKey().Bytes()
+	info := &blsKeyInfo{
+		PrivKey: common.ToHex(priv.Bytes()),
+		PubKey:  common.ToHex(pub),
+		Pop:     common.ToHex(priv.PopProve().Bytes()),
+		Addr:    address.PubKeyToAddr(address.DefaultID, pub),
+	}
+	data, err := json.MarshalIndent(info, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
@@ -5,7 +5,9 @@ import (
 	"os"
 
 	"github.com/33cn/chain33/rpc/jsonclient"
+	"github.com/33cn/chain33/system/crypto/btcscript"
 	"github.com/33cn/chain33/types"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +22,9 @@ func BtcScriptCmd() *cobra.Command {
 	cmd.AddCommand(
 		getWalletRecoveryAddrCmd(),
 		signWalletRecoveryTxCmd(),
+		getHTLCAddrCmd(),
+		signHTLCClaimTxCmd(),
+		signHTLCRefundTxCmd(),
 	)
 
 	return cmd
@@ -144,3 +149,153 @@ func signWalletRecoveryTx(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcAddr, "Chain33.SignWalletRecoverTx", req, &res)
 	ctx.RunWithoutMarshal()
 }
+
+// getHTLCAddrCmd get hash time lock contract address
+func getHTLCAddrCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlcaddr",
+		Short: "get hash time lock contract address",
+		Run:   getHTLCAddr,
+	}
+	getHTLCFlags(cmd)
+	return cmd
+}
+
+func getHTLCFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hashlock", "s", "", "sha256 hash of secret preimage(hex format)")
+	cmd.MarkFlagRequired("hashlock")
+
+	cmd.Flags().StringP("receiverPub", "r", "", "receiver address public key(hex format)")
+	cmd.MarkFlagRequired("receiverPub")
+
+	cmd.Flags().StringP("refundPub", "f", "", "refund address public key(hex format)")
+	cmd.MarkFlagRequired("refundPub")
+
+	cmd.Flags().Int64P("locktime", "t", 0, "refund lock time, block height(after fork ForkBtcScriptHeightLock) if less than 500000000, otherwise block time(unix seconds)")
+	cmd.MarkFlagRequired("locktime")
+}
+
+func getHTLCParam(cmd *cobra.Command) *types.ReqGetHTLCAddr {
+	hashLock, _ := cmd.Flags().GetString("hashlock")
+	receiverPub, _ := cmd.Flags().GetString("receiverPub")
+	refundPub, _ := cmd.Flags().GetString("refundPub")
+	lockTime, _ := cmd.Flags().GetInt64("locktime")
+
+	if hashLock == "" || receiverPub == "" || refundPub == "" {
+		fmt.Fprintf(os.Stderr, "invalid hash lock or receiver/refund pubKey\n")
+		return nil
+	}
+
+	if lockTime < 1 {
+		fmt.Fprintf(os.Stderr, "invalid lock time param\n")
+		return nil
+	}
+	// 区块高度类型的锁定时间需要在分叉之后才能使用
+	if lockTime < txscript.LockTimeThreshold {
+		title, _ := cmd.Flags().GetString("title")
+		rpcAddr, _ := cmd.Flags().GetString("rpc_laddr")
+		rpc, err := jsonclient.NewJSONClient(rpcAddr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return nil
+		}
+		header, err := getLastBlock(rpc)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return nil
+		}
+		err = btcscript.CheckLockTimeFork(types.GetCliSysParam(title), header.Height+1, lockTime)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "block height lock time is not enabled, use block time(unix seconds) instead\n")
+			return nil
+		}
+	}
+	return &types.ReqGetHTLCAddr{
+		HashLock:       hashLock,
+		ReceiverPubKey: receiverPub,
+		RefundPubKey:   refundPub,
+		LockTime:       lockTime,
+	}
+}
+
+func getHTLCAddr(cmd *cobra.Command, args []string) {
+	req := getHTLCParam(cmd)
+	if req == nil {
+		return
+	}
+	var res string
+	rpcAddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcAddr, "Chain33.GetHTLCAddress", req, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// signHTLCClaimTxCmd sign htlc claim tx with secret preimage
+func signHTLCClaimTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlcclaim",
+		Short: "sign htlc claim tx with secret preimage",
+		Run:   signHTLCTx,
+	}
+	signHTLCTxFlags(cmd)
+	cmd.Flags().StringP("preimage", "p", "", "secret preimage(hex format, 32 bytes)")
+	cmd.MarkFlagRequired("preimage")
+	return cmd
+}
+
+// signHTLCRefundTxCmd sign htlc refund tx after lock time
+func signHTLCRefundTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "htlcrefund",
+		Short: "sign htlc refund tx after lock time",
+		Run:   signHTLCTx,
+	}
+	signHTLCTxFlags(cmd)
+	return cmd
+}
+
+func signHTLCTxFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("privkey", "k", "", "sign private key(hex format)")
+	cmd.Flags().StringP("addr", "a", "", "sign address(must exist in wallet)")
+
+	cmd.Flags().StringP("txdata", "d", "", "tx data for sign(hex format)")
+	cmd.MarkFlagRequired("txdata")
+
+	getHTLCFlags(cmd)
+}
+
+func signHTLCTx(cmd *cobra.Command, args []string) {
+
+	privKey, _ := cmd.Flags().GetString("privkey")
+	addr, _ := cmd.Flags().GetString("addr")
+	txdata, _ := cmd.Flags().GetString("txdata")
+	// 仅htlcclaim命令设置preimage
+	preimage, _ := cmd.Flags().GetString("preimage")
+
+	if privKey == "" && addr == "" {
+		fmt.Fprintf(os.Stderr, "sign private key or address must be provided\n")
+		return
+	}
+	if cmd.Flags().Lookup("preimage") != nil && preimage == "" {
+		fmt.Fprintf(os.Stderr, "secret preimage must be provided\n")
+		return
+	}
+
+	htlc := getHTLCParam(cmd)
+	if htlc == nil {
+		return
+	}
+
+	req := &types.ReqSignHTLCTx{
+		SignAddr:  addr,
+		PrivKey:   privKey,
+		RawTx:     txdata,
+		HtlcParam: htlc,
+		Preimage:  preimage,
+	}
+
+	var res string
+	rpcAddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcAddr, "Chain33.SignHTLCTx", req, &res)
+	ctx.RunWithoutMarshal()
+}
@@ -321,7 +321,8 @@ func addSignRawTxFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
 	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional), auto set proper fee if not set or zero fee")
 	cmd.Flags().StringP("to", "t", "", "new to addr (optional)")
-	cmd.Flags().Int32P("addressType", "p", -1, "address type ID, btc(0), btcMultiSign(1), eth(2)")
+	cmd.Flags().Int32P("addressType", "p", -1, "address type ID, btc(0), btcMultiSign(1), eth(2), taproot(3)")
+	cmd.Flags().StringP("signType", "s", "", "sign type name, such as secp256k1, schnorr, use wallet sign type if not set")
 	// A duration string is a possibly signed sequence of
 	// decimal numbers, each with optional fraction and a unit suffix,
 	// such as "300ms", "-1.5h" or "2h45m".
@@ -411,6 +412,7 @@ func signRawTx(cmd *cobra.Command, args []string) {
 	fee, _ := cmd.Flags().GetFloat64("fee")
 	expire, _ := cmd.Flags().GetString("expire")
 	addressType, _ := cmd.Flags().GetInt32("addressType")
+	signType, _ := cmd.Flags().GetString("signType")
 	expire, err := commandtypes.CheckExpireOpt(expire)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -437,6 +439,7 @@ func signRawTx(cmd *cobra.Command, args []string) {
 		Fee:       feeInt64,
 		NewToAddr: to,
 		AddressID: addressType,
+		SignType:  signType,
 	}
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SignRawTx", &params, nil)
 	ctx.RunWithoutMarshal()
@@ -77,6 +77,9 @@ type Driver interface {
 	CheckReceiptExecOk() bool
 	ExecutorOrder() int64
 	Upgrade() (*types.LocalDBSet, error)
+	//交易追踪上下文, 非追踪执行时为nil
+	SetTraceContext(*TraceContext)
+	GetTraceContext() *TraceContext
 }
 
 // DriverBase defines driverbase type
@@ -99,6 +102,7 @@ type DriverBase struct {
 	txs                  []*types.Transaction
 	receipts             []*types.ReceiptData
 	ety                  types.ExecutorType
+	traceCtx             *TraceContext
 }
 
 //Upgrade default upgrade only print a message
@@ -404,6 +408,16 @@ func (d *DriverBase) SetReceipt(receipts []*types.ReceiptData) {
 	d.receipts = receipts
 }
 
+// SetTraceContext set trace context
+func (d *DriverBase) SetTraceContext(ctx *TraceContext) {
+	d.traceCtx = ctx
+}
+
+// GetTraceContext return trace context, nil if not tracing
+func (d *DriverBase) GetTraceContext() *TraceContext {
+	return d.traceCtx
+}
+
 // GetStateDB set statedb
 func (d *DriverBase) GetStateDB() dbm.KV {
 	return d.statedb
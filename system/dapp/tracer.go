@@ -0,0 +1,55 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dapp
+
+import (
+	"sync"
+)
+
+// Tracer 交易执行追踪接口
+// 执行器在追踪执行时通过GetTraceContext获取tracer, 记录调用帧等执行细节, 如evm合约调用
+type Tracer interface {
+	//Result 返回追踪结果, json格式
+	Result() ([]byte, error)
+}
+
+// TracerCreate 创建tracer, name为追踪请求中指定的tracer名称
+type TracerCreate func(name string) (Tracer, error)
+
+// TraceContext 交易追踪上下文, 只在debug追踪执行期间设置
+type TraceContext struct {
+	Tracer Tracer
+	//预执行调用的发起地址, 执行器可以用来代替交易的签名地址
+	From string
+}
+
+var (
+	tracerLock     sync.RWMutex
+	tracerCreators = make(map[string]TracerCreate)
+)
+
+// RegisterTracer 注册执行器的tracer, name为执行器驱动名称
+func RegisterTracer(name string, create TracerCreate) {
+	if create == nil {
+		panic("Execute: RegisterTracer create is nil")
+	}
+	tracerLock.Lock()
+	defer tracerLock.Unlock()
+	if _, dup := tracerCreators[name]; dup {
+		panic("Execute: RegisterTracer called twice for driver " + name)
+	}
+	tracerCreators[name] = create
+}
+
+// NewTracer 根据执行器驱动名称创建tracer, 执行器没有注册tracer时返回nil
+func NewTracer(driverName, name string) (Tracer, error) {
+	tracerLock.RLock()
+	create, ok := tracerCreators[driverName]
+	tracerLock.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+	return create(name)
+}
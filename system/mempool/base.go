@@ -7,6 +7,7 @@ package mempool
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -40,6 +41,9 @@ type Mempool struct {
 	cache             *txCache
 	delayTxListChan   chan []*types.Transaction
 	currHeight        int64
+	journal           *txJournal
+	queue             *ethTxQueue
+	status            *txStatusCache
 }
 
 func (mem *Mempool) setAPI(api client.QueueProtocolAPI) {
@@ -73,6 +77,24 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.MaxNonceGap == 0 {
+		cfg.MaxNonceGap = maxNonceGap
+	}
+	if cfg.MaxQueuedTxPerAccount == 0 {
+		cfg.MaxQueuedTxPerAccount = maxQueuedTxPerAccount
+	}
+	if cfg.MaxQueuedTxs == 0 {
+		cfg.MaxQueuedTxs = maxQueuedTxs
+	}
+	if cfg.QueuedTxExpire == 0 {
+		cfg.QueuedTxExpire = queuedTxExpire
+	}
+	if cfg.PriceBump == 0 {
+		cfg.PriceBump = priceBump
+	}
+	if cfg.TxStatusCacheSize == 0 {
+		cfg.TxStatusCacheSize = txStatusCacheSize
+	}
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -81,6 +103,13 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast, cfg.PoolCacheSize)
 	pool.delayTxListChan = make(chan []*types.Transaction, 16)
+	pool.queue = newEthTxQueue(cfg.MaxQueuedTxPerAccount, cfg.MaxQueuedTxs, cfg.QueuedTxExpire)
+	pool.status = newTxStatusCache(cfg.TxStatusCacheSize)
+	pool.cache.status = pool.status
+	if cfg.EnableJournal {
+		pool.journal = newTxJournal(cfg)
+		pool.cache.journal = pool.journal
+	}
 	return pool
 }
 
@@ -97,12 +126,16 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	if mem.journal != nil {
+		mem.journal.close()
+	}
 	mlog.Info("mempool module closed")
 }
 
 //SetQueueClient 初始化mempool模块
 func (mem *Mempool) SetQueueClient(cli queue.Client) {
 	mem.client = cli
+	mem.status.client = cli
 	mem.client.Sub("mempool")
 	api, err := client.New(cli, nil)
 	if err != nil {
@@ -119,6 +152,10 @@ func (mem *Mempool) SetQueueClient(cli queue.Client) {
 	mem.wg.Add(1)
 	go mem.eventProcess()
 	go mem.pushDelayTxRoutine()
+	if mem.journal != nil {
+		mem.wg.Add(1)
+		go mem.rotateJournal()
+	}
 }
 
 // Size 返回mempool中txCache大小
@@ -211,7 +248,9 @@ func (mem *Mempool) sortEthSignTyTx(txs []*types.Transaction) []*types.Transacti
 			return etxs[i].GetNonce() < etxs[j].GetNonce()
 		})
 		//check exts[0].Nonce 是否等于current nonce, merge
-		if len(etxs) != 0 && mem.getCurrentNonce(from) == etxs[0].GetNonce() {
+		//查询失败时按nonce为0处理, 与之前的行为一致
+		nonce, _ := mem.getCurrentNonce(from)
+		if len(etxs) != 0 && nonce == etxs[0].GetNonce() {
 			merge = append(merge, etxs[0])
 			for i, etx := range etxs {
 				if i == 0 {
@@ -230,20 +269,26 @@ func (mem *Mempool) sortEthSignTyTx(txs []*types.Transaction) []*types.Transacti
 	return merge
 }
 
-func (mem *Mempool) getCurrentNonce(addr string) int64 {
+//getCurrentNonce 获取账户在状态数据中的nonce, 查询超时或者没有加载evm模块时返回错误
+func (mem *Mempool) getCurrentNonce(addr string) (int64, error) {
 	msg := mem.client.NewMessage("rpc", types.EventGetEvmNonce, &types.ReqEvmAccountNonce{
 		Addr: addr,
 	})
-	mem.client.Send(msg, true)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		return 0, err
+	}
 	reply, err := mem.client.WaitTimeout(msg, time.Second*2)
-	if err == nil {
-		nonceInfo, ok := reply.GetData().(*types.EvmAccountNonce)
-		if ok {
-			return nonceInfo.GetNonce()
-		}
+	if err != nil {
+		return 0, err
 	}
-	return 0
-
+	switch data := reply.GetData().(type) {
+	case *types.EvmAccountNonce:
+		return data.GetNonce(), nil
+	case *types.Reply:
+		return 0, errors.New(string(data.GetMsg()))
+	}
+	return 0, types.ErrInvalidParam
 }
 
 // RemoveTxs 从mempool中删除给定Hash的txs
@@ -266,6 +311,9 @@ func (mem *Mempool) removeTxs(hashes [][]byte) {
 
 // PushTx 将交易推入mempool，并返回结果（error）
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
+	if mem.isEthNonceTx(tx) {
+		return mem.pushEthTx(tx)
+	}
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 	err := mem.cache.Push(tx)
@@ -353,6 +401,9 @@ func (mem *Mempool) pollLastHeader() {
 		}
 		h := lastHeader.(*queue.Message).Data.(*types.Header)
 		mem.setHeader(h)
+		if mem.journal != nil {
+			mem.loadJournal()
+		}
 		return
 	}
 }
@@ -363,6 +414,7 @@ func (mem *Mempool) removeExpired() {
 	types.AssertConfig(mem.client)
 	//mempool的header是当前高度，而交易将被下一个区块打包，过期判定采用下一个区块的高度和时间
 	mem.cache.removeExpiredTx(mem.client.GetConfig(), mem.header.GetHeight()+1, mem.header.GetBlockTime())
+	mem.removeExpiredQueued()
 }
 
 // removeBlockedTxs 每隔1分钟清理一次已打包的交易
@@ -396,6 +448,7 @@ func (mem *Mempool) RemoveTxsOfBlock(block *types.Block) bool {
 			mem.cache.Remove(string(hash))
 		}
 	}
+	mem.promoteQueuedOfBlock(block)
 	return true
 }
 func (mem *Mempool) getCacheFeeRate() int64 {
@@ -424,6 +477,10 @@ func (mem *Mempool) GetProperFeeRate(req *types.ReqProperFee) int64 {
 		req.TxSize = 10240
 	}
 	feeRate := mem.getCacheFeeRate()
+	//优先级队列根据队列中交易的手续费率估算
+	if _, ok := mem.cache.qcache.(PriorityQueue); ok {
+		return feeRate
+	}
 	if mem.cfg.IsLevelFee {
 		levelFeeRate := mem.getLevelFeeRate(mem.cfg.MinTxFeeRate, req.TxCount, req.TxSize)
 		if levelFeeRate > feeRate {
@@ -482,6 +539,7 @@ func (mem *Mempool) delBlock(block *types.Block) {
 		if !mem.checkExpireValid(tx) {
 			continue
 		}
+		mem.status.record(tx.Hash(), types.TxStatusReorged, "", block.Height)
 		err = mem.PushTx(tx)
 		if err != nil {
 			mlog.Error("mem", "push tx err", err)
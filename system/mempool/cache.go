@@ -7,6 +7,7 @@ package mempool
 import (
 	"sync"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 )
 
@@ -22,6 +23,14 @@ type QueueCache interface {
 	GetCacheBytes() int64
 }
 
+//PriorityQueue 按优先级排队的策略, 队列已满时可以淘汰优先级最低的交易,
+//手续费率由队列中的交易统计得到, 不再使用阶梯手续费估算
+type PriorityQueue interface {
+	QueueCache
+	//GetEvictItem 队列已满时返回可以被tx替换的优先级最低的交易, 不能替换返回nil
+	GetEvictItem(tx *Item) *Item
+}
+
 // Item 为Mempool中包装交易的数据结构
 type Item struct {
 	Value     *types.Transaction
@@ -37,6 +46,8 @@ type txCache struct {
 	totalFee int64
 	*SHashTxCache
 	delayCache *delayTxCache
+	journal    *txJournal
+	status     *txStatusCache
 }
 
 //NewTxCache init accountIndex and last cache
@@ -69,6 +80,10 @@ func (cache *txCache) Remove(hash string) {
 	cache.LastTxCache.Remove(hash)
 	cache.totalFee -= tx.Fee
 	cache.SHashTxCache.Remove(hash)
+	if cache.journal != nil {
+		cache.journal.remove([]byte(hash))
+	}
+	cache.updateSizeMetrics()
 }
 
 //Exist 是否存在
@@ -115,6 +130,9 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
 	txHash := tx.Hash()
 	err := cache.qcache.Push(item)
+	if err == types.ErrMemFull {
+		err = cache.evictPush(item)
+	}
 	if err != nil {
 		return err
 	}
@@ -125,9 +143,34 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	cache.LastTxCache.Push(tx, string(txHash))
 	cache.totalFee += tx.Fee
 	cache.SHashTxCache.Push(tx, txHash)
+	if cache.journal != nil {
+		cache.journal.insert(tx)
+	}
+	if cache.status != nil {
+		cache.status.record(txHash, types.TxStatusPending, "", 0)
+	}
+	cache.updateSizeMetrics()
 	return nil
 }
 
+//evictPush 队列已满时淘汰优先级最低的交易, 同时清理账户索引等缓存
+func (cache *txCache) evictPush(item *Item) error {
+	pq, ok := cache.qcache.(PriorityQueue)
+	if !ok {
+		return types.ErrMemFull
+	}
+	evict := pq.GetEvictItem(item)
+	if evict == nil {
+		return types.ErrMemFull
+	}
+	mlog.Debug("evictPush", "evictTx", common.ToHex(evict.Value.Hash()), "fee", evict.Value.Fee)
+	cache.Remove(string(evict.Value.Hash()))
+	if cache.status != nil {
+		cache.status.record(evict.Value.Hash(), types.TxStatusEvicted, types.ErrMemFull.Error(), 0)
+	}
+	return cache.qcache.Push(item)
+}
+
 func (cache *txCache) removeExpiredTx(cfg *types.Chain33Config, height, blocktime int64) {
 	var txs []string
 	cache.qcache.Walk(0, func(tx *Item) bool {
@@ -139,6 +182,11 @@ func (cache *txCache) removeExpiredTx(cfg *types.Chain33Config, height, blocktim
 	if len(txs) > 0 {
 		mlog.Info("removeExpiredTx", "height", height, "totalTxs", cache.Size(), "expiredTxs", len(txs))
 		cache.RemoveTxs(txs)
+		if cache.status != nil {
+			for _, hash := range txs {
+				cache.status.record([]byte(hash), types.TxStatusExpired, types.ErrTxExpire.Error(), 0)
+			}
+		}
 	}
 }
 
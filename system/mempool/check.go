@@ -133,6 +133,73 @@ func (mem *Mempool) checkTxs(msg *queue.Message) *queue.Message {
 	return msg
 }
 
+//maxSignBatchNum 批量验签时单批最多包含的交易数
+const maxSignBatchNum = 256
+
+//batchCheckSign 收集输入通道中已经到达的交易批量验签, 不等待凑满批次, 避免增加交易延迟
+func (mem *Mempool) batchCheckSign(done <-chan struct{}, in <-chan *queue.Message) <-chan *queue.Message {
+	out := make(chan *queue.Message)
+	go func() {
+		defer close(out)
+		for msg := range in {
+			batch := []*queue.Message{msg}
+		collect:
+			for len(batch) < maxSignBatchNum {
+				select {
+				case m, ok := <-in:
+					if !ok {
+						break collect
+					}
+					batch = append(batch, m)
+				default:
+					break collect
+				}
+			}
+			mem.checkTxsSign(batch)
+			for _, m := range batch {
+				select {
+				case out <- m:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+//checkTxsSign 批量验证交易签名, 验签失败的消息设置为ErrSign
+func (mem *Mempool) checkTxsSign(msgs []*queue.Message) {
+	var groups []types.TxGroup
+	var checked []*queue.Message
+	for _, msg := range msgs {
+		if msg.Err() != nil {
+			continue
+		}
+		tx, ok := msg.GetData().(types.TxGroup)
+		if !ok {
+			msg.Data = types.ErrSign
+			continue
+		}
+		groups = append(groups, tx)
+		checked = append(checked, msg)
+	}
+	if len(groups) == 0 {
+		return
+	}
+	results := types.CheckTxGroupsSign(groups, atomic.LoadInt64(&mem.currHeight)+1)
+	for i, ok := range results {
+		if ok {
+			continue
+		}
+		mlog.Error("wrong tx", "err", types.ErrSign)
+		mem.checkStep(checked[i], func(msg *queue.Message) *queue.Message {
+			msg.Data = types.ErrSign
+			return msg
+		})
+	}
+}
+
 // checkLevelFee 检查阶梯手续费
 func (mem *Mempool) checkLevelFee(tx *types.TransactionCache) error {
 	//获取mempool里所有交易手续费总和
@@ -14,6 +14,15 @@ var (
 	mempoolExpiredInterval int64 = 600   // mempool内交易过期时间，10分钟
 	maxTxNumPerAccount     int64 = 100   // TODO 每个账户在mempool中最大交易数量，10
 	maxTxLast              int64 = 10
+	journalPath                  = "datadir/mempool" // 交易日志默认存储路径
+	journalDriver                = "leveldb"
+	journalRotate          int64 = 3600  // 交易日志重写周期，1小时
+	maxNonceGap            int64 = 64    // eth交易允许超前当前nonce的最大距离
+	maxQueuedTxPerAccount  int64 = 16    // 每个账户等待前序nonce的eth交易最大数量
+	maxQueuedTxs           int64 = 1024  // 等待前序nonce的eth交易总数量
+	queuedTxExpire         int64 = 600   // 等待前序nonce的eth交易过期时间，10分钟
+	priceBump              int64 = 10    // 替换相同nonce的eth交易需要提高的手续费百分比
+	txStatusCacheSize      int64 = 10240 // 记录最近交易状态变化的交易数量
 	processNum             int
 )
 
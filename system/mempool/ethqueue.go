@@ -0,0 +1,339 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+)
+
+//ethTxQueue 等待前序nonce的eth签名交易, 按发送地址和nonce索引
+//前序nonce的交易进入mempool或者被打包后, 连续nonce的交易被提升到mempool中
+type ethTxQueue struct {
+	maxPerAccount int
+	maxSize       int
+	expire        int64
+	accounts      map[string]map[int64]*Item
+	hashes        map[string]*Item
+}
+
+func newEthTxQueue(maxPerAccount, maxSize, expire int64) *ethTxQueue {
+	return &ethTxQueue{
+		maxPerAccount: int(maxPerAccount),
+		maxSize:       int(maxSize),
+		expire:        expire,
+		accounts:      make(map[string]map[int64]*Item),
+		hashes:        make(map[string]*Item),
+	}
+}
+
+//Size 等待中的交易数量
+func (q *ethTxQueue) Size() int {
+	return len(q.hashes)
+}
+
+//Exist 交易是否在等待队列中
+func (q *ethTxQueue) Exist(hash string) bool {
+	_, ok := q.hashes[hash]
+	return ok
+}
+
+//Push 加入等待队列, 同一账户相同nonce的交易只保留一笔
+func (q *ethTxQueue) Push(tx *types.Transaction) error {
+	hash := string(tx.Hash())
+	if q.Exist(hash) {
+		return types.ErrTxExist
+	}
+	from := tx.From()
+	acc := q.accounts[from]
+	if _, ok := acc[tx.GetNonce()]; ok {
+		return types.ErrDupNonce
+	}
+	if len(acc) >= q.maxPerAccount {
+		return types.ErrManyTx
+	}
+	if q.Size() >= q.maxSize {
+		return types.ErrMemFull
+	}
+	q.add(from, &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()})
+	return nil
+}
+
+func (q *ethTxQueue) add(from string, item *Item) {
+	acc := q.accounts[from]
+	if acc == nil {
+		acc = make(map[int64]*Item)
+		q.accounts[from] = acc
+	}
+	acc[item.Value.GetNonce()] = item
+	q.hashes[string(item.Value.Hash())] = item
+	queuedSizeGauge.Update(int64(q.Size()))
+}
+
+//Pop 取出账户指定nonce的交易
+func (q *ethTxQueue) Pop(from string, nonce int64) *Item {
+	item, ok := q.accounts[from][nonce]
+	if !ok {
+		return nil
+	}
+	q.remove(from, nonce, item)
+	return item
+}
+
+func (q *ethTxQueue) remove(from string, nonce int64, item *Item) {
+	delete(q.hashes, string(item.Value.Hash()))
+	delete(q.accounts[from], nonce)
+	if len(q.accounts[from]) == 0 {
+		delete(q.accounts, from)
+	}
+	queuedSizeGauge.Update(int64(q.Size()))
+}
+
+//RemoveStale 删除账户nonce小于给定值的交易, 这些nonce已经被使用
+func (q *ethTxQueue) RemoveStale(from string, nonce int64) (removed []*Item) {
+	for n, item := range q.accounts[from] {
+		if n < nonce {
+			q.remove(from, n, item)
+			removed = append(removed, item)
+		}
+	}
+	return removed
+}
+
+//RemoveExpired 删除等待超时的交易
+func (q *ethTxQueue) RemoveExpired(now int64) (removed []*Item) {
+	for from, acc := range q.accounts {
+		for n, item := range acc {
+			if now-item.EnterTime >= q.expire {
+				q.remove(from, n, item)
+				removed = append(removed, item)
+			}
+		}
+	}
+	return removed
+}
+
+//Txs 返回等待中的全部交易, 同一账户按nonce排序
+func (q *ethTxQueue) Txs() []*types.Transaction {
+	var froms []string
+	for from := range q.accounts {
+		froms = append(froms, from)
+	}
+	sort.Strings(froms)
+	txs := make([]*types.Transaction, 0, q.Size())
+	for _, from := range froms {
+		var nonces []int64
+		for n := range q.accounts[from] {
+			nonces = append(nonces, n)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+		for _, n := range nonces {
+			txs = append(txs, q.accounts[from][n].Value)
+		}
+	}
+	return txs
+}
+
+//nextNonce 从start开始, 返回账户在mempool中连续nonce之后的下一个nonce
+func (cache *txCache) nextNonce(from string, start int64) int64 {
+	lm, ok := cache.AccountTxIndex.accMap[from]
+	if !ok {
+		return start
+	}
+	nonces := make(map[int64]bool, lm.Size())
+	lm.Walk(func(val interface{}) bool {
+		nonces[val.(*types.Transaction).GetNonce()] = true
+		return true
+	})
+	for nonces[start] {
+		start++
+	}
+	return start
+}
+
+//isEthNonceTx 需要按照nonce顺序进入mempool的交易, 即开启eth检查时的非平行链eth签名交易
+func (mem *Mempool) isEthNonceTx(tx *types.Transaction) bool {
+	return mem.cfg.EnableEthCheck && tx.GetGroupCount() == 0 && types.IsEthSignID(tx.GetSignature().GetTy()) &&
+		!bytes.HasPrefix(tx.GetExecer(), []byte(types.ParaKeyX))
+}
+
+//getTxByNonce 获取账户在mempool中指定nonce的交易
+func (cache *txCache) getTxByNonce(from string, nonce int64) *types.Transaction {
+	lm, ok := cache.AccountTxIndex.accMap[from]
+	if !ok {
+		return nil
+	}
+	var found *types.Transaction
+	lm.Walk(func(val interface{}) bool {
+		if tx := val.(*types.Transaction); tx.GetNonce() == nonce {
+			found = tx
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+//pushEthTx nonce连续的交易直接进入mempool, 并提升等待队列中后续nonce的交易,
+//超前的交易进入等待队列
+func (mem *Mempool) pushEthTx(tx *types.Transaction) error {
+	from := tx.From()
+	stateNonce, err := mem.getCurrentNonce(from)
+	if err != nil {
+		//无法获取账户nonce时不做nonce间隔检查, 交易直接进入mempool
+		mlog.Debug("pushEthTx getCurrentNonce", "from", from, "err", err)
+		stateNonce = tx.GetNonce()
+	} else if tx.GetNonce() > stateNonce+mem.cfg.MaxNonceGap {
+		return types.ErrNonceTooHigh
+	}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	if mem.queue.Exist(string(tx.Hash())) || mem.cache.Exist(string(tx.Hash())) {
+		return types.ErrTxExist
+	}
+	if item := mem.queue.accounts[from][tx.GetNonce()]; item != nil {
+		if err := mem.checkReplace(item.Value, tx); err != nil {
+			return err
+		}
+		mem.queue.remove(from, tx.GetNonce(), item)
+		err := mem.queue.Push(tx)
+		if err != nil {
+			mem.queue.Push(item.Value)
+			return err
+		}
+		mem.replaced(item.Value, tx)
+		if mem.journal != nil {
+			mem.journal.insert(tx)
+		}
+		mem.status.record(tx.Hash(), types.TxStatusQueued, "", 0)
+		return nil
+	}
+	if old := mem.cache.getTxByNonce(from, tx.GetNonce()); old != nil {
+		if err := mem.checkReplace(old, tx); err != nil {
+			return err
+		}
+		mem.cache.Remove(string(old.Hash()))
+		if err := mem.cache.Push(tx); err != nil {
+			mem.cache.Push(old)
+			return err
+		}
+		mem.replaced(old, tx)
+		return nil
+	}
+	next := mem.cache.nextNonce(from, stateNonce)
+	if tx.GetNonce() > next {
+		err := mem.queue.Push(tx)
+		if err != nil {
+			return err
+		}
+		if mem.journal != nil {
+			mem.journal.insert(tx)
+		}
+		mem.status.record(tx.Hash(), types.TxStatusQueued, "", 0)
+		return nil
+	}
+	err = mem.cache.Push(tx)
+	if err != nil {
+		return err
+	}
+	mem.promoteQueued(from, next)
+	return nil
+}
+
+//checkReplace 相同nonce的交易手续费需要比原交易高出PriceBump百分比才能替换原交易,
+//通过发送给自己的零金额交易替换可以实现取消交易
+func (mem *Mempool) checkReplace(old, tx *types.Transaction) error {
+	if tx.Fee*100 < old.Fee*(100+mem.cfg.PriceBump) {
+		return types.ErrReplaceUnderpriced
+	}
+	return nil
+}
+
+func (mem *Mempool) replaced(old, tx *types.Transaction) {
+	replacedCounter.Inc(1)
+	mem.status.record(old.Hash(), types.TxStatusReplaced, common.ToHex(tx.Hash()), 0)
+	if mem.journal != nil {
+		mem.journal.remove(old.Hash())
+	}
+	mlog.Debug("replaceTx", "from", tx.From(), "nonce", tx.GetNonce(), "oldTx", common.ToHex(old.Hash()),
+		"oldFee", old.Fee, "newTx", common.ToHex(tx.Hash()), "newFee", tx.Fee)
+}
+
+//promoteQueued 将账户在等待队列中与mempool nonce连续的交易提升到mempool,
+//账户交易数达到上限或者mempool已满时保留在等待队列中, 等区块打包后再提升
+func (mem *Mempool) promoteQueued(from string, nonce int64) {
+	for _, item := range mem.queue.RemoveStale(from, nonce) {
+		mem.dropQueued(item, types.TxStatusEvicted, "stale nonce")
+	}
+	for int64(mem.cache.TxNumOfAccount(from)) < mem.cfg.MaxTxNumPerAccount {
+		nonce = mem.cache.nextNonce(from, nonce)
+		item := mem.queue.Pop(from, nonce)
+		if item == nil {
+			return
+		}
+		err := mem.cache.Push(item.Value)
+		if err == types.ErrMemFull || err == types.ErrManyTx {
+			mem.queue.add(from, item)
+			return
+		}
+		//交易本身无法进入mempool, 后续nonce的交易继续等待该nonce的交易重新发送
+		if err != nil {
+			mem.dropQueued(item, types.TxStatusRejected, err.Error())
+			return
+		}
+		mlog.Debug("promoteQueued", "from", from, "nonce", nonce)
+	}
+}
+
+func (mem *Mempool) dropQueued(item *Item, status int32, reason string) {
+	mlog.Debug("dropQueued", "txHash", common.ToHex(item.Value.Hash()), "nonce", item.Value.GetNonce(), "reason", reason)
+	mem.status.record(item.Value.Hash(), status, reason, 0)
+	if mem.journal != nil {
+		mem.journal.remove(item.Value.Hash())
+	}
+}
+
+//promoteQueuedOfBlock 区块打包后账户nonce增加, 提升等待队列中nonce连续的交易
+func (mem *Mempool) promoteQueuedOfBlock(block *types.Block) {
+	if mem.queue.Size() == 0 {
+		return
+	}
+	nonces := make(map[string]int64)
+	for _, tx := range block.Txs {
+		if !mem.isEthNonceTx(tx) {
+			continue
+		}
+		from := tx.From()
+		if n, ok := nonces[from]; !ok || tx.GetNonce() >= n {
+			nonces[from] = tx.GetNonce() + 1
+		}
+	}
+	for from, nonce := range nonces {
+		mem.promoteQueued(from, nonce)
+	}
+}
+
+//removeExpiredQueued 清理等待超时的交易
+func (mem *Mempool) removeExpiredQueued() {
+	for _, item := range mem.queue.RemoveExpired(types.Now().Unix()) {
+		mem.dropQueued(item, types.TxStatusExpired, types.ErrTxExpire.Error())
+	}
+}
+
+//QueuedSize 返回等待前序nonce的交易数量
+func (mem *Mempool) QueuedSize() int {
+	mem.proxyMtx.RLock()
+	defer mem.proxyMtx.RUnlock()
+	return mem.queue.Size()
+}
+
+func (mem *Mempool) queuedTxs() []*types.Transaction {
+	mem.proxyMtx.RLock()
+	defer mem.proxyMtx.RUnlock()
+	return mem.queue.Txs()
+}
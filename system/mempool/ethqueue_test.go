@@ -0,0 +1,156 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newEthNonceTx(t *testing.T, nonce, fee int64) *types.Transaction {
+	pub, err := common.FromHex("0x04715e4e07d983c2d98eeac7018bce6e68ef9de25835340f6455f1b1c9686132ac54904f5e04b07966a256140a5f487c4aef3ddc461e02d58f90cc8baa49f9c7ca")
+	require.Nil(t, err)
+	sig := &types.Signature{Ty: 8452, Pubkey: pub}
+	return &types.Transaction{ChainID: 3999, Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: fee, To: toAddr, Nonce: nonce, Signature: sig}
+}
+
+func TestEthTxQueue(t *testing.T) {
+	q := newEthTxQueue(2, 3, 10)
+	tx1, tx2, tx3 := newEthNonceTx(t, 3, 100000), newEthNonceTx(t, 5, 100000), newEthNonceTx(t, 7, 100000)
+	require.Nil(t, q.Push(tx2))
+	require.Nil(t, q.Push(tx1))
+	require.Equal(t, types.ErrTxExist, q.Push(tx1))
+	require.Equal(t, types.ErrDupNonce, q.Push(newEthNonceTx(t, 3, 200000)))
+	require.Equal(t, types.ErrManyTx, q.Push(tx3))
+	require.Equal(t, []*types.Transaction{tx1, tx2}, q.Txs())
+
+	other := &types.Transaction{Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: 100000, Nonce: 1}
+	require.Nil(t, q.Push(other))
+	require.Equal(t, types.ErrMemFull, q.Push(&types.Transaction{Execer: []byte("coins"), Payload: types.Encode(transfer), Fee: 100000, Nonce: 2}))
+
+	require.Nil(t, q.Pop(tx1.From(), 4))
+	require.Equal(t, tx1, q.Pop(tx1.From(), 3).Value)
+	removed := q.RemoveStale(tx2.From(), 6)
+	require.Equal(t, 1, len(removed))
+	require.Equal(t, tx2, removed[0].Value)
+	require.Equal(t, 1, q.Size())
+	require.Equal(t, 0, len(q.RemoveExpired(types.Now().Unix())))
+	require.Equal(t, 1, len(q.RemoveExpired(types.Now().Unix()+10)))
+	require.Equal(t, 0, q.Size())
+	require.Equal(t, 0, len(q.accounts))
+}
+
+func TestPushEthNonceTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.EnableEthCheck = true
+
+	//rpc模块返回的当前nonce为1
+	tx1, tx2, tx3 := newEthNonceTx(t, 1, 100000), newEthNonceTx(t, 2, 100000), newEthNonceTx(t, 3, 100000)
+	require.Nil(t, mem.PushTx(tx3))
+	require.Equal(t, 0, mem.Size())
+	require.Equal(t, 1, mem.QueuedSize())
+	require.Equal(t, types.ErrTxExist, mem.PushTx(tx3))
+	require.Equal(t, types.ErrReplaceUnderpriced, mem.PushTx(newEthNonceTx(t, 3, 105000)))
+	require.Equal(t, types.ErrNonceTooHigh, mem.PushTx(newEthNonceTx(t, 1+mem.cfg.MaxNonceGap+1, 100000)))
+
+	require.Nil(t, mem.PushTx(tx1))
+	require.Equal(t, 1, mem.Size())
+	require.Equal(t, 1, mem.QueuedSize())
+	//nonce连续后提升等待中的交易
+	require.Nil(t, mem.PushTx(tx2))
+	require.Equal(t, 3, mem.Size())
+	require.Equal(t, 0, mem.QueuedSize())
+
+	//区块打包后提升等待中的交易
+	tx5 := newEthNonceTx(t, 5, 100000)
+	require.Nil(t, mem.PushTx(tx5))
+	require.Equal(t, 1, mem.QueuedSize())
+	require.Equal(t, 4, len(mem.queuedTxs())+mem.Size())
+	mem.RemoveTxsOfBlock(&types.Block{Txs: []*types.Transaction{tx1, tx2, tx3, newEthNonceTx(t, 4, 100000)}})
+	require.Equal(t, 1, mem.Size())
+	require.Equal(t, 0, mem.QueuedSize())
+	require.True(t, mem.cache.Exist(string(tx5.Hash())))
+
+	//等待超时
+	require.Nil(t, mem.PushTx(newEthNonceTx(t, 10, 100000)))
+	require.Equal(t, 1, mem.QueuedSize())
+	mem.queue.expire = 0
+	mem.removeExpired()
+	require.Equal(t, 0, mem.QueuedSize())
+}
+
+func TestPushEthNonceTxUnknownNonce(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.EnableEthCheck = true
+
+	//无法获取账户nonce时不检查nonce间隔, 交易直接进入mempool
+	tx := newEthNonceTx(t, 1+mem.cfg.MaxNonceGap+1, 100000)
+	unknownNonceAddrs.Store(tx.From(), true)
+	defer unknownNonceAddrs.Delete(tx.From())
+	_, err := mem.getCurrentNonce(tx.From())
+	require.NotNil(t, err)
+	require.Nil(t, mem.PushTx(tx))
+	require.Equal(t, 1, mem.Size())
+	require.Equal(t, 0, mem.QueuedSize())
+}
+
+func TestReplaceEthNonceTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.EnableEthCheck = true
+	replaced := replacedCounter.Count()
+
+	//替换mempool中的交易
+	tx1 := newEthNonceTx(t, 1, 100000)
+	require.Nil(t, mem.PushTx(tx1))
+	require.Equal(t, types.ErrReplaceUnderpriced, mem.PushTx(newEthNonceTx(t, 1, 109999)))
+	tx2 := newEthNonceTx(t, 1, 110000)
+	require.Nil(t, mem.PushTx(tx2))
+	require.Equal(t, 1, mem.Size())
+	require.False(t, mem.cache.Exist(string(tx1.Hash())))
+	require.True(t, mem.cache.Exist(string(tx2.Hash())))
+	require.Nil(t, mem.cache.GetSHashTxCache(types.CalcTxShortHash(tx1.Hash())))
+	require.Equal(t, tx2, mem.cache.GetSHashTxCache(types.CalcTxShortHash(tx2.Hash())))
+	require.Equal(t, int64(1), mem.TxNumOfAccount(tx2.From()))
+	require.Equal(t, tx2, mem.GetAccTxs(&types.ReqAddrs{Addrs: []string{tx2.From()}}).Txs[0].Tx)
+
+	//替换等待队列中的交易
+	tx3 := newEthNonceTx(t, 5, 100000)
+	require.Nil(t, mem.PushTx(tx3))
+	tx4 := newEthNonceTx(t, 5, 200000)
+	require.Nil(t, mem.PushTx(tx4))
+	require.Equal(t, []*types.Transaction{tx4}, mem.queuedTxs())
+	require.Equal(t, replaced+2, replacedCounter.Count())
+}
+
+func TestPromoteQueuedAccountLimit(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.EnableEthCheck = true
+	mem.cfg.MaxTxNumPerAccount = 2
+
+	//账户交易数达到上限后保留在等待队列中
+	tx1, tx2, tx3 := newEthNonceTx(t, 1, 100000), newEthNonceTx(t, 2, 100000), newEthNonceTx(t, 3, 100000)
+	require.Nil(t, mem.PushTx(tx3))
+	require.Nil(t, mem.PushTx(tx2))
+	require.Nil(t, mem.PushTx(tx1))
+	require.Equal(t, 2, mem.Size())
+	require.Equal(t, []*types.Transaction{tx3}, mem.queuedTxs())
+
+	//区块打包后继续提升
+	mem.RemoveTxsOfBlock(&types.Block{Txs: []*types.Transaction{tx1}})
+	require.Equal(t, 2, mem.Size())
+	require.Equal(t, 0, mem.QueuedSize())
+	require.True(t, mem.cache.Exist(string(tx3.Hash())))
+}
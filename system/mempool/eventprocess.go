@@ -2,7 +2,6 @@ package mempool
 
 import (
 	"strings"
-	"sync/atomic"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/queue"
@@ -15,6 +14,7 @@ func (mem *Mempool) reply() {
 	defer mem.wg.Done()
 	for m := range mem.out {
 		if m.Err() != nil {
+			markReject(m.Err())
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 				&types.Reply{IsOk: false, Msg: []byte(m.Err().Error())}))
 		} else {
@@ -27,24 +27,14 @@ func (mem *Mempool) reply() {
 func (mem *Mempool) pipeLine() <-chan *queue.Message {
 
 	//check sign
-	step1 := func(data *queue.Message) *queue.Message {
-		if data.Err() != nil {
-			return data
-		}
-		return mem.checkSign(data)
-	}
-	chs := make([]<-chan *queue.Message, processNum)
-	for i := 0; i < processNum; i++ {
-		chs[i] = step(mem.done, mem.in, step1)
-	}
-	out1 := merge(mem.done, chs)
+	out1 := mem.batchCheckSign(mem.done, mem.in)
 
 	//checktx remote
 	step2 := func(data *queue.Message) *queue.Message {
 		if data.Err() != nil {
 			return data
 		}
-		return mem.checkTxRemote(data)
+		return mem.checkStep(data, mem.checkTxRemote)
 	}
 	chs2 := make([]<-chan *queue.Message, processNum)
 	for i := 0; i < processNum; i++ {
@@ -106,6 +96,10 @@ func (mem *Mempool) eventProcess() {
 			mem.eventCheckTxsExist(msg)
 		case types.EventAddDelayTx:
 			mem.eventAddDelayTx(msg)
+		case types.EventGetTxStatus:
+			mem.eventGetTxStatus(msg)
+		case types.EventSubTxStatus:
+			mem.eventSubTxStatus(msg)
 
 		default:
 		}
@@ -117,9 +111,14 @@ func (mem *Mempool) eventProcess() {
 func (mem *Mempool) eventTx(msg *queue.Message) {
 	if !mem.getSync() {
 		msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrNotSync.Error())}))
+		markReject(types.ErrNotSync)
 		mlog.Debug("wrong tx", "err", types.ErrNotSync.Error())
 	} else {
+		tx, _ := msg.GetData().(*types.Transaction)
 		checkedMsg := mem.checkTxs(msg)
+		if checkedMsg.Err() != nil && tx != nil {
+			mem.status.record(tx.Hash(), types.TxStatusRejected, checkedMsg.Err().Error(), 0)
+		}
 		select {
 		case mem.in <- checkedMsg:
 		case <-mem.done:
@@ -135,8 +134,13 @@ func (mem *Mempool) eventGetMempool(msg *queue.Message) {
 	} else {
 		isAll = msg.GetData().(*types.ReqGetMempool).GetIsAll()
 	}
+	txs := mem.filterTxList(0, nil, isAll)
+	if isAll {
+		//等待前序nonce的交易只在获取全部交易时返回
+		txs = append(txs, mem.queuedTxs()...)
+	}
 	msg.Reply(mem.client.NewMessage("rpc", types.EventReplyTxList,
-		&types.ReplyTxList{Txs: mem.filterTxList(0, nil, isAll)}))
+		&types.ReplyTxList{Txs: txs}))
 }
 
 // EventDelTxList 获取Mempool中一定数量交易，并把这些交易从Mempool中删除
@@ -174,8 +178,9 @@ func (mem *Mempool) eventAddBlock(msg *queue.Message) {
 		header.StateHash = block.StateHash
 		mem.setHeader(header)
 	}
+	mem.status.recordKnown(block.Txs, types.TxStatusPacked, block.Height)
 	//同步状态等mempool中不存在交易时，不需要执行操作
-	if mem.Size() > 0 {
+	if mem.Size() > 0 || mem.QueuedSize() > 0 {
 		mem.RemoveTxsOfBlock(block)
 		mem.removeExpired()
 	}
@@ -288,16 +293,6 @@ func (mem *Mempool) eventGetProperFee(msg *queue.Message) {
 		&types.ReplyProperFee{ProperFee: properFee}))
 }
 
-func (mem *Mempool) checkSign(data *queue.Message) *queue.Message {
-	tx, ok := data.GetData().(types.TxGroup)
-	if ok && tx.CheckSign(atomic.LoadInt64(&mem.currHeight)+1) {
-		return data
-	}
-	mlog.Error("wrong tx", "err", types.ErrSign)
-	data.Data = types.ErrSign
-	return data
-}
-
 // eventTxListByHash 通过hash获取tx列表
 func (mem *Mempool) eventTxListByHash(msg *queue.Message) {
 	shashList := msg.GetData().(*types.ReqTxHashList)
@@ -339,3 +334,20 @@ func (mem *Mempool) eventAddDelayTx(msg *queue.Message) {
 	}
 	msg.Reply(replyMsg)
 }
+
+// EventGetTxStatus 获取交易最近的状态变化
+func (mem *Mempool) eventGetTxStatus(msg *queue.Message) {
+	hash := msg.GetData().(*types.ReqHash).GetHash()
+	status := mem.GetTxStatus(hash)
+	if status == nil {
+		msg.Reply(mem.client.NewMessage("rpc", types.EventGetTxStatus, types.ErrNotFound))
+		return
+	}
+	msg.Reply(mem.client.NewMessage("rpc", types.EventGetTxStatus, status))
+}
+
+// EventSubTxStatus 订阅或取消订阅交易状态变化的推送
+func (mem *Mempool) eventSubTxStatus(msg *queue.Message) {
+	mem.status.subscribe(msg.GetData().(*types.ReqSubTxStatus))
+	msg.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true}))
+}
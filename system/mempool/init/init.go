@@ -5,5 +5,6 @@
 package init
 
 import (
+	_ "github.com/33cn/chain33/system/mempool/price"    //按照手续费率排队, 队列满时淘汰低手续费交易
 	_ "github.com/33cn/chain33/system/mempool/timeline" //最简单的排队模式，按照时间
 )
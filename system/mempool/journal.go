@@ -0,0 +1,234 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+)
+
+var journalTxPrefix = []byte("mempool-journal-tx-")
+
+func calcJournalTxKey(hash []byte) []byte {
+	return append(append([]byte{}, journalTxPrefix...), hash...)
+}
+
+//txJournal mempool交易日志, 记录进入mempool的交易以及被移除的交易, 用于节点重启后恢复
+type txJournal struct {
+	db  dbm.DB
+	mtx sync.Mutex
+	//获取交易快照之后写入或删除的记录, 重写日志时保留这些记录的当前状态
+	changed map[string]bool
+}
+
+func newTxJournal(cfg *types.Mempool) *txJournal {
+	if cfg.JournalPath == "" {
+		cfg.JournalPath = journalPath
+	}
+	if cfg.JournalDriver == "" {
+		cfg.JournalDriver = journalDriver
+	}
+	if cfg.JournalRotate <= 0 {
+		cfg.JournalRotate = journalRotate
+	}
+	return &txJournal{db: dbm.NewDB("journal", cfg.JournalDriver, cfg.JournalPath, 16)}
+}
+
+//insert 记录进入mempool的交易
+func (j *txJournal) insert(tx *types.Transaction) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.markChanged(tx.Hash())
+	err := j.db.Set(calcJournalTxKey(tx.Hash()), types.Encode(tx))
+	if err != nil {
+		mlog.Error("journal insert", "txHash", common.ToHex(tx.Hash()), "err", err)
+	}
+}
+
+//remove 记录从mempool移除的交易
+func (j *txJournal) remove(hash []byte) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.markChanged(hash)
+	err := j.db.Delete(calcJournalTxKey(hash))
+	if err != nil {
+		mlog.Error("journal remove", "txHash", common.ToHex(hash), "err", err)
+	}
+}
+
+func (j *txJournal) markChanged(hash []byte) {
+	if j.changed != nil {
+		j.changed[string(calcJournalTxKey(hash))] = true
+	}
+}
+
+//snapshot 开始记录变更的交易, 需要和获取mempool交易快照在同一个锁内调用
+func (j *txJournal) snapshot() {
+	j.mtx.Lock()
+	j.changed = make(map[string]bool)
+	j.mtx.Unlock()
+}
+
+//load 读取日志中所有交易, 解码失败的记录直接删除
+func (j *txJournal) load() []*types.Transaction {
+	var txs []*types.Transaction
+	var bad [][]byte
+	it := j.db.Iterator(journalTxPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		tx := &types.Transaction{}
+		if err := types.Decode(it.Value(), tx); err != nil {
+			mlog.Error("journal load", "key", string(it.Key()), "err", err)
+			bad = append(bad, append([]byte{}, it.Key()...))
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	for _, key := range bad {
+		_ = j.db.Delete(key)
+	}
+	return txs
+}
+
+//rotate 以快照中的交易重写日志, 并压缩底层存储, 快照之后发生变更的记录保持不变
+func (j *txJournal) rotate(txs []*types.Transaction) error {
+	if err := j.rewrite(txs); err != nil {
+		return err
+	}
+	return j.db.CompactRange(journalTxPrefix, nil)
+}
+
+func (j *txJournal) rewrite(txs []*types.Transaction) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	changed := j.changed
+	j.changed = nil
+	live := make(map[string]*types.Transaction, len(txs))
+	for _, tx := range txs {
+		key := string(calcJournalTxKey(tx.Hash()))
+		if !changed[key] {
+			live[key] = tx
+		}
+	}
+	batch := j.db.NewBatch(true)
+	it := j.db.Iterator(journalTxPrefix, nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := string(it.Key())
+		if changed[key] {
+			continue
+		}
+		if _, ok := live[key]; ok {
+			delete(live, key)
+			continue
+		}
+		batch.Delete([]byte(key))
+	}
+	it.Close()
+	for key, tx := range live {
+		batch.Set([]byte(key), types.Encode(tx))
+	}
+	return batch.Write()
+}
+
+func (j *txJournal) close() {
+	j.db.Close()
+}
+
+//loadJournal 节点启动时重新加载交易日志, 交易需要重新通过过期, 签名, 手续费以及链上查重检查
+func (mem *Mempool) loadJournal() {
+	txs := mem.journal.load()
+	if len(txs) == 0 {
+		return
+	}
+	types.AssertConfig(mem.client)
+	cfg := mem.client.GetConfig()
+	mem.proxyMtx.RLock()
+	header := mem.header
+	minFee, maxFee := mem.cfg.MinTxFeeRate, mem.cfg.MaxTxFee
+	mem.proxyMtx.RUnlock()
+	var checked []types.TxGroup
+	for _, tx := range txs {
+		txCache := types.NewTransactionCache(tx)
+		if err := txCache.Check(cfg, header.GetHeight()+1, minFee, maxFee); err != nil {
+			mlog.Debug("loadJournal", "txHash", common.ToHex(tx.Hash()), "check err", err)
+			mem.journal.remove(tx.Hash())
+			continue
+		}
+		checked = append(checked, txCache)
+	}
+	signOK := types.CheckTxGroupsSign(checked, header.GetHeight()+1)
+	var valid []*types.Transaction
+	for i, txCache := range checked {
+		tx := txCache.Tx()
+		if !signOK[i] {
+			mem.journal.remove(tx.Hash())
+			continue
+		}
+		mem.proxyMtx.Lock()
+		ok := mem.checkExpireValid(tx)
+		mem.proxyMtx.Unlock()
+		if !ok {
+			mem.journal.remove(tx.Hash())
+			continue
+		}
+		valid = append(valid, tx)
+	}
+	newTxs, err := util.CheckDupTx(mem.client, valid, header.GetHeight())
+	if err != nil {
+		mlog.Error("loadJournal", "CheckDupTx err", err)
+		return
+	}
+	news := make(map[string]bool, len(newTxs))
+	for _, tx := range newTxs {
+		news[string(tx.Hash())] = true
+	}
+	var count int
+	for _, tx := range valid {
+		if !news[string(tx.Hash())] {
+			mem.journal.remove(tx.Hash())
+			continue
+		}
+		if err := mem.PushTx(tx); err != nil {
+			mlog.Debug("loadJournal", "txHash", common.ToHex(tx.Hash()), "push err", err)
+			mem.journal.remove(tx.Hash())
+			continue
+		}
+		count++
+	}
+	mlog.Info("loadJournal", "total", len(txs), "loaded", count)
+}
+
+//rotateJournal 定期以mempool中的交易重写日志
+func (mem *Mempool) rotateJournal() {
+	defer mem.wg.Done()
+	defer mlog.Info("rotateJournal quit")
+	ticker := time.NewTicker(time.Duration(mem.cfg.JournalRotate) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mem.proxyMtx.Lock()
+			txs := make([]*types.Transaction, 0, mem.cache.Size())
+			mem.cache.Walk(0, func(item *Item) bool {
+				txs = append(txs, item.Value)
+				return true
+			})
+			txs = append(txs, mem.queue.Txs()...)
+			mem.journal.snapshot()
+			mem.proxyMtx.Unlock()
+			//重写和压缩日志比较耗时, 不在mempool锁内执行
+			if err := mem.journal.rotate(txs); err != nil {
+				mlog.Error("rotateJournal", "err", err)
+			}
+		case <-mem.done:
+			return
+		}
+	}
+}
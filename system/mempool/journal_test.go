@@ -0,0 +1,109 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := &types.Mempool{JournalPath: dir}
+	journal := newTxJournal(cfg)
+	require.Equal(t, journalDriver, cfg.JournalDriver)
+	require.Equal(t, journalRotate, cfg.JournalRotate)
+
+	journal.insert(tx2)
+	journal.insert(tx3)
+	journal.insert(tx4)
+	journal.remove(tx3.Hash())
+	txs := journal.load()
+	require.Equal(t, 2, len(txs))
+
+	// 重写后只保留当前mempool中的交易
+	require.Nil(t, journal.rotate([]*types.Transaction{tx4, tx5}))
+	txs = journal.load()
+	require.Equal(t, 2, len(txs))
+	hashes := map[string]bool{string(txs[0].Hash()): true, string(txs[1].Hash()): true}
+	require.True(t, hashes[string(tx4.Hash())])
+	require.True(t, hashes[string(tx5.Hash())])
+
+	// 快照之后新进入的交易不会被删除, 快照之后移除的交易不会被重新写入
+	journal.snapshot()
+	journal.insert(tx2)
+	journal.remove(tx5.Hash())
+	require.Nil(t, journal.rotate([]*types.Transaction{tx4, tx5}))
+	txs = journal.load()
+	require.Equal(t, 2, len(txs))
+	hashes = map[string]bool{string(txs[0].Hash()): true, string(txs[1].Hash()): true}
+	require.True(t, hashes[string(tx2.Hash())])
+	require.True(t, hashes[string(tx4.Hash())])
+
+	// 无法解码的记录在加载时被清理
+	require.Nil(t, journal.db.Set(calcJournalTxKey([]byte("bad")), []byte("bad tx")))
+	require.Equal(t, 2, len(journal.load()))
+	_, err = journal.db.Get(calcJournalTxKey([]byte("bad")))
+	require.Equal(t, types.ErrNotFound, err)
+	journal.close()
+}
+
+func initJournalEnv(dir string) (queue.Queue, *Mempool) {
+	cfg := types.NewChain33Config(types.MergeCfg(types.ReadFile("../../cmd/chain33/chain33.test.toml"), types.ReadFile("../../cmd/chain33/chain33.fork.toml")))
+	mcfg := cfg.GetModuleConfig()
+	var q = queue.New("channel")
+	q.SetConfig(cfg)
+	blockchainProcess(q)
+	rpcProcess(q)
+	execProcess(q)
+	mcfg.Mempool.PoolCacheSize = 100
+	mcfg.Mempool.EnableJournal = true
+	mcfg.Mempool.JournalPath = dir
+	mcfg.Mempool.MinTxFeeRate = cfg.GetMinTxFeeRate()
+	subConfig := SubConfig{mcfg.Mempool.PoolCacheSize, mcfg.Mempool.MinTxFeeRate}
+	mem := NewMempool(mcfg.Mempool)
+	mem.SetQueueCache(NewSimpleQueue(subConfig))
+	mem.SetQueueClient(q.Client())
+	mem.setSync(true)
+	mem.Wait()
+	return q, mem
+}
+
+func TestMempoolJournalReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q, mem := initJournalEnv(dir)
+	for _, tx := range []*types.Transaction{tx2, tx3, tx4} {
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		require.Nil(t, mem.client.Send(msg, true))
+		reply, err := mem.client.Wait(msg)
+		require.Nil(t, err)
+		require.True(t, reply.GetData().(*types.Reply).GetIsOk())
+	}
+	require.Equal(t, 3, mem.Size())
+	// 被打包的交易从日志中移除
+	mem.RemoveTxsOfBlock(&types.Block{Txs: []*types.Transaction{tx3}})
+	require.Equal(t, 2, mem.Size())
+	mem.Close()
+	q.Close()
+
+	q, mem = initJournalEnv(dir)
+	defer q.Close()
+	defer mem.Close()
+	require.Equal(t, 2, mem.Size())
+	require.True(t, mem.cache.Exist(string(tx2.Hash())))
+	require.True(t, mem.cache.Exist(string(tx4.Hash())))
+	require.False(t, mem.cache.Exist(string(tx3.Hash())))
+}
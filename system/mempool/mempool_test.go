@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sync"
 	"testing"
 
 	nty "github.com/33cn/chain33/system/dapp/none/types"
@@ -33,6 +34,7 @@ import (
 	_ "github.com/33cn/chain33/system/dapp/init"
 	_ "github.com/33cn/chain33/system/store/init"
 	"github.com/33cn/chain33/types"
+	gometrics "github.com/rcrowley/go-metrics"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1132,6 +1134,9 @@ func blockchainProcess(q queue.Queue) {
 	}()
 }
 
+//unknownNonceAddrs rpc模块无法返回nonce的地址
+var unknownNonceAddrs sync.Map
+
 func rpcProcess(q queue.Queue) {
 	go func() {
 		client := q.Client()
@@ -1139,6 +1144,10 @@ func rpcProcess(q queue.Queue) {
 		for msg := range client.Recv() {
 			switch msg.Ty {
 			case types.EventGetEvmNonce:
+				if _, ok := unknownNonceAddrs.Load(msg.GetData().(*types.ReqEvmAccountNonce).GetAddr()); ok {
+					msg.Reply(client.NewMessage("", types.EventGetEvmNonce, &types.Reply{Msg: []byte("evm not loaded")}))
+					continue
+				}
 				msg.Reply(client.NewMessage("", types.EventGetEvmNonce, &types.EvmAccountNonce{Nonce: int64(1)}))
 			}
 		}
@@ -1475,3 +1484,13 @@ func Test_sortEthSignTyTx(t *testing.T) {
 	require.Equal(t, txs[3].GetNonce(), tx4.GetNonce())
 
 }
+
+func TestMarkReject(t *testing.T) {
+	expire := gometrics.GetOrRegisterCounter("mempool/reject/ErrTxExpire", nil)
+	other := gometrics.GetOrRegisterCounter("mempool/reject/other", nil)
+	expireCount, otherCount := expire.Count(), other.Count()
+	markReject(types.ErrTxExpire)
+	markReject(errors.New("ErrNoBalance: account not enough"))
+	require.Equal(t, expireCount+1, expire.Count())
+	require.Equal(t, otherCount+1, other.Count())
+}
@@ -0,0 +1,34 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"strings"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var (
+	mempoolSizeGauge  = metrics.NewRegisteredGauge("mempool/size", nil)       // mempool交易数量
+	mempoolBytesGauge = metrics.NewRegisteredGauge("mempool/bytes", nil)      // mempool交易占用空间
+	queuedSizeGauge   = metrics.NewRegisteredGauge("mempool/queued", nil)     // 等待前序nonce的eth交易数量
+	replacedCounter   = metrics.NewRegisteredCounter("mempool/replaced", nil) // 被相同nonce交易替换的eth交易数量
+)
+
+// markReject 按拒绝原因统计交易被拒绝的次数,
+// 只有形如ErrXXX的错误作为原因, 其他错误(如执行器检查的详细信息)统一归为other, 避免指标数量膨胀
+func markReject(err error) {
+	reason := err.Error()
+	if !strings.HasPrefix(reason, "Err") || strings.ContainsAny(reason, " :/") {
+		reason = "other"
+	}
+	metrics.GetOrRegisterCounter("mempool/reject/"+reason, nil).Inc(1)
+}
+
+// updateSizeMetrics 更新mempool大小统计
+func (cache *txCache) updateSizeMetrics() {
+	mempoolSizeGauge.Update(int64(cache.qcache.Size()))
+	mempoolBytesGauge.Update(cache.qcache.GetCacheBytes())
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -54,6 +55,28 @@ func TestMutiStep(t *testing.T) {
 	close(done)
 }
 
+func TestBatchCheckSign(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	badSign := types.Clone(tx2).(*types.Transaction)
+	badSign.Fee++
+	done := make(chan struct{})
+	defer close(done)
+	in := make(chan *queue.Message, 3)
+	in <- &queue.Message{Data: types.NewTransactionCache(tx2)}
+	in <- &queue.Message{Data: types.NewTransactionCache(badSign)}
+	in <- &queue.Message{Data: types.ErrTxFeeTooLow}
+	close(in)
+	out := mem.batchCheckSign(done, in)
+	assert.Nil(t, (<-out).Err())
+	assert.Equal(t, types.ErrSign, (<-out).Err())
+	assert.Equal(t, types.ErrTxFeeTooLow, (<-out).Err())
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
 func BenchmarkStep(b *testing.B) {
 	done := make(chan struct{})
 	in := make(chan *queue.Message)
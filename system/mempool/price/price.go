@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	drivers.Reg("price", New)
+}
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	//估算手续费时取交易池中手续费率的百分位, 默认50
+	ProperFeePercentile int64 `json:"properFeePercentile"`
+}
+
+//New 创建price cache 结构的 mempool, 按手续费率排队
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.ProperFeePercentile <= 0 || subcfg.ProperFeePercentile > 100 {
+		subcfg.ProperFeePercentile = 50
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
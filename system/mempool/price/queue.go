@@ -0,0 +1,167 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"math"
+
+	"github.com/33cn/chain33/common/skiplist"
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+// 手续费率的放大倍数, 保证小额手续费排序的精度
+const priceScale int64 = 1e6
+
+var _ mempool.PriorityQueue = (*Queue)(nil)
+
+// Queue 按照手续费率(每字节手续费)排序的队列, 手续费率相同按照进入时间排序
+type Queue struct {
+	txList    *skiplist.Queue
+	subConfig subConfig
+}
+
+// NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		txList:    skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig: subcfg,
+	}
+}
+
+// priceScore 手续费率排序的交易
+type priceScore struct {
+	*mempool.Item
+	score int64
+	size  int64
+}
+
+func newPriceScore(item *mempool.Item) *priceScore {
+	size := int64(types.Size(item.Value))
+	if size <= 0 {
+		size = 1
+	}
+	fee := item.Value.Fee
+	if fee > math.MaxInt64/priceScale {
+		fee = math.MaxInt64 / priceScale
+	}
+	return &priceScore{Item: item, score: fee * priceScale / size, size: size}
+}
+
+// GetScore 手续费率
+func (item *priceScore) GetScore() int64 {
+	return item.score
+}
+
+// Hash 交易哈希
+func (item *priceScore) Hash() []byte {
+	return item.Value.Hash()
+}
+
+// Compare 手续费率相同时, 先进入的优先
+func (item *priceScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*priceScore)
+	if item.EnterTime < it.EnterTime {
+		return skiplist.Big
+	} else if item.EnterTime == it.EnterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+// ByteSize 交易大小
+func (item *priceScore) ByteSize() int64 {
+	return item.size
+}
+
+// Exist 是否存在
+func (cache *Queue) Exist(hash string) bool {
+	return cache.txList.Exist(hash)
+}
+
+// GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*mempool.Item, error) {
+	item, err := cache.txList.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*priceScore).Item, nil
+}
+
+// Push 把给定tx添加到Queue；如果tx已经存在Queue中或Mempool已满则返回对应error,
+// 淘汰低手续费交易由mempool通过GetEvictItem处理, 以便同时清理其他索引
+func (cache *Queue) Push(item *mempool.Item) error {
+	hash := string(item.Value.Hash())
+	if cache.Exist(hash) {
+		return types.ErrTxExist
+	}
+	if int64(cache.Size()) >= cache.subConfig.PoolCacheSize {
+		return types.ErrMemFull
+	}
+	cache.txList.Insert(hash, newPriceScore(item))
+	return nil
+}
+
+// GetEvictItem 队列已满时, 如果tx的手续费率高于队列中最低的交易, 返回被淘汰的交易
+func (cache *Queue) GetEvictItem(item *mempool.Item) *mempool.Item {
+	tail := cache.txList.Last()
+	if tail == nil {
+		return nil
+	}
+	score := newPriceScore(item)
+	if score.GetScore() > tail.GetScore() {
+		return tail.(*priceScore).Item
+	}
+	return nil
+}
+
+// Remove 删除数据
+func (cache *Queue) Remove(hash string) error {
+	return cache.txList.Remove(hash)
+}
+
+// Size 数据总数
+func (cache *Queue) Size() int {
+	return cache.txList.Size()
+}
+
+// Walk 按手续费率从高到低遍历队列
+func (cache *Queue) Walk(count int, cb func(value *mempool.Item) bool) {
+	cache.txList.Walk(count, func(value skiplist.Scorer) bool {
+		return cb(value.(*priceScore).Item)
+	})
+}
+
+// GetProperFee 获取合适的手续费率, 取队列中交易手续费率(每千字节)的百分位, 不低于配置的最低值
+func (cache *Queue) GetProperFee() int64 {
+	size := cache.Size()
+	if size == 0 {
+		return cache.subConfig.ProperFee
+	}
+	//队列从高到低排序, 第pos个交易的手续费率即为对应的百分位
+	pos := size - int(int64(size)*cache.subConfig.ProperFeePercentile/100)
+	if pos >= size {
+		pos = size - 1
+	}
+	var feeRate int64
+	i := 0
+	cache.txList.Walk(0, func(value skiplist.Scorer) bool {
+		if i == pos {
+			feeRate = value.GetScore() * 1000 / priceScale
+			return false
+		}
+		i++
+		return true
+	})
+	if feeRate < cache.subConfig.ProperFee {
+		feeRate = cache.subConfig.ProperFee
+	}
+	return feeRate
+}
+
+// GetCacheBytes 获取缓存占用空间大小
+func (cache *Queue) GetCacheBytes() int64 {
+	return cache.txList.GetCacheBytes()
+}
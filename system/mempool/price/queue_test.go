@@ -0,0 +1,94 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newItem(fee int64, payload string, enterTime int64) *mempool.Item {
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte(payload), Fee: fee}
+	return &mempool.Item{Value: tx, Priority: fee, EnterTime: enterTime}
+}
+
+func TestQueue(t *testing.T) {
+	cache := NewQueue(subConfig{PoolCacheSize: 3, ProperFee: 1000, ProperFeePercentile: 50})
+	require.Equal(t, int64(1000), cache.GetProperFee())
+
+	item1 := newItem(100000, "1", 1)
+	item2 := newItem(300000, "2", 2)
+	item3 := newItem(100000, "3", 3)
+	require.Nil(t, cache.Push(item1))
+	require.Equal(t, types.ErrTxExist, cache.Push(item1))
+	require.Nil(t, cache.Push(item2))
+	require.Nil(t, cache.Push(item3))
+	require.Equal(t, 3, cache.Size())
+	it, err := cache.GetItem(string(item2.Value.Hash()))
+	require.Nil(t, err)
+	require.Equal(t, item2, it)
+
+	//按手续费率从高到低, 相同手续费率先进入的优先
+	var items []*mempool.Item
+	cache.Walk(0, func(value *mempool.Item) bool {
+		items = append(items, value)
+		return true
+	})
+	require.Equal(t, []*mempool.Item{item2, item1, item3}, items)
+	items = nil
+	cache.Walk(1, func(value *mempool.Item) bool {
+		items = append(items, value)
+		return true
+	})
+	require.Equal(t, []*mempool.Item{item2}, items)
+
+	//队列已满
+	low := newItem(100000, "4", 3)
+	require.Equal(t, types.ErrMemFull, cache.Push(low))
+	require.Nil(t, cache.GetEvictItem(low))
+	high := newItem(200000, "5", 3)
+	require.Equal(t, item3, cache.GetEvictItem(high))
+
+	//中位数为item1的手续费率
+	size := int64(types.Size(item1.Value))
+	require.Equal(t, item1.Value.Fee*priceScale/size*1000/priceScale, cache.GetProperFee())
+	cache.subConfig.ProperFeePercentile = 100
+	require.Equal(t, item2.Value.Fee*priceScale/size*1000/priceScale, cache.GetProperFee())
+
+	require.Nil(t, cache.Remove(string(item3.Value.Hash())))
+	require.Equal(t, types.ErrNotFound, cache.Remove(string(item3.Value.Hash())))
+	require.Equal(t, 2, cache.Size())
+	require.Equal(t, int64(types.Size(item1.Value)+types.Size(item2.Value)), cache.GetCacheBytes())
+}
+
+func TestEvictLowFeeTx(t *testing.T) {
+	sub, _ := json.Marshal(&subConfig{PoolCacheSize: 2})
+	module := New(&types.Mempool{MinTxFeeRate: 1000, MaxTxFeeRate: 1e7, MaxTxNumPerAccount: 100}, sub)
+	mem := module.(*mempool.Mempool)
+	defer mem.Close()
+
+	tx1 := newItem(100000, "1", 0).Value
+	tx2 := newItem(200000, "2", 0).Value
+	require.Nil(t, mem.PushTx(tx1))
+	require.Nil(t, mem.PushTx(tx2))
+	//手续费率不高于最低的交易, 拒绝
+	require.Equal(t, types.ErrMemFull, mem.PushTx(newItem(100000, "3", 0).Value))
+	//淘汰最低手续费交易
+	tx3 := newItem(300000, "3", 0).Value
+	require.Nil(t, mem.PushTx(tx3))
+	require.Equal(t, 2, mem.Size())
+	txs := mem.GetLatestTx()
+	require.Len(t, txs, 2)
+	for _, tx := range txs {
+		require.NotEqual(t, tx1.Hash(), tx.Hash())
+	}
+	//不再使用阶梯手续费
+	fee := mem.GetProperFeeRate(&types.ReqProperFee{TxCount: 1000, TxSize: 1e6})
+	require.True(t, fee >= 1000 && fee%1000 == 0)
+}
@@ -0,0 +1,113 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+//maxTxStatusEvents 每笔交易保留的最近状态变化数量
+const maxTxStatusEvents = 16
+
+//txStatusCache 记录本节点mempool中最近交易的状态变化, 并推送给订阅者, 推送不按交易过滤
+type txStatusCache struct {
+	mtx    sync.Mutex
+	cache  *lru.Cache
+	subs   map[string]bool
+	client queue.Client
+}
+
+func newTxStatusCache(size int64) *txStatusCache {
+	cache, err := lru.New(int(size))
+	if err != nil {
+		panic(err)
+	}
+	return &txStatusCache{cache: cache, subs: make(map[string]bool)}
+}
+
+//record 记录交易状态变化, height为打包或者回滚的区块高度
+func (s *txStatusCache) record(hash []byte, status int32, reason string, height int64) {
+	event := &types.TxStatusEvent{Status: status, Reason: reason, Height: height, Time: types.Now().Unix()}
+	s.mtx.Lock()
+	var history *types.TxStatusHistory
+	if val, ok := s.cache.Get(string(hash)); ok {
+		history = val.(*types.TxStatusHistory)
+	} else {
+		history = &types.TxStatusHistory{Hash: hash}
+		s.cache.Add(string(hash), history)
+	}
+	history.Status = status
+	history.Events = append(history.Events, event)
+	if len(history.Events) > maxTxStatusEvents {
+		history.Events = history.Events[len(history.Events)-maxTxStatusEvents:]
+	}
+	var subs []string
+	for name := range s.subs {
+		subs = append(subs, name)
+	}
+	s.mtx.Unlock()
+	mlog.Debug("txStatus", "txHash", common.ToHex(hash), "status", types.TxStatusName(status), "reason", reason)
+
+	for _, name := range subs {
+		data := &types.PushData{Name: name, Value: &types.PushData_TxStatus{
+			TxStatus: &types.TxStatusHistory{Hash: hash, Status: status, Events: []*types.TxStatusEvent{event}}}}
+		//推送不阻塞mempool处理, 队列已满时丢弃
+		err := s.client.SendTimeout(s.client.NewMessage("rpc", types.EventPushTxStatus, data), false, 0)
+		if err != nil {
+			mlog.Debug("txStatus push", "name", name, "err", err)
+		}
+	}
+}
+
+//recordKnown 只记录已有状态记录的交易, 避免区块中的其他交易冲掉最近的记录
+func (s *txStatusCache) recordKnown(txs []*types.Transaction, status int32, height int64) {
+	for _, tx := range txs {
+		if s.cache.Contains(string(tx.Hash())) {
+			s.record(tx.Hash(), status, "", height)
+		}
+	}
+}
+
+//get 获取交易最近的状态变化
+func (s *txStatusCache) get(hash []byte) *types.TxStatusHistory {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	val, ok := s.cache.Get(string(hash))
+	if !ok {
+		return nil
+	}
+	return types.Clone(val.(*types.TxStatusHistory)).(*types.TxStatusHistory)
+}
+
+//subscribe 添加或者删除交易状态推送的订阅者
+func (s *txStatusCache) subscribe(req *types.ReqSubTxStatus) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if req.GetUnsubscribe() {
+		delete(s.subs, req.GetName())
+		return
+	}
+	s.subs[req.GetName()] = true
+}
+
+// GetTxStatus 获取交易最近的状态变化
+func (mem *Mempool) GetTxStatus(hash []byte) *types.TxStatusHistory {
+	return mem.status.get(hash)
+}
+
+//checkStep 交易检查失败时记录拒绝状态
+func (mem *Mempool) checkStep(msg *queue.Message, check func(*queue.Message) *queue.Message) *queue.Message {
+	tx := msg.GetData().(types.TxGroup).Tx()
+	msg = check(msg)
+	if msg.Err() != nil {
+		mem.status.record(tx.Hash(), types.TxStatusRejected, msg.Err().Error(), 0)
+	}
+	return msg
+}
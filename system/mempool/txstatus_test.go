@@ -0,0 +1,121 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxStatusCache(t *testing.T) {
+	q := queue.New("test")
+	defer q.Close()
+	pushes := make(chan *types.PushData, 1)
+	go func() {
+		cli := q.Client()
+		cli.Sub("rpc")
+		for msg := range cli.Recv() {
+			if msg.Ty == types.EventPushTxStatus {
+				pushes <- msg.GetData().(*types.PushData)
+			}
+		}
+	}()
+
+	s := newTxStatusCache(2)
+	s.client = q.Client()
+	s.record([]byte("tx1"), types.TxStatusPending, "", 0)
+	for i := 0; i < maxTxStatusEvents; i++ {
+		s.record([]byte("tx1"), types.TxStatusReorged, "", int64(i))
+	}
+	status := s.get([]byte("tx1"))
+	require.Equal(t, int32(types.TxStatusReorged), status.Status)
+	require.Equal(t, maxTxStatusEvents, len(status.Events))
+	require.Equal(t, int64(maxTxStatusEvents-1), status.Events[maxTxStatusEvents-1].Height)
+	//返回的记录不受后续变化影响
+	s.record([]byte("tx1"), types.TxStatusPacked, "", 10)
+	require.Equal(t, int32(types.TxStatusReorged), status.Status)
+
+	//只记录已知交易的打包状态
+	s.recordKnown([]*types.Transaction{tx1, tx2}, types.TxStatusPacked, 1)
+	require.Nil(t, s.get(tx1.Hash()))
+	s.record(tx1.Hash(), types.TxStatusPending, "", 0)
+	s.recordKnown([]*types.Transaction{tx1, tx2}, types.TxStatusPacked, 1)
+	require.Equal(t, int32(types.TxStatusPacked), s.get(tx1.Hash()).Status)
+	require.Nil(t, s.get(tx2.Hash()))
+	//超过容量后淘汰最早的记录
+	s.record(tx2.Hash(), types.TxStatusPending, "", 0)
+	require.Nil(t, s.get([]byte("tx1")))
+
+	s.subscribe(&types.ReqSubTxStatus{Name: "sub"})
+	s.record(tx3.Hash(), types.TxStatusRejected, types.ErrTxFeeTooLow.Error(), 0)
+	data := <-pushes
+	require.Equal(t, "sub", data.Name)
+	require.Equal(t, tx3.Hash(), data.GetTxStatus().Hash)
+	require.Equal(t, int32(types.TxStatusRejected), data.GetTxStatus().Status)
+	require.Equal(t, types.ErrTxFeeTooLow.Error(), data.GetTxStatus().Events[0].Reason)
+	s.subscribe(&types.ReqSubTxStatus{Name: "sub", Unsubscribe: true})
+	require.Equal(t, 0, len(s.subs))
+}
+
+func getTxStatus(t *testing.T, mem *Mempool, hash []byte) (*types.TxStatusHistory, error) {
+	msg := mem.client.NewMessage("mempool", types.EventGetTxStatus, &types.ReqHash{Hash: hash})
+	require.Nil(t, mem.client.Send(msg, true))
+	reply, err := mem.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetData().(*types.TxStatusHistory), nil
+}
+
+func TestEventGetTxStatus(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+
+	badSign := types.Clone(tx2).(*types.Transaction)
+	badSign.Fee++
+	for _, tx := range []*types.Transaction{tx3, tx13, badSign} {
+		msg := mem.client.NewMessage("mempool", types.EventTx, tx)
+		require.Nil(t, mem.client.Send(msg, true))
+		_, err := mem.client.Wait(msg)
+		require.Nil(t, err)
+	}
+	status, err := getTxStatus(t, mem, tx3.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.TxStatusPending), status.Status)
+	status, err = getTxStatus(t, mem, tx13.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.TxStatusRejected), status.Status)
+	require.Equal(t, types.ErrTxFeeTooLow.Error(), status.Events[0].Reason)
+	status, err = getTxStatus(t, mem, badSign.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.TxStatusRejected), status.Status)
+	require.Equal(t, types.ErrSign.Error(), status.Events[0].Reason)
+	_, err = getTxStatus(t, mem, tx5.Hash())
+	require.Equal(t, types.ErrNotFound, err)
+
+	//区块打包以及回滚
+	blkDetail := &types.BlockDetail{Block: blk}
+	msg := mem.client.NewMessage("mempool", types.EventAddBlock, blkDetail)
+	require.Nil(t, mem.client.Send(msg, false))
+	status, err = getTxStatus(t, mem, tx3.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int32(types.TxStatusPacked), status.Status)
+	require.Equal(t, blk.Height, status.Events[1].Height)
+	_, err = getTxStatus(t, mem, tx5.Hash())
+	require.Equal(t, types.ErrNotFound, err)
+
+	mem.setHeader(&types.Header{Height: 2, BlockTime: 1e9 + 1})
+	msg = mem.client.NewMessage("mempool", types.EventDelBlock, blkDetail)
+	require.Nil(t, mem.client.Send(msg, false))
+	status, err = getTxStatus(t, mem, tx3.Hash())
+	require.Nil(t, err)
+	require.Equal(t, 4, len(status.Events))
+	require.Equal(t, int32(types.TxStatusReorged), status.Events[2].Status)
+	require.Equal(t, int32(types.TxStatusPending), status.Status)
+}
@@ -0,0 +1,105 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+func (p *Protocol) handleStreamSnapshotBlock(stream network.Stream) {
+	var req types.ReqBlocks
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotBlock", "err", err)
+		return
+	}
+	if req.Start != req.End {
+		log.Error("handleStreamSnapshotBlock", "error", "wrong parameter")
+		return
+	}
+	req.IsDetail = true
+	msg := p.QueueClient.NewMessage("blockchain", types.EventGetBlocks, &req)
+	err = p.QueueClient.Send(msg, true)
+	if err != nil {
+		return
+	}
+	reply, err := p.QueueClient.WaitTimeout(msg, time.Second*10)
+	if err != nil {
+		return
+	}
+	details, ok := reply.GetData().(*types.BlockDetails)
+	if !ok || len(details.GetItems()) == 0 {
+		log.Error("handleStreamSnapshotBlock", "height", req.Start, "error", "block not found")
+		return
+	}
+	err = protocol.WriteStream(details.Items[0], stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotBlock", "remote pid", stream.Conn().RemotePeer().String(), "err", err)
+	}
+}
+
+func (p *Protocol) handleStreamSnapshotHeaders(stream network.Stream) {
+	var req types.ReqBlocks
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotHeaders", "err", err)
+		return
+	}
+	if req.Start < 0 || req.Start > req.End || req.End-req.Start >= maxSnapshotHeaders {
+		log.Error("handleStreamSnapshotHeaders", "start", req.Start, "end", req.End, "error", "wrong parameter")
+		return
+	}
+	msg := p.QueueClient.NewMessage("blockchain", types.EventGetHeaders, &req)
+	err = p.QueueClient.Send(msg, true)
+	if err != nil {
+		return
+	}
+	reply, err := p.QueueClient.WaitTimeout(msg, time.Second*30)
+	if err != nil {
+		return
+	}
+	headers, ok := reply.GetData().(*types.Headers)
+	if !ok {
+		log.Error("handleStreamSnapshotHeaders", "start", req.Start, "end", req.End, "error", reply.Err())
+		return
+	}
+	err = protocol.WriteStream(headers, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotHeaders", "remote pid", stream.Conn().RemotePeer().String(), "err", err)
+	}
+}
+
+func (p *Protocol) handleStreamSnapshotState(stream network.Stream) {
+	var req types.ReqStateSnapshot
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotState", "err", err)
+		return
+	}
+	if len(req.GetHashes()) == 0 || len(req.GetHashes()) > maxSnapshotNodes {
+		log.Error("handleStreamSnapshotState", "hashes", len(req.GetHashes()), "error", "wrong parameter")
+		return
+	}
+	msg := p.QueueClient.NewMessage("store", types.EventStoreGetSnapshot, &req)
+	err = p.QueueClient.Send(msg, true)
+	if err != nil {
+		return
+	}
+	reply, err := p.QueueClient.WaitTimeout(msg, time.Second*30)
+	if err != nil {
+		return
+	}
+	chunk, ok := reply.GetData().(*types.StateSnapshotChunk)
+	if !ok {
+		log.Error("handleStreamSnapshotState", "error", reply.Err())
+		return
+	}
+	err = protocol.WriteStream(chunk, stream)
+	if err != nil {
+		log.Error("handleStreamSnapshotState", "remote pid", stream.Conn().RemotePeer().String(), "err", err)
+		return
+	}
+	log.Debug("handleStreamSnapshotState", "nodes", len(chunk.GetNodes()), "remote peer", stream.Conn().RemotePeer().String())
+}
@@ -0,0 +1,188 @@
+// Package snapshot 状态快照同步协议, 为新加入的节点提供mavl状态树节点, 快照高度之前的区块头以及快照高度的区块
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var (
+	log = log15.New("module", "p2p.snapshot")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	snapshotBlock   = "/chain33/snapshot-block/1.0.0"
+	snapshotState   = "/chain33/snapshot-state/1.0.0"
+	snapshotHeaders = "/chain33/snapshot-headers/1.0.0"
+)
+
+// 单次请求的最大节点数
+const maxSnapshotNodes = 4096
+
+// 单次请求的最大区块头数
+const maxSnapshotHeaders = 2000
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//注册p2p通信协议，用于处理节点之间请求
+	protocol.RegisterStreamHandler(p.Host, snapshotBlock, p.handleStreamSnapshotBlock)
+	protocol.RegisterStreamHandler(p.Host, snapshotState, p.handleStreamSnapshotState)
+	protocol.RegisterStreamHandler(p.Host, snapshotHeaders, p.handleStreamSnapshotHeaders)
+	//注册事件处理函数
+	protocol.RegisterEventHandler(types.EventFetchSnapshotBlock, p.handleEventFetchSnapshotBlock)
+	protocol.RegisterEventHandler(types.EventFetchStateSnapshot, p.handleEventFetchStateSnapshot)
+	protocol.RegisterEventHandler(types.EventFetchSnapshotHeaders, p.handleEventFetchSnapshotHeaders)
+}
+
+func (p *Protocol) handleEventFetchSnapshotBlock(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqBlocks)
+	if len(req.GetPid()) == 0 || req.GetStart() != req.GetEnd() {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotBlock, types.ErrInvalidParam))
+		return
+	}
+	pid, err := peer.Decode(req.GetPid()[0])
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotBlock, err))
+		return
+	}
+	detail, err := p.fetchSnapshotBlock(pid, req.GetStart())
+	if err != nil {
+		log.Error("handleEventFetchSnapshotBlock", "pid", pid, "height", req.GetStart(), "err", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotBlock, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotBlock, detail))
+}
+
+func (p *Protocol) handleEventFetchSnapshotHeaders(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqBlocks)
+	if len(req.GetPid()) == 0 {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotHeaders, types.ErrInvalidParam))
+		return
+	}
+	pid, err := peer.Decode(req.GetPid()[0])
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotHeaders, err))
+		return
+	}
+	headers, err := p.fetchSnapshotHeaders(pid, req.GetStart(), req.GetEnd())
+	if err != nil {
+		log.Error("handleEventFetchSnapshotHeaders", "pid", pid, "start", req.GetStart(), "end", req.GetEnd(), "err", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotHeaders, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchSnapshotHeaders, headers))
+}
+
+func (p *Protocol) handleEventFetchStateSnapshot(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqStateSnapshot)
+	pid, err := peer.Decode(req.GetPid())
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchStateSnapshot, err))
+		return
+	}
+	chunk, err := p.fetchStateSnapshot(pid, req)
+	if err != nil {
+		log.Error("handleEventFetchStateSnapshot", "pid", pid, "hashes", len(req.GetHashes()), "err", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchStateSnapshot, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchStateSnapshot, chunk))
+}
+
+func (p *Protocol) fetchSnapshotBlock(pid peer.ID, height int64) (*types.BlockDetail, error) {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*30)
+	defer cancel()
+	p.Host.ConnManager().Protect(pid, snapshotBlock)
+	defer p.Host.ConnManager().Unprotect(pid, snapshotBlock)
+	stream, err := p.Host.NewStream(ctx, pid, snapshotBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(&types.ReqBlocks{Start: height, End: height, IsDetail: true}, stream)
+	if err != nil {
+		return nil, err
+	}
+	var detail types.BlockDetail
+	err = protocol.ReadStream(&detail, stream)
+	if err != nil {
+		return nil, err
+	}
+	if detail.GetBlock() == nil || detail.GetBlock().GetHeight() != height {
+		return nil, errors.New("wrong snapshot block")
+	}
+	return &detail, nil
+}
+
+func (p *Protocol) fetchSnapshotHeaders(pid peer.ID, start, end int64) (*types.Headers, error) {
+	if start < 0 || start > end || end-start >= maxSnapshotHeaders {
+		return nil, types.ErrInvalidParam
+	}
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Minute)
+	defer cancel()
+	p.Host.ConnManager().Protect(pid, snapshotHeaders)
+	defer p.Host.ConnManager().Unprotect(pid, snapshotHeaders)
+	stream, err := p.Host.NewStream(ctx, pid, snapshotHeaders)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(&types.ReqBlocks{Start: start, End: end}, stream)
+	if err != nil {
+		return nil, err
+	}
+	var headers types.Headers
+	err = protocol.ReadStream(&headers, stream)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(headers.GetItems())) != end-start+1 {
+		return nil, errors.New("wrong snapshot headers")
+	}
+	return &headers, nil
+}
+
+func (p *Protocol) fetchStateSnapshot(pid peer.ID, req *types.ReqStateSnapshot) (*types.StateSnapshotChunk, error) {
+	if len(req.GetHashes()) == 0 || len(req.GetHashes()) > maxSnapshotNodes {
+		return nil, types.ErrInvalidParam
+	}
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Minute)
+	defer cancel()
+	p.Host.ConnManager().Protect(pid, snapshotState)
+	defer p.Host.ConnManager().Unprotect(pid, snapshotState)
+	stream, err := p.Host.NewStream(ctx, pid, snapshotState)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(&types.ReqStateSnapshot{StateHash: req.GetStateHash(), Hashes: req.GetHashes()}, stream)
+	if err != nil {
+		return nil, err
+	}
+	var chunk types.StateSnapshotChunk
+	err = protocol.ReadStream(&chunk, stream)
+	if err != nil {
+		return nil, err
+	}
+	return &chunk, nil
+}
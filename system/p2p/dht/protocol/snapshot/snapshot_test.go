@@ -0,0 +1,127 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	p2pty "github.com/33cn/chain33/system/p2p/dht/types"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func newHost(t *testing.T, port int) host.Host {
+	m, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", port))
+	require.Nil(t, err)
+	h, err := libp2p.New(context.Background(), libp2p.ListenAddrs(m))
+	require.Nil(t, err)
+	return h
+}
+
+// host1提供快照数据, 返回连接到host1的请求方协议
+func initEnv(t *testing.T, q queue.Queue) (*Protocol, peer.ID) {
+	cfg := types.NewChain33Config(types.ReadFile("../../../../../cmd/chain33/chain33.test.toml"))
+	mcfg := &p2pty.P2PSubConfig{}
+	types.MustDecode(cfg.GetSubConfig().P2P[p2pty.DHTTypeName], mcfg)
+
+	host1, host2 := newHost(t, 13816), newHost(t, 13817)
+	InitProtocol(&protocol.P2PEnv{
+		Ctx:         context.Background(),
+		ChainCfg:    cfg,
+		QueueClient: q.Client(),
+		Host:        host1,
+		SubConfig:   mcfg,
+	})
+	require.Nil(t, host2.Connect(context.Background(), peer.AddrInfo{ID: host1.ID(), Addrs: host1.Addrs()}))
+
+	p2 := &Protocol{P2PEnv: &protocol.P2PEnv{
+		Ctx:         context.Background(),
+		ChainCfg:    cfg,
+		QueueClient: q.Client(),
+		Host:        host2,
+		SubConfig:   mcfg,
+	}}
+	return p2, host1.ID()
+}
+
+// 模拟blockchain和store模块, 为快照协议的处理函数提供数据
+func mockModules(q queue.Queue) {
+	chain := q.Client()
+	chain.Sub("blockchain")
+	go func() {
+		for msg := range chain.Recv() {
+			req := msg.GetData().(*types.ReqBlocks)
+			switch msg.Ty {
+			case types.EventGetBlocks:
+				detail := &types.BlockDetail{Block: &types.Block{Height: req.Start}}
+				msg.Reply(chain.NewMessage("", types.EventBlocks, &types.BlockDetails{Items: []*types.BlockDetail{detail}}))
+			case types.EventGetHeaders:
+				headers := &types.Headers{}
+				for h := req.Start; h <= req.End; h++ {
+					headers.Items = append(headers.Items, &types.Header{Height: h})
+				}
+				msg.Reply(chain.NewMessage("", types.EventHeaders, headers))
+			}
+		}
+	}()
+	store := q.Client()
+	store.Sub("store")
+	go func() {
+		for msg := range store.Recv() {
+			req := msg.GetData().(*types.ReqStateSnapshot)
+			chunk := &types.StateSnapshotChunk{StateHash: req.StateHash}
+			for _, hash := range req.Hashes {
+				chunk.Nodes = append(chunk.Nodes, &types.StateSnapshotNode{Hash: hash, Data: append([]byte("node-"), hash...)})
+			}
+			msg.Reply(store.NewMessage("", types.EventStoreGetSnapshot, chunk))
+		}
+	}()
+}
+
+func TestSnapshotProtocol(t *testing.T) {
+	q := queue.New("test")
+	mockModules(q)
+	p, pid := initEnv(t, q)
+
+	detail, err := p.fetchSnapshotBlock(pid, 10)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), detail.GetBlock().GetHeight())
+
+	headers, err := p.fetchSnapshotHeaders(pid, 5, 9)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(headers.GetItems()))
+	require.Equal(t, int64(9), headers.GetItems()[4].GetHeight())
+	_, err = p.fetchSnapshotHeaders(pid, 0, maxSnapshotHeaders)
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	chunk, err := p.fetchStateSnapshot(pid, &types.ReqStateSnapshot{StateHash: []byte("root"), Hashes: [][]byte{[]byte("a"), []byte("b")}})
+	require.Nil(t, err)
+	require.Equal(t, []byte("root"), chunk.GetStateHash())
+	require.Equal(t, 2, len(chunk.GetNodes()))
+	require.Equal(t, []byte("node-b"), chunk.GetNodes()[1].GetData())
+	_, err = p.fetchStateSnapshot(pid, &types.ReqStateSnapshot{StateHash: []byte("root")})
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	//通过事件请求区块头, 结果回复给blockchain模块
+	client := q.Client()
+	msg := client.NewMessage("p2p", types.EventFetchSnapshotHeaders, &types.ReqBlocks{Start: 1, End: 3, Pid: []string{pid.Pretty()}})
+	p.handleEventFetchSnapshotHeaders(msg)
+	reply, err := client.WaitTimeout(msg, time.Second)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(reply.GetData().(*types.Headers).GetItems()))
+	msg = client.NewMessage("p2p", types.EventFetchSnapshotBlock, &types.ReqBlocks{Start: 1, End: 2, Pid: []string{pid.Pretty()}})
+	p.handleEventFetchSnapshotBlock(msg)
+	_, err = client.WaitTimeout(msg, time.Second)
+	require.Equal(t, types.ErrInvalidParam, err)
+}
@@ -0,0 +1,36 @@
+package txproof
+
+import (
+	"time"
+
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+func (p *Protocol) handleStreamTxProof(stream network.Stream) {
+	var req types.ReqHash
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		log.Error("handleStreamTxProof", "err", err)
+		return
+	}
+	msg := p.QueueClient.NewMessage("blockchain", types.EventQueryTx, &req)
+	err = p.QueueClient.Send(msg, true)
+	if err != nil {
+		return
+	}
+	reply, err := p.QueueClient.WaitTimeout(msg, time.Second*10)
+	if err != nil {
+		return
+	}
+	detail, ok := reply.GetData().(*types.TransactionDetail)
+	if !ok {
+		//交易不存在时返回空的交易详情, 请求方可以尽快尝试其他节点
+		detail = &types.TransactionDetail{}
+	}
+	err = protocol.WriteStream(detail, stream)
+	if err != nil {
+		log.Error("handleStreamTxProof", "remote pid", stream.Conn().RemotePeer().String(), "err", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Package txproof 交易证明协议, 全节点为轻节点提供交易以及交易在区块中的默克尔证明
+package txproof
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var (
+	log = log15.New("module", "p2p.txproof")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	txProof = "/chain33/tx-proof/1.0.0"
+)
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//注册p2p通信协议，用于处理节点之间请求, 轻节点只保存自己关心的交易, 不为其他节点提供交易证明
+	if !env.ChainCfg.GetModuleConfig().BlockChain.LightMode {
+		protocol.RegisterStreamHandler(p.Host, txProof, p.handleStreamTxProof)
+	}
+	//注册事件处理函数
+	protocol.RegisterEventHandler(types.EventFetchTxProof, p.handleEventFetchTxProof)
+}
+
+func (p *Protocol) handleEventFetchTxProof(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqTxProof)
+	pid, err := peer.Decode(req.GetPid())
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchTxProof, err))
+		return
+	}
+	detail, err := p.fetchTxProof(pid, req.GetHash())
+	if err != nil {
+		log.Error("handleEventFetchTxProof", "pid", pid, "err", err)
+		msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchTxProof, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("blockchain", types.EventFetchTxProof, detail))
+}
+
+func (p *Protocol) fetchTxProof(pid peer.ID, hash []byte) (*types.TransactionDetail, error) {
+	if len(hash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Second*10)
+	defer cancel()
+	p.Host.ConnManager().Protect(pid, txProof)
+	defer p.Host.ConnManager().Unprotect(pid, txProof)
+	stream, err := p.Host.NewStream(ctx, pid, txProof)
+	if err != nil {
+		return nil, err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(&types.ReqHash{Hash: hash}, stream)
+	if err != nil {
+		return nil, err
+	}
+	var detail types.TransactionDetail
+	err = protocol.ReadStream(&detail, stream)
+	if err != nil {
+		return nil, err
+	}
+	if detail.GetTx() == nil {
+		return nil, errors.New("tx not found")
+	}
+	return &detail, nil
+}
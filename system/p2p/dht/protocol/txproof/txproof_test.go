@@ -0,0 +1,77 @@
+package txproof
+
+import (
+	"context"
+	"testing"
+
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/system/p2p/dht/protocol"
+	"github.com/33cn/chain33/types"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProtocol(t *testing.T, q queue.Queue, cfg *types.Chain33Config, init bool) *Protocol {
+	host, err := libp2p.New(context.Background(), libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = host.Close() })
+	env := &protocol.P2PEnv{
+		Ctx:         context.Background(),
+		ChainCfg:    cfg,
+		QueueClient: q.Client(),
+		Host:        host,
+	}
+	if init {
+		InitProtocol(env)
+	}
+	return &Protocol{P2PEnv: env}
+}
+
+func TestFetchTxProof(t *testing.T) {
+	q := queue.New("test")
+	defer q.Close()
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q.SetConfig(cfg)
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload")}
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			if msg.Ty != types.EventQueryTx {
+				continue
+			}
+			req := msg.GetData().(*types.ReqHash)
+			if string(req.Hash) == string(tx.Hash()) {
+				msg.Reply(client.NewMessage("", types.EventTransactionDetail, &types.TransactionDetail{Tx: tx, Height: 10, Proofs: [][]byte{[]byte("proof")}}))
+			} else {
+				msg.Reply(client.NewMessage("", types.EventTransactionDetail, types.ErrTxNotExist))
+			}
+		}
+	}()
+
+	full := newTestProtocol(t, q, cfg, true)
+	light := newTestProtocol(t, q, cfg, false)
+	err := light.Host.Connect(context.Background(), peer.AddrInfo{ID: full.Host.ID(), Addrs: full.Host.Addrs()})
+	require.Nil(t, err)
+
+	detail, err := light.fetchTxProof(full.Host.ID(), tx.Hash())
+	require.Nil(t, err)
+	require.Equal(t, int64(10), detail.Height)
+	require.Equal(t, tx.Hash(), detail.Tx.Hash())
+	_, err = light.fetchTxProof(full.Host.ID(), []byte("notexist"))
+	require.NotNil(t, err)
+	_, err = light.fetchTxProof(full.Host.ID(), nil)
+	require.Equal(t, types.ErrInvalidParam, err)
+
+	//通过事件获取交易证明
+	msg := q.Client().NewMessage("p2p", types.EventFetchTxProof, &types.ReqTxProof{Pid: full.Host.ID().Pretty(), Hash: tx.Hash()})
+	light.handleEventFetchTxProof(msg)
+	reply, err := q.Client().Wait(msg)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), reply.GetData().(*types.TransactionDetail).Height)
+	msg = q.Client().NewMessage("p2p", types.EventFetchTxProof, &types.ReqTxProof{Pid: "wrongpid", Hash: tx.Hash()})
+	light.handleEventFetchTxProof(msg)
+	_, err = q.Client().Wait(msg)
+	require.NotNil(t, err)
+}
@@ -6,7 +6,9 @@ package mavl
 
 import (
 	"bytes"
+	"errors"
 
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -68,20 +70,7 @@ func ReadProof(roothash []byte, leafhash []byte, data []byte) (*Proof, error) {
 
 // InnerNodeProofHash 计算inner节点的hash
 func InnerNodeProofHash(childHash []byte, branch *types.InnerNode) []byte {
-	var innernode types.InnerNode
-
-	innernode.Height = branch.Height
-	innernode.Size = branch.Size
-
-	// left is nil
-	if len(branch.LeftHash) == 0 {
-		innernode.LeftHash = childHash
-		innernode.RightHash = branch.RightHash
-	} else {
-		innernode.LeftHash = branch.LeftHash
-		innernode.RightHash = childHash
-	}
-	return innernode.Hash()
+	return branch.ProofHash(childHash)
 }
 
 func (node *Node) constructProof(t *Tree, key []byte, valuePtr *[]byte, proof *Proof) (exists bool) {
@@ -136,3 +125,175 @@ func (t *Tree) ConstructProof(key []byte) (value []byte, proof *Proof) {
 	}
 	return nil, nil
 }
+
+var (
+	// ErrProofKeyExists 构造不存在证明时key存在
+	ErrProofKeyExists = errors.New("ErrProofKeyExists")
+	// ErrProofEmptyTree 空树无法构造证明
+	ErrProofEmptyTree = errors.New("ErrProofEmptyTree")
+	// ErrProofInvalid 证明验证失败
+	ErrProofInvalid = errors.New("ErrProofInvalid")
+)
+
+// 构造指定序号叶子节点的证明, 证明中的hash去掉前缀
+func (t *Tree) constructLeafProof(index int32) *types.LeafProof {
+	key, _ := t.GetByIndex(index)
+	value, proof := t.ConstructProof(key)
+	return &types.LeafProof{Key: key, Value: value, InnerNodes: trimProofNodes(proof.InnerNodes)}
+}
+
+// 构造序号[begin, end)之外两侧相邻叶子节点的证明
+func (t *Tree) constructNeighbors(begin, end int32) (left, right *types.LeafProof) {
+	if begin > 0 {
+		left = t.constructLeafProof(begin - 1)
+	}
+	if end < t.Size() {
+		right = t.constructLeafProof(end)
+	}
+	return left, right
+}
+
+// ConstructAbsenceProof 构造key不存在的证明
+func (t *Tree) ConstructAbsenceProof(key []byte) (*types.AbsenceProof, error) {
+	if t.root == nil {
+		return nil, ErrProofEmptyTree
+	}
+	t.root.Hash(t)
+	index, _, exists := t.Get(key)
+	if exists {
+		return nil, ErrProofKeyExists
+	}
+	proof := &types.AbsenceProof{Key: key, RootHash: t.root.hash}
+	proof.Left, proof.Right = t.constructNeighbors(index, index)
+	return proof, nil
+}
+
+// ConstructRangeProof 构造[start,end)区间内全部叶子节点的证明
+func (t *Tree) ConstructRangeProof(start, end []byte) (*types.RangeProof, error) {
+	if t.root == nil {
+		return nil, ErrProofEmptyTree
+	}
+	t.root.Hash(t)
+	proof := &types.RangeProof{Start: start, End: end, RootHash: t.root.hash}
+	begin, _, _ := t.Get(start)
+	//end对应的叶子节点不在区间内, 作为右侧相邻节点
+	t.IterateRangeInclusive(start, end, true, func(key, value []byte) bool {
+		if end != nil && bytes.Equal(key, end) {
+			return true
+		}
+		proof.Leaves = append(proof.Leaves, t.constructLeafProof(begin+int32(len(proof.Leaves))))
+		return false
+	})
+	proof.Left, proof.Right = t.constructNeighbors(begin, begin+int32(len(proof.Leaves)))
+	return proof, nil
+}
+
+// 验证叶子节点到root的路径, 返回叶子节点的序号和树的叶子总数
+func verifyLeafProof(root []byte, leaf *types.LeafProof) (index int32, total int32, err error) {
+	leafNode := types.LeafNode{Key: leaf.GetKey(), Value: leaf.GetValue(), Height: 0, Size: 1}
+	hash := leafNode.Hash()
+	size := int32(1)
+	for _, branch := range leaf.GetInnerNodes() {
+		if branch.Size <= size {
+			return 0, 0, ErrProofInvalid
+		}
+		//子节点在右侧, 左侧子树的叶子节点都在前面
+		if len(branch.LeftHash) != 0 {
+			index += branch.Size - size
+		}
+		size = branch.Size
+		hash = branch.ProofHash(hash)
+	}
+	if !bytes.Equal(hash, root) {
+		return 0, 0, ErrProofInvalid
+	}
+	return index, size, nil
+}
+
+// 验证序列中的叶子节点在树中连续, 并且左右两侧没有遗漏的叶子节点
+func verifyLeafSequence(root []byte, left *types.LeafProof, leaves []*types.LeafProof, right *types.LeafProof) error {
+	seq := leaves
+	if left != nil {
+		seq = append([]*types.LeafProof{left}, seq...)
+	}
+	if right != nil {
+		seq = append(seq, right)
+	}
+	if len(seq) == 0 {
+		return ErrProofInvalid
+	}
+	var prev int32
+	var total int32
+	for i, leaf := range seq {
+		index, size, err := verifyLeafProof(root, leaf)
+		if err != nil {
+			return err
+		}
+		if i > 0 && (index != prev+1 || size != total || bytes.Compare(seq[i-1].Key, leaf.Key) >= 0) {
+			return ErrProofInvalid
+		}
+		prev, total = index, size
+		if i == 0 && left == nil && index != 0 {
+			return ErrProofInvalid
+		}
+	}
+	if right == nil && prev != total-1 {
+		return ErrProofInvalid
+	}
+	return nil
+}
+
+// VerifyAbsenceProof 验证key在root对应的状态中不存在
+func VerifyAbsenceProof(root []byte, proof *types.AbsenceProof) error {
+	if proof == nil || !bytes.Equal(proof.RootHash, root) {
+		return ErrProofInvalid
+	}
+	key := proof.GetKey()
+	if proof.Left != nil && bytes.Compare(proof.Left.Key, key) >= 0 {
+		return ErrProofInvalid
+	}
+	if proof.Right != nil && bytes.Compare(proof.Right.Key, key) <= 0 {
+		return ErrProofInvalid
+	}
+	return verifyLeafSequence(root, proof.Left, nil, proof.Right)
+}
+
+// VerifyRangeProof 验证root对应的状态中[start,end)区间内的全部叶子节点
+func VerifyRangeProof(root []byte, proof *types.RangeProof) error {
+	if proof == nil || !bytes.Equal(proof.RootHash, root) {
+		return ErrProofInvalid
+	}
+	start, end := proof.GetStart(), proof.GetEnd()
+	if proof.Left != nil && (start == nil || bytes.Compare(proof.Left.Key, start) >= 0) {
+		return ErrProofInvalid
+	}
+	if proof.Right != nil && (end == nil || bytes.Compare(proof.Right.Key, end) < 0) {
+		return ErrProofInvalid
+	}
+	for _, leaf := range proof.Leaves {
+		if bytes.Compare(leaf.Key, start) < 0 || (end != nil && bytes.Compare(leaf.Key, end) >= 0) {
+			return ErrProofInvalid
+		}
+	}
+	return verifyLeafSequence(root, proof.Left, proof.Leaves, proof.Right)
+}
+
+// GetAbsenceProof 获取key在指定状态下不存在的证明
+func GetAbsenceProof(db dbm.DB, roothash []byte, key []byte, treeCfg *TreeConfig) (*types.AbsenceProof, error) {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ConstructAbsenceProof(key)
+}
+
+// GetRangeProof 获取指定状态下[start,end)区间的证明
+func GetRangeProof(db dbm.DB, roothash []byte, start, end []byte, treeCfg *TreeConfig) (*types.RangeProof, error) {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ConstructRangeProof(start, end)
+}
@@ -0,0 +1,145 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// 构造key为key000, key002 ... 的偶数序号的树
+func newProofTestTree(t *testing.T, count int, treeCfg *TreeConfig) (db.DB, []byte, func()) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	mdb := db.NewDB("mavltree", "leveldb", dir, 100)
+	tree := NewTree(mdb, true, treeCfg)
+	tree.SetBlockHeight(1)
+	for i := 0; i < count; i++ {
+		tree.Set(proofTestKey(2*i), []byte(fmt.Sprintf("value%d", i)))
+	}
+	hash := tree.Save()
+	return mdb, hash, func() {
+		mdb.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func proofTestKey(i int) []byte {
+	return []byte(fmt.Sprintf("key%03d", i))
+}
+
+func TestAbsenceProof(t *testing.T) {
+	for _, treeCfg := range []*TreeConfig{nil, {EnableMavlPrefix: true}} {
+		mdb, root, closer := newProofTestTree(t, 50, treeCfg)
+		//中间, 最左侧, 最右侧不存在的key
+		for _, key := range [][]byte{proofTestKey(1), proofTestKey(51), []byte("a"), []byte("z")} {
+			proof, err := GetAbsenceProof(mdb, root, key, treeCfg)
+			require.NoError(t, err)
+			require.NoError(t, VerifyAbsenceProof(root, proof))
+		}
+		_, err := GetAbsenceProof(mdb, root, proofTestKey(2), treeCfg)
+		require.Equal(t, ErrProofKeyExists, err)
+
+		proof, err := GetAbsenceProof(mdb, root, proofTestKey(7), treeCfg)
+		require.NoError(t, err)
+		require.Equal(t, proofTestKey(6), proof.Left.Key)
+		require.Equal(t, proofTestKey(8), proof.Right.Key)
+		tamper := func(fn func(p *types.AbsenceProof)) {
+			p := proto.Clone(proof).(*types.AbsenceProof)
+			fn(p)
+			require.Equal(t, ErrProofInvalid, VerifyAbsenceProof(root, p))
+		}
+		//证明存在的key不存在
+		tamper(func(p *types.AbsenceProof) { p.Key = proofTestKey(6) })
+		tamper(func(p *types.AbsenceProof) { p.Key = proofTestKey(8) })
+		//用不相邻的叶子节点证明
+		tamper(func(p *types.AbsenceProof) { p.Key = proofTestKey(9) })
+		//去掉一侧的叶子节点
+		tamper(func(p *types.AbsenceProof) { p.Left = nil })
+		tamper(func(p *types.AbsenceProof) { p.Right = nil })
+		//修改叶子节点的值和路径
+		tamper(func(p *types.AbsenceProof) { p.Left.Value = []byte("fake") })
+		tamper(func(p *types.AbsenceProof) { p.Right.InnerNodes[0].Size++ })
+		tamper(func(p *types.AbsenceProof) { p.Right.InnerNodes = p.Right.InnerNodes[1:] })
+		tamper(func(p *types.AbsenceProof) { p.Left, p.Right = p.Right, p.Left })
+		tamper(func(p *types.AbsenceProof) { p.RootHash = emptyRoot[:] })
+		require.Equal(t, ErrProofInvalid, VerifyAbsenceProof(emptyRoot[:], proof))
+		require.Equal(t, ErrProofInvalid, VerifyAbsenceProof(root, nil))
+		closer()
+	}
+}
+
+func TestAbsenceProofSingleLeaf(t *testing.T) {
+	mdb, root, closer := newProofTestTree(t, 1, nil)
+	defer closer()
+	proof, err := GetAbsenceProof(mdb, root, proofTestKey(1), nil)
+	require.NoError(t, err)
+	require.Nil(t, proof.Right)
+	require.NoError(t, VerifyAbsenceProof(root, proof))
+	proof, err = GetAbsenceProof(mdb, root, []byte("a"), nil)
+	require.NoError(t, err)
+	require.Nil(t, proof.Left)
+	require.NoError(t, VerifyAbsenceProof(root, proof))
+}
+
+func TestRangeProof(t *testing.T) {
+	for _, treeCfg := range []*TreeConfig{nil, {EnableMavlPrefix: true}} {
+		mdb, root, closer := newProofTestTree(t, 50, treeCfg)
+		cases := []struct {
+			start, end []byte
+			count      int
+		}{
+			{proofTestKey(10), proofTestKey(20), 5},
+			{proofTestKey(11), proofTestKey(21), 5},
+			{proofTestKey(11), proofTestKey(12), 0},
+			{nil, proofTestKey(6), 3},
+			{proofTestKey(90), nil, 5},
+			{nil, nil, 50},
+			{[]byte("z"), nil, 0},
+		}
+		for _, c := range cases {
+			proof, err := GetRangeProof(mdb, root, c.start, c.end, treeCfg)
+			require.NoError(t, err)
+			require.Len(t, proof.Leaves, c.count)
+			require.NoError(t, VerifyRangeProof(root, proof))
+		}
+
+		proof, err := GetRangeProof(mdb, root, proofTestKey(10), proofTestKey(20), treeCfg)
+		require.NoError(t, err)
+		require.Equal(t, proofTestKey(8), proof.Left.Key)
+		require.Equal(t, proofTestKey(20), proof.Right.Key)
+		tamper := func(fn func(p *types.RangeProof)) {
+			p := proto.Clone(proof).(*types.RangeProof)
+			fn(p)
+			require.Equal(t, ErrProofInvalid, VerifyRangeProof(root, p))
+		}
+		//隐藏区间中的叶子节点
+		tamper(func(p *types.RangeProof) { p.Leaves = append(p.Leaves[:2], p.Leaves[3:]...) })
+		tamper(func(p *types.RangeProof) { p.Leaves = p.Leaves[1:] })
+		tamper(func(p *types.RangeProof) { p.Leaves = p.Leaves[:len(p.Leaves)-1] })
+		//修改区间, 使叶子节点或相邻节点超出范围
+		tamper(func(p *types.RangeProof) { p.End = proofTestKey(21) })
+		tamper(func(p *types.RangeProof) { p.Start = proofTestKey(8) })
+		tamper(func(p *types.RangeProof) { p.Start = nil })
+		tamper(func(p *types.RangeProof) { p.End = nil })
+		//去掉相邻节点
+		tamper(func(p *types.RangeProof) { p.Left = nil })
+		tamper(func(p *types.RangeProof) { p.Right = nil })
+		//修改或重复叶子节点
+		tamper(func(p *types.RangeProof) { p.Leaves[1].Value = []byte("fake") })
+		tamper(func(p *types.RangeProof) { p.Leaves[1] = p.Leaves[0] })
+		tamper(func(p *types.RangeProof) { p.Leaves[0], p.Leaves[1] = p.Leaves[1], p.Leaves[0] })
+		tamper(func(p *types.RangeProof) { p.Leaves[2].InnerNodes[1].Size-- })
+		require.Equal(t, ErrProofInvalid, VerifyRangeProof(emptyRoot[:], proof))
+		closer()
+	}
+}
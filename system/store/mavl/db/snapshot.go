@@ -0,0 +1,133 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// 状态快照同步:
+// 1. 同步节点从区块头的StateHash开始, 按层向其他节点请求mavl树节点
+// 2. 每个节点的数据都需要通过hash校验, 校验通过后写入本地db, 并返回该节点还未下载的子节点hash
+// 3. 所有节点下载完成后, 从根节点开始重新计算整棵树的hash, 与区块头的StateHash做比对
+
+var (
+	// ErrSnapshotNodeHash 快照节点数据与hash不匹配
+	ErrSnapshotNodeHash = errors.New("ErrSnapshotNodeHash")
+	// ErrSnapshotNotSupport 开启MVCC的mavl树叶子节点不存储value, 不支持导出快照
+	ErrSnapshotNotSupport = errors.New("ErrSnapshotNotSupport")
+)
+
+// MaxSnapshotChunkSize 单次导出快照节点数据的最大字节数
+var MaxSnapshotChunkSize = 4 * 1024 * 1024
+
+// GetSnapshotNodes 获取指定hash的mavl树节点存储数据, 不存在的节点直接跳过
+func GetSnapshotNodes(db dbm.DB, req *types.ReqStateSnapshot, treeCfg *TreeConfig) (*types.StateSnapshotChunk, error) {
+	if treeCfg != nil && treeCfg.EnableMVCC {
+		return nil, ErrSnapshotNotSupport
+	}
+	chunk := &types.StateSnapshotChunk{StateHash: req.GetStateHash()}
+	size := 0
+	for _, hash := range req.GetHashes() {
+		data, err := db.Get(hash)
+		if err != nil || len(data) == 0 {
+			treelog.Debug("GetSnapshotNodes", "hash", common.ToHex(hash), "err", err)
+			continue
+		}
+		size += len(data) + len(hash)
+		if size > MaxSnapshotChunkSize && len(chunk.Nodes) > 0 {
+			break
+		}
+		chunk.Nodes = append(chunk.Nodes, &types.StateSnapshotNode{Hash: hash, Data: data})
+	}
+	return chunk, nil
+}
+
+// ImportSnapshotNodes 校验快照节点数据并写入db, 返回还未下载的子节点hash
+// 调用方需要保证导入的节点都是已请求的节点, 即节点hash来自父节点或者区块头的StateHash
+func ImportSnapshotNodes(db dbm.DB, nodes []*types.StateSnapshotNode, sync bool) ([][]byte, error) {
+	batch := db.NewBatch(sync)
+	var children [][]byte
+	for _, node := range nodes {
+		storeNode, err := verifySnapshotNode(node.Hash, node.Data)
+		if err != nil {
+			treelog.Error("ImportSnapshotNodes", "hash", common.ToHex(node.Hash), "err", err)
+			return nil, err
+		}
+		batch.Set(node.Hash, node.Data)
+		if storeNode.Height == 0 {
+			continue
+		}
+		for _, child := range [][]byte{storeNode.LeftHash, storeNode.RightHash} {
+			if value, err := db.Get(child); err == nil && len(value) > 0 {
+				continue
+			}
+			children = append(children, child)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// CheckSnapshot 从根节点开始重新计算整棵树的hash, 校验导入的快照是否完整
+func CheckSnapshot(db dbm.DB, stateHash []byte) (leaves int64, err error) {
+	if bytes.Equal(stateHash, emptyRoot[:]) {
+		return 0, nil
+	}
+	stack := [][]byte{stateHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		data, err := db.Get(hash)
+		if err != nil || len(data) == 0 {
+			treelog.Error("CheckSnapshot", "hash", common.ToHex(hash), "err", err)
+			return leaves, ErrNodeNotExist
+		}
+		storeNode, err := verifySnapshotNode(hash, data)
+		if err != nil {
+			return leaves, err
+		}
+		if storeNode.Height == 0 {
+			leaves++
+			continue
+		}
+		stack = append(stack, storeNode.LeftHash, storeNode.RightHash)
+	}
+	return leaves, nil
+}
+
+// 根据节点存储数据重新计算hash, 开启前缀时hash的前缀部分不参与校验
+func verifySnapshotNode(hash, data []byte) (*types.StoreNode, error) {
+	if len(hash) < sha256Len {
+		return nil, ErrSnapshotNodeHash
+	}
+	var storeNode types.StoreNode
+	err := types.Decode(data, &storeNode)
+	if err != nil {
+		return nil, err
+	}
+	var calcHash []byte
+	if storeNode.Height == 0 {
+		leafNode := types.LeafNode{Key: storeNode.Key, Value: storeNode.Value, Height: storeNode.Height, Size: storeNode.Size}
+		calcHash = leafNode.Hash()
+	} else {
+		if len(storeNode.LeftHash) == 0 || len(storeNode.RightHash) == 0 {
+			return nil, ErrSnapshotNodeHash
+		}
+		innerNode := types.InnerNode{LeftHash: storeNode.LeftHash, RightHash: storeNode.RightHash, Height: storeNode.Height, Size: storeNode.Size}
+		calcHash = innerNode.Hash()
+	}
+	if !bytes.Equal(calcHash, hash[len(hash)-sha256Len:]) {
+		return nil, ErrSnapshotNodeHash
+	}
+	return &storeNode, nil
+}
@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	treeCfg := &TreeConfig{EnableMavlPrefix: true}
+	srcdb := db.NewDB("mavltree", "leveldb", dir, 100)
+	records := make(map[string]string)
+	var hash []byte
+	for h := int64(0); h < 3; h++ {
+		tree := NewTree(srcdb, true, treeCfg)
+		tree.Load(hash)
+		tree.SetBlockHeight(h)
+		for i := 0; i < 100; i++ {
+			key, value := randstr(20), randstr(20)
+			records[key] = value
+			tree.Set([]byte(key), []byte(value))
+		}
+		hash = tree.Save()
+	}
+
+	dstdb := db.NewDB("snapshot", "leveldb", dir, 100)
+	_, err = CheckSnapshot(dstdb, hash)
+	assert.Equal(t, ErrNodeNotExist, err)
+
+	//按层从源db导出节点并导入目标db
+	pending := [][]byte{hash}
+	for len(pending) > 0 {
+		req := &types.ReqStateSnapshot{StateHash: hash, Hashes: pending}
+		chunk, err := GetSnapshotNodes(srcdb, req, treeCfg)
+		require.NoError(t, err)
+		require.Equal(t, len(pending), len(chunk.Nodes))
+		pending, err = ImportSnapshotNodes(dstdb, chunk.Nodes, true)
+		require.NoError(t, err)
+	}
+	leaves, err := CheckSnapshot(dstdb, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(records)), leaves)
+
+	tree := NewTree(dstdb, true, treeCfg)
+	require.NoError(t, tree.Load(hash))
+	for key, value := range records {
+		_, v, exists := tree.Get([]byte(key))
+		assert.True(t, exists)
+		assert.Equal(t, value, string(v))
+	}
+
+	//篡改节点数据
+	chunk, err := GetSnapshotNodes(srcdb, &types.ReqStateSnapshot{Hashes: [][]byte{hash}}, treeCfg)
+	require.NoError(t, err)
+	var storeNode types.StoreNode
+	require.NoError(t, types.Decode(chunk.Nodes[0].Data, &storeNode))
+	storeNode.Size++
+	chunk.Nodes[0].Data = types.Encode(&storeNode)
+	_, err = ImportSnapshotNodes(db.NewDB("tamper", "leveldb", dir, 100), chunk.Nodes, true)
+	assert.Equal(t, ErrSnapshotNodeHash, err)
+
+	_, err = GetSnapshotNodes(srcdb, &types.ReqStateSnapshot{Hashes: [][]byte{hash}}, &TreeConfig{EnableMVCC: true})
+	assert.Equal(t, ErrSnapshotNotSupport, err)
+}
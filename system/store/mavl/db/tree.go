@@ -738,6 +738,50 @@ func GetKVPairProof(db dbm.DB, roothash []byte, key []byte, treeCfg *TreeConfig)
 	return nil, nil
 }
 
+// GetStateProof 获取key在指定状态下的证明, 包含验证所需的全部数据, key不存在时返回不存在证明
+func GetStateProof(db dbm.DB, roothash []byte, key []byte, treeCfg *TreeConfig) (*types.StateProof, error) {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	value, proof := tree.ConstructProof(key)
+	if proof == nil {
+		absence, err := tree.ConstructAbsenceProof(key)
+		if err != nil {
+			return nil, types.ErrNotFound
+		}
+		return &types.StateProof{Key: key, StateHash: roothash, Absence: absence}, nil
+	}
+	mavlProof := &types.MAVLProof{
+		LeafHash:   trimHashPrefix(proof.LeafHash),
+		InnerNodes: trimProofNodes(proof.InnerNodes),
+		RootHash:   proof.RootHash,
+	}
+	return &types.StateProof{Key: key, Value: value, StateHash: roothash, Proof: mavlProof}, nil
+}
+
+// 开启前缀树时节点hash带有前缀, 证明中只保留hash部分
+func trimHashPrefix(hash []byte) []byte {
+	if len(hash) > sha256Len {
+		return hash[len(hash)-sha256Len:]
+	}
+	return hash
+}
+
+func trimProofNodes(nodes []*types.InnerNode) []*types.InnerNode {
+	trimmed := make([]*types.InnerNode, 0, len(nodes))
+	for _, branch := range nodes {
+		trimmed = append(trimmed, &types.InnerNode{
+			Height:    branch.Height,
+			Size:      branch.Size,
+			LeftHash:  trimHashPrefix(branch.LeftHash),
+			RightHash: trimHashPrefix(branch.RightHash),
+		})
+	}
+	return trimmed
+}
+
 // DelKVPair 剔除key对应的节点在本次tree中，返回新的roothash和key对应的value
 func DelKVPair(db dbm.DB, storeDel *types.StoreGet, treeCfg *TreeConfig) ([]byte, [][]byte, error) {
 	tree := NewTree(db, true, treeCfg)
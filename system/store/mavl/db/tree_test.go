@@ -675,6 +675,40 @@ func TestGetAndVerifyKVPairProof(t *testing.T) {
 	db.Close()
 }
 
+func TestGetStateProof(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db.Close()
+
+	for _, treeCfg := range []*TreeConfig{nil, {EnableMavlPrefix: true}} {
+		storeSet := &types.StoreSet{StateHash: emptyRoot[:], Height: 1}
+		for i := 0; i < 20; i++ {
+			storeSet.KV = append(storeSet.KV, &types.KeyValue{Key: []byte(fmt.Sprintf("key%02d", i)), Value: []byte(randstr(20))})
+		}
+		hash, err := SetKVPair(db, storeSet, true, treeCfg)
+		require.Nil(t, err)
+		for _, kv := range storeSet.KV {
+			proof, err := GetStateProof(db, hash, kv.Key, treeCfg)
+			require.Nil(t, err)
+			require.Equal(t, kv.Value, proof.Value)
+			//编码后可以独立验证
+			var decoded types.StateProof
+			require.Nil(t, types.Decode(types.Encode(proof), &decoded))
+			require.Nil(t, decoded.Verify(hash))
+			require.Equal(t, types.ErrStateProofRoot, decoded.Verify(emptyRoot[:]))
+			decoded.Value = []byte("fake")
+			require.Equal(t, types.ErrStateProofInvalid, decoded.Verify(hash))
+		}
+		proof, err := GetStateProof(db, hash, []byte("key99"), treeCfg)
+		require.Nil(t, err)
+		require.Nil(t, proof.Value)
+		require.Nil(t, proof.Proof)
+		require.Nil(t, VerifyAbsenceProof(hash, proof.Absence))
+	}
+}
+
 type traverser struct {
 	Values []string
 }
@@ -219,12 +219,39 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, mavls.treeCfg, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理状态快照同步和状态证明相关的消息
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
-	msg.ReplyErr("Store", types.ErrActionNotSupport)
+	var reply types.Message
+	var err error
+	switch msg.Ty {
+	case types.EventStoreGetSnapshot:
+		reply, err = mavl.GetSnapshotNodes(mavls.GetDB(), msg.GetData().(*types.ReqStateSnapshot), mavls.treeCfg)
+	case types.EventStoreImportSnapshot:
+		var children [][]byte
+		chunk := msg.GetData().(*types.StateSnapshotChunk)
+		children, err = mavl.ImportSnapshotNodes(mavls.GetDB(), chunk.GetNodes(), false)
+		reply = &types.ReplyHashes{Hashes: children}
+	case types.EventStoreCheckSnapshot:
+		var leaves int64
+		req := msg.GetData().(*types.ReqHash)
+		leaves, err = mavl.CheckSnapshot(mavls.GetDB(), req.GetHash())
+		mlog.Info("store mavl check snapshot", "stateHash", common.ToHex(req.GetHash()), "leaves", leaves, "err", err)
+		reply = &types.Reply{IsOk: err == nil}
+	case types.EventStoreGetProof:
+		req := msg.GetData().(*types.ReqStateProof)
+		reply, err = mavl.GetStateProof(mavls.GetDB(), req.GetStateHash(), req.GetKey(), mavls.treeCfg)
+	default:
+		msg.ReplyErr("Store", types.ErrActionNotSupport)
+		return
+	}
+	if err != nil {
+		msg.Reply(mavls.GetQueueClient().NewMessage("", msg.Ty, err))
+		return
+	}
+	msg.Reply(mavls.GetQueueClient().NewMessage("", msg.Ty, reply))
 }
 
 // Del ...
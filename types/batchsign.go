@@ -0,0 +1,166 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/33cn/chain33/common/crypto"
+)
+
+//maxBatchSignNum 单个批量验签任务包含的最大签名数
+const maxBatchSignNum = 64
+
+//VerifyTxsSignature 批量验证交易签名, 全部通过返回true, 存在错误签名时尽早结束
+func VerifyTxsSignature(txs []*Transaction, blockHeight int64) bool {
+	for _, ok := range checkTxsSign(txs, blockHeight, true) {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+//CheckTxGroupsSign 批量验证交易(组)签名, 返回每一项的验证结果, 交易组需要组内所有交易验签通过
+func CheckTxGroupsSign(groups []TxGroup, blockHeight int64) []bool {
+	results := make([]bool, len(groups))
+	var txs []*Transaction
+	var owners []int
+	for i, group := range groups {
+		//已经验证过签名的交易直接使用缓存结果
+		if cache, ok := group.(*TransactionCache); ok && cache.signok != 0 {
+			results[i] = cache.signok == 1
+			continue
+		}
+		txgroup, err := group.GetTxGroup()
+		if err != nil {
+			continue
+		}
+		results[i] = true
+		if txgroup == nil {
+			txs = append(txs, group.Tx())
+			owners = append(owners, i)
+			continue
+		}
+		for _, tx := range txgroup.GetTxs() {
+			txs = append(txs, tx)
+			owners = append(owners, i)
+		}
+	}
+	for j, ok := range checkTxsSign(txs, blockHeight, false) {
+		if !ok {
+			results[owners[j]] = false
+		}
+	}
+	for i, group := range groups {
+		if cache, ok := group.(*TransactionCache); ok && cache.signok == 0 {
+			cache.signok = 2
+			if results[i] {
+				cache.signok = 1
+			}
+		}
+	}
+	return results
+}
+
+//checkTxsSign 按签名类型分组, 支持批量验签的类型分批验证, 失败时回退到逐个验证定位错误签名,
+//其余类型逐个验证, 所有任务由worker pool并发执行
+//
+//failFast为true时, 出现错误签名后剩余的任务不再执行, 对应结果为false
+func checkTxsSign(txs []*Transaction, blockHeight int64, failFast bool) []bool {
+	results := make([]bool, len(txs))
+	if len(txs) == 0 {
+		return results
+	}
+	var tasks [][]int
+	batches := make(map[string][]int)
+	for i, tx := range txs {
+		if sign := tx.GetSignature(); sign != nil {
+			name := GetSignName(string(tx.Execer), int(sign.Ty))
+			if c, err := crypto.Load(name, blockHeight); err == nil {
+				if _, err := crypto.ToBatch(c); err == nil {
+					batches[name] = append(batches[name], i)
+					continue
+				}
+			}
+		}
+		tasks = append(tasks, []int{i})
+	}
+	for _, indexes := range batches {
+		for len(indexes) > maxBatchSignNum {
+			tasks = append(tasks, indexes[:maxBatchSignNum])
+			indexes = indexes[maxBatchSignNum:]
+		}
+		tasks = append(tasks, indexes)
+	}
+
+	taskCh := make(chan []int, len(tasks))
+	for _, task := range tasks {
+		taskCh <- task
+	}
+	close(taskCh)
+	workers := runtime.NumCPU()
+	if workers > len(tasks) {
+		workers = len(tasks)
+	}
+	var failed int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for task := range taskCh {
+				if failFast && atomic.LoadInt32(&failed) == 1 {
+					continue
+				}
+				if !checkSignTask(txs, task, blockHeight, results) {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func checkSignTask(txs []*Transaction, task []int, blockHeight int64, results []bool) bool {
+	if len(task) > 1 && verifyBatchSign(txs, task, blockHeight) {
+		for _, i := range task {
+			results[i] = true
+		}
+		return true
+	}
+	allOK := true
+	for _, i := range task {
+		results[i] = txs[i].checkSign(blockHeight)
+		allOK = allOK && results[i]
+	}
+	return allOK
+}
+
+//verifyBatchSign 同一任务中的交易签名类型相同
+func verifyBatchSign(txs []*Transaction, task []int, blockHeight int64) bool {
+	first := txs[task[0]]
+	c, err := crypto.Load(GetSignName(string(first.Execer), int(first.Signature.Ty)), blockHeight)
+	if err != nil {
+		return false
+	}
+	batch, err := crypto.ToBatch(c)
+	if err != nil {
+		return false
+	}
+	msgs := make([][]byte, 0, len(task))
+	pubs := make([][]byte, 0, len(task))
+	sigs := make([][]byte, 0, len(task))
+	for _, i := range task {
+		tx := txs[i]
+		msgs = append(msgs, tx.signData())
+		pubs = append(pubs, tx.Signature.Pubkey)
+		sigs = append(sigs, tx.Signature.Signature)
+	}
+	return batch.VerifyBatch(msgs, pubs, sigs) == nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/ed25519"
+	"github.com/33cn/chain33/system/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+//batchDemo 支持批量验签的测试插件, 记录批量验签的调用次数
+type batchDemo struct {
+	ed25519.Driver
+	calls int32
+}
+
+func (d *batchDemo) VerifyBatch(msgs, pubs, sigs [][]byte) error {
+	atomic.AddInt32(&d.calls, 1)
+	for i := range msgs {
+		if err := d.Validate(msgs[i], pubs[i], sigs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const batchDemoID = 4000
+
+var demoBatch = &batchDemo{}
+
+func init() {
+	crypto.Register("batchdemo", demoBatch, crypto.WithRegOptionTypeID(batchDemoID))
+}
+
+func newBatchSignTxs(t *testing.T, num int) []*Transaction {
+	var txs []*Transaction
+	for i := 0; i < num; i++ {
+		var priv crypto.PrivKey
+		var err error
+		ty := int32(secp256k1.ID)
+		if i%2 == 0 {
+			priv, err = demoBatch.GenKey()
+			ty = batchDemoID
+		} else {
+			priv, err = secp256k1.Driver{}.GenKey()
+		}
+		require.Nil(t, err)
+		tx := &Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 1000000, Nonce: int64(i)}
+		tx.Sign(ty, priv)
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func TestCheckTxsSign(t *testing.T) {
+	txs := newBatchSignTxs(t, 2*maxBatchSignNum+10)
+	require.True(t, VerifyTxsSignature(txs, 0))
+	//batchdemo类型的交易分为两批验证
+	require.Equal(t, int32(2), atomic.LoadInt32(&demoBatch.calls))
+
+	//批量验证失败时回退逐个验证, 准确定位错误签名
+	txs[2].Fee++
+	txs[3].Fee++
+	txs = append(txs, &Transaction{})
+	results := checkTxsSign(txs, 0, false)
+	for i, ok := range results {
+		require.Equal(t, i != 2 && i != 3 && i != len(txs)-1, ok, "index %d", i)
+	}
+	require.False(t, VerifyTxsSignature(txs, 0))
+	require.True(t, VerifyTxsSignature(nil, 0))
+}
+
+func TestCheckTxGroupsSign(t *testing.T) {
+	cfg := NewChain33Config(GetDefaultCfgstring())
+	txs := newBatchSignTxs(t, 6)
+	group, err := CreateTxGroup(txs[:3], cfg.GetMinTxFeeRate())
+	require.Nil(t, err)
+	priv, err := demoBatch.GenKey()
+	require.Nil(t, err)
+	for i := range group.Txs {
+		require.Nil(t, group.SignN(i, batchDemoID, priv))
+	}
+	badGroup, err := CreateTxGroup(txs[3:5], cfg.GetMinTxFeeRate())
+	require.Nil(t, err)
+	require.Nil(t, badGroup.SignN(0, batchDemoID, priv))
+
+	caches := []TxGroup{
+		NewTransactionCache(group.Tx()),
+		NewTransactionCache(badGroup.Tx()),
+		NewTransactionCache(txs[5]),
+		txs[4],
+	}
+	results := CheckTxGroupsSign(caches, 0)
+	require.Equal(t, []bool{true, false, true, true}, results)
+	//结果写入交易缓存
+	require.True(t, caches[0].CheckSign(0))
+	require.False(t, caches[1].CheckSign(0))
+}
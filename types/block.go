@@ -6,8 +6,6 @@ package types
 
 import (
 	"bytes"
-	"runtime"
-	"sync"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
@@ -103,7 +101,7 @@ func VerifySignature(cfg *Chain33Config, block *Block, txs []*Transaction) bool
 		return false
 	}
 	//检查交易的签名
-	return verifyTxsSignature(txs, block.GetHeight())
+	return VerifyTxsSignature(txs, block.GetHeight())
 }
 
 // CheckSign 检测block的签名,以及交易的签名
@@ -119,74 +117,6 @@ func (block *Block) verifySignature(cfg *Chain33Config) bool {
 	return CheckSign(hash, "", block.GetSignature(), block.GetHeight())
 }
 
-func verifyTxsSignature(txs []*Transaction, blockHeight int64) bool {
-
-	//没有需要要验签的交易，直接返回
-	if len(txs) == 0 {
-		return true
-	}
-	done := make(chan struct{})
-	defer close(done)
-	taskes := gen(done, txs)
-	cpuNum := runtime.NumCPU()
-	// Start a fixed number of goroutines to read and digest files.
-	c := make(chan result) // HLc
-	var wg sync.WaitGroup
-	wg.Add(cpuNum)
-	for i := 0; i < cpuNum; i++ {
-		go func() {
-			checksign(done, taskes, c, blockHeight) // HLc
-			wg.Done()
-		}()
-	}
-	go func() {
-		wg.Wait()
-		close(c) // HLc
-	}()
-	// End of pipeline. OMIT
-	for r := range c {
-		if !r.isok {
-			return false
-		}
-	}
-	return true
-}
-
-func gen(done <-chan struct{}, task []*Transaction) <-chan *Transaction {
-	ch := make(chan *Transaction)
-	go func() {
-		defer func() {
-			close(ch)
-		}()
-		for i := 0; i < len(task); i++ {
-			select {
-			case ch <- task[i]:
-			case <-done:
-				return
-			}
-		}
-	}()
-	return ch
-}
-
-type result struct {
-	isok bool
-}
-
-func check(data *Transaction, blockHeight int64) bool {
-	return data.CheckSign(blockHeight)
-}
-
-func checksign(done <-chan struct{}, taskes <-chan *Transaction, c chan<- result, blockHeight int64) {
-	for task := range taskes {
-		select {
-		case c <- result{check(task, blockHeight)}:
-		case <-done:
-			return
-		}
-	}
-}
-
 // CheckSign 检测签名
 func CheckSign(data []byte, execer string, sign *Signature, blockHeight int64) bool {
 	//GetDefaultSign: 系统内置钱包，非插件中的签名
@@ -3052,10 +3052,14 @@ type PushSubscribeReq struct {
 	LastSequence  int64  `protobuf:"varint,4,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"`
 	LastHeight    int64  `protobuf:"varint,5,opt,name=lastHeight,proto3" json:"lastHeight,omitempty"`
 	LastBlockHash string `protobuf:"bytes,6,opt,name=lastBlockHash,proto3" json:"lastBlockHash,omitempty"`
-	// 0:代表区块；1:代表区块头信息；2：代表交易回执
+	// 0:代表区块；1:代表区块头信息；2：代表交易回执；6：代表主链切换
 	Type int32 `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
 	//允许订阅多个类型的交易回执
 	Contract map[string]bool `protobuf:"bytes,8,rep,name=contract,proto3" json:"contract,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//交易回执的过滤条件
+	TxFilter *PushTxFilter `protobuf:"bytes,9,opt,name=txFilter,proto3" json:"txFilter,omitempty"`
+	//订阅者的公钥(hex), 设置后可以通过该公钥签名的请求管理订阅
+	Owner string `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (x *PushSubscribeReq) Reset() {
@@ -3146,33 +3150,798 @@ func (x *PushSubscribeReq) GetContract() map[string]bool {
 	return nil
 }
 
+func (x *PushSubscribeReq) GetTxFilter() *PushTxFilter {
+	if x != nil {
+		return x.TxFilter
+	}
+	return nil
+}
+
+func (x *PushSubscribeReq) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// 交易回执推送的过滤条件, 与contract条件同时满足的交易才会推送
+type PushTxFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 地址过滤, 交易发送地址属于fromAddr, 或者接收地址属于toAddr, 或者任一地址属于anyAddr
+	FromAddr map[string]bool `protobuf:"bytes,1,rep,name=fromAddr,proto3" json:"fromAddr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ToAddr   map[string]bool `protobuf:"bytes,2,rep,name=toAddr,proto3" json:"toAddr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AnyAddr  map[string]bool `protobuf:"bytes,3,rep,name=anyAddr,proto3" json:"anyAddr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 交易action名称过滤
+	ActionName map[string]bool `protobuf:"bytes,4,rep,name=actionName,proto3" json:"actionName,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 交易执行结果过滤, 0:全部, 1:只推送执行成功的交易, 2:只推送执行失败的交易
+	ExecResult int32 `protobuf:"varint,5,opt,name=execResult,proto3" json:"execResult,omitempty"`
+}
+
+func (x *PushTxFilter) Reset() {
+	*x = PushTxFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushTxFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTxFilter) ProtoMessage() {}
+
+func (x *PushTxFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTxFilter.ProtoReflect.Descriptor instead.
+func (*PushTxFilter) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PushTxFilter) GetFromAddr() map[string]bool {
+	if x != nil {
+		return x.FromAddr
+	}
+	return nil
+}
+
+func (x *PushTxFilter) GetToAddr() map[string]bool {
+	if x != nil {
+		return x.ToAddr
+	}
+	return nil
+}
+
+func (x *PushTxFilter) GetAnyAddr() map[string]bool {
+	if x != nil {
+		return x.AnyAddr
+	}
+	return nil
+}
+
+func (x *PushTxFilter) GetActionName() map[string]bool {
+	if x != nil {
+		return x.ActionName
+	}
+	return nil
+}
+
+func (x *PushTxFilter) GetExecResult() int32 {
+	if x != nil {
+		return x.ExecResult
+	}
+	return 0
+}
+
 type PushWithStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Push *PushSubscribeReq `protobuf:"bytes,1,opt,name=push,proto3" json:"push,omitempty"`
-	// 1:active,2:noactive
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Push *PushSubscribeReq `protobuf:"bytes,1,opt,name=push,proto3" json:"push,omitempty"`
+	// 1:active,2:noactive,3:paused
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	//最近一次管理请求使用的nonce
+	ManageNonce int64 `protobuf:"varint,3,opt,name=manageNonce,proto3" json:"manageNonce,omitempty"`
+}
+
+func (x *PushWithStatus) Reset() {
+	*x = PushWithStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushWithStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWithStatus) ProtoMessage() {}
+
+func (x *PushWithStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWithStatus.ProtoReflect.Descriptor instead.
+func (*PushWithStatus) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PushWithStatus) GetPush() *PushSubscribeReq {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *PushWithStatus) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PushWithStatus) GetManageNonce() int64 {
+	if x != nil {
+		return x.ManageNonce
+	}
+	return 0
+}
+
+type PushSubscribes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pushes []*PushSubscribeReq `protobuf:"bytes,1,rep,name=pushes,proto3" json:"pushes,omitempty"`
+}
+
+func (x *PushSubscribes) Reset() {
+	*x = PushSubscribes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushSubscribes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSubscribes) ProtoMessage() {}
+
+func (x *PushSubscribes) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSubscribes.ProtoReflect.Descriptor instead.
+func (*PushSubscribes) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PushSubscribes) GetPushes() []*PushSubscribeReq {
+	if x != nil {
+		return x.Pushes
+	}
+	return nil
+}
+
+type ReplySubscribePush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsOk bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
+	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *ReplySubscribePush) Reset() {
+	*x = ReplySubscribePush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplySubscribePush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplySubscribePush) ProtoMessage() {}
+
+func (x *ReplySubscribePush) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplySubscribePush.ProtoReflect.Descriptor instead.
+func (*ReplySubscribePush) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReplySubscribePush) GetIsOk() bool {
+	if x != nil {
+		return x.IsOk
+	}
+	return false
+}
+
+func (x *ReplySubscribePush) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+//推送订阅的管理请求, 需要由订阅时设置的owner签名
+// 	 op : 1:暂停推送；2：恢复推送；3：删除订阅；4：重置已推送的sequence；5：修改推送的URL
+//	 nonce :必须大于该订阅上一次管理请求的nonce, 防止请求被重放
+type ReqManagePush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Op           int32      `protobuf:"varint,2,opt,name=op,proto3" json:"op,omitempty"`
+	LastSequence int64      `protobuf:"varint,3,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"`
+	URL          string     `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	Nonce        int64      `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature    *Signature `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ReqManagePush) Reset() {
+	*x = ReqManagePush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqManagePush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqManagePush) ProtoMessage() {}
+
+func (x *ReqManagePush) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqManagePush.ProtoReflect.Descriptor instead.
+func (*ReqManagePush) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReqManagePush) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReqManagePush) GetOp() int32 {
+	if x != nil {
+		return x.Op
+	}
+	return 0
+}
+
+func (x *ReqManagePush) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *ReqManagePush) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *ReqManagePush) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ReqManagePush) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// websocket或者SSE连接附加到推送订阅, attach为false时断开,
+// 附加时需要订阅owner对op为PushManageAttach的ReqManagePush签名, nonce需要大于上一次管理请求的nonce
+type ReqAttachPush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Attach    bool       `protobuf:"varint,2,opt,name=attach,proto3" json:"attach,omitempty"`
+	Nonce     int64      `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature *Signature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ReqAttachPush) Reset() {
+	*x = ReqAttachPush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqAttachPush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqAttachPush) ProtoMessage() {}
+
+func (x *ReqAttachPush) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqAttachPush.ProtoReflect.Descriptor instead.
+func (*ReqAttachPush) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReqAttachPush) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReqAttachPush) GetAttach() bool {
+	if x != nil {
+		return x.Attach
+	}
+	return false
+}
+
+func (x *ReqAttachPush) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ReqAttachPush) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// 通过websocket或者SSE推送的数据, data与http推送的数据编码相同
+type PushStreamData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type   int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Encode string `protobuf:"bytes,3,opt,name=encode,proto3" json:"encode,omitempty"`
+	Seq    int64  `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	Data   []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PushStreamData) Reset() {
+	*x = PushStreamData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushStreamData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushStreamData) ProtoMessage() {}
+
+func (x *PushStreamData) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushStreamData.ProtoReflect.Descriptor instead.
+func (*PushStreamData) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PushStreamData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PushStreamData) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PushStreamData) GetEncode() string {
+	if x != nil {
+		return x.Encode
+	}
+	return ""
+}
+
+func (x *PushStreamData) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PushStreamData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReqSubscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 0:代表区块；1:代表区块头信息；2：代表交易回执；5：代表交易状态变化(不支持contract和txFilter)；6：代表主链切换
+	Type int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	//允许订阅多个类型的交易回执
+	Contract map[string]bool `protobuf:"bytes,8,rep,name=contract,proto3" json:"contract,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	//交易回执的过滤条件
+	TxFilter *PushTxFilter `protobuf:"bytes,9,opt,name=txFilter,proto3" json:"txFilter,omitempty"`
+}
+
+func (x *ReqSubscribe) Reset() {
+	*x = ReqSubscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSubscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSubscribe) ProtoMessage() {}
+
+func (x *ReqSubscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSubscribe.ProtoReflect.Descriptor instead.
+func (*ReqSubscribe) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ReqSubscribe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReqSubscribe) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ReqSubscribe) GetContract() map[string]bool {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *ReqSubscribe) GetTxFilter() *PushTxFilter {
+	if x != nil {
+		return x.TxFilter
+	}
+	return nil
+}
+
+type SubscribeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 1:active,2:noactive
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SubscribeStatus) Reset() {
+	*x = SubscribeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeStatus) ProtoMessage() {}
+
+func (x *SubscribeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeStatus.ProtoReflect.Descriptor instead.
+func (*SubscribeStatus) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SubscribeStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscribeStatus) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 主链切换的记录, 回滚depth个区块后从共同祖先ancestor切换到新的主链
+//	 sequence :切换完成时的区块序列号, 没有记录区块序列时为0
+type ReorgRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index          int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	OldTipHash     []byte `protobuf:"bytes,2,opt,name=oldTipHash,proto3" json:"oldTipHash,omitempty"`
+	OldTipHeight   int64  `protobuf:"varint,3,opt,name=oldTipHeight,proto3" json:"oldTipHeight,omitempty"`
+	NewTipHash     []byte `protobuf:"bytes,4,opt,name=newTipHash,proto3" json:"newTipHash,omitempty"`
+	NewTipHeight   int64  `protobuf:"varint,5,opt,name=newTipHeight,proto3" json:"newTipHeight,omitempty"`
+	AncestorHash   []byte `protobuf:"bytes,6,opt,name=ancestorHash,proto3" json:"ancestorHash,omitempty"`
+	AncestorHeight int64  `protobuf:"varint,7,opt,name=ancestorHeight,proto3" json:"ancestorHeight,omitempty"`
+	Depth          int64  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Time           int64  `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+	Sequence       int64  `protobuf:"varint,10,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// 切换过程中失败, 已经回滚了部分区块, newTip为实际切换到的区块
+	Incomplete bool `protobuf:"varint,11,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+}
+
+func (x *ReorgRecord) Reset() {
+	*x = ReorgRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blockchain_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReorgRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorgRecord) ProtoMessage() {}
+
+func (x *ReorgRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorgRecord.ProtoReflect.Descriptor instead.
+func (*ReorgRecord) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReorgRecord) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetOldTipHash() []byte {
+	if x != nil {
+		return x.OldTipHash
+	}
+	return nil
+}
+
+func (x *ReorgRecord) GetOldTipHeight() int64 {
+	if x != nil {
+		return x.OldTipHeight
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetNewTipHash() []byte {
+	if x != nil {
+		return x.NewTipHash
+	}
+	return nil
+}
+
+func (x *ReorgRecord) GetNewTipHeight() int64 {
+	if x != nil {
+		return x.NewTipHeight
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetAncestorHash() []byte {
+	if x != nil {
+		return x.AncestorHash
+	}
+	return nil
+}
+
+func (x *ReorgRecord) GetAncestorHeight() int64 {
+	if x != nil {
+		return x.AncestorHeight
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ReorgRecord) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type ReorgRecords struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*ReorgRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (x *PushWithStatus) Reset() {
-	*x = PushWithStatus{}
+func (x *ReorgRecords) Reset() {
+	*x = ReorgRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockchain_proto_msgTypes[48]
+		mi := &file_blockchain_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushWithStatus) String() string {
+func (x *ReorgRecords) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushWithStatus) ProtoMessage() {}
+func (*ReorgRecords) ProtoMessage() {}
 
-func (x *PushWithStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_blockchain_proto_msgTypes[48]
+func (x *ReorgRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,50 +3952,45 @@ func (x *PushWithStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushWithStatus.ProtoReflect.Descriptor instead.
-func (*PushWithStatus) Descriptor() ([]byte, []int) {
-	return file_blockchain_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ReorgRecords.ProtoReflect.Descriptor instead.
+func (*ReorgRecords) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *PushWithStatus) GetPush() *PushSubscribeReq {
+func (x *ReorgRecords) GetRecords() []*ReorgRecord {
 	if x != nil {
-		return x.Push
+		return x.Records
 	}
 	return nil
 }
 
-func (x *PushWithStatus) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-type PushSubscribes struct {
+// 获取主链切换记录, 从index为start的记录开始向前获取count个, start小于等于0时从最新的记录开始
+type ReqReorgHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pushes []*PushSubscribeReq `protobuf:"bytes,1,rep,name=pushes,proto3" json:"pushes,omitempty"`
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *PushSubscribes) Reset() {
-	*x = PushSubscribes{}
+func (x *ReqReorgHistory) Reset() {
+	*x = ReqReorgHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockchain_proto_msgTypes[49]
+		mi := &file_blockchain_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PushSubscribes) String() string {
+func (x *ReqReorgHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushSubscribes) ProtoMessage() {}
+func (*ReqReorgHistory) ProtoMessage() {}
 
-func (x *PushSubscribes) ProtoReflect() protoreflect.Message {
-	mi := &file_blockchain_proto_msgTypes[49]
+func (x *ReqReorgHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3237,44 +4001,52 @@ func (x *PushSubscribes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushSubscribes.ProtoReflect.Descriptor instead.
-func (*PushSubscribes) Descriptor() ([]byte, []int) {
-	return file_blockchain_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ReqReorgHistory.ProtoReflect.Descriptor instead.
+func (*ReqReorgHistory) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PushSubscribes) GetPushes() []*PushSubscribeReq {
+func (x *ReqReorgHistory) GetStart() int64 {
 	if x != nil {
-		return x.Pushes
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-type ReplySubscribePush struct {
+func (x *ReqReorgHistory) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 检查点, 同步时拒绝与检查点区块hash冲突的分叉
+type BlockCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsOk bool   `protobuf:"varint,1,opt,name=isOk,proto3" json:"isOk,omitempty"`
-	Msg  string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *ReplySubscribePush) Reset() {
-	*x = ReplySubscribePush{}
+func (x *BlockCheckpoint) Reset() {
+	*x = BlockCheckpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockchain_proto_msgTypes[50]
+		mi := &file_blockchain_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplySubscribePush) String() string {
+func (x *BlockCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplySubscribePush) ProtoMessage() {}
+func (*BlockCheckpoint) ProtoMessage() {}
 
-func (x *ReplySubscribePush) ProtoReflect() protoreflect.Message {
-	mi := &file_blockchain_proto_msgTypes[50]
+func (x *BlockCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3285,54 +4057,50 @@ func (x *ReplySubscribePush) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplySubscribePush.ProtoReflect.Descriptor instead.
-func (*ReplySubscribePush) Descriptor() ([]byte, []int) {
-	return file_blockchain_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use BlockCheckpoint.ProtoReflect.Descriptor instead.
+func (*BlockCheckpoint) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ReplySubscribePush) GetIsOk() bool {
+func (x *BlockCheckpoint) GetHeight() int64 {
 	if x != nil {
-		return x.IsOk
+		return x.Height
 	}
-	return false
+	return 0
 }
 
-func (x *ReplySubscribePush) GetMsg() string {
+func (x *BlockCheckpoint) GetHash() []byte {
 	if x != nil {
-		return x.Msg
+		return x.Hash
 	}
-	return ""
+	return nil
 }
 
-type ReqSubscribe struct {
+type BlockCheckpoints struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// 0:代表区块；1:代表区块头信息；2：代表交易回执
-	Type int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	//允许订阅多个类型的交易回执
-	Contract map[string]bool `protobuf:"bytes,8,rep,name=contract,proto3" json:"contract,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Items []*BlockCheckpoint `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ReqSubscribe) Reset() {
-	*x = ReqSubscribe{}
+func (x *BlockCheckpoints) Reset() {
+	*x = BlockCheckpoints{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockchain_proto_msgTypes[51]
+		mi := &file_blockchain_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReqSubscribe) String() string {
+func (x *BlockCheckpoints) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqSubscribe) ProtoMessage() {}
+func (*BlockCheckpoints) ProtoMessage() {}
 
-func (x *ReqSubscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_blockchain_proto_msgTypes[51]
+func (x *BlockCheckpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3343,59 +4111,45 @@ func (x *ReqSubscribe) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqSubscribe.ProtoReflect.Descriptor instead.
-func (*ReqSubscribe) Descriptor() ([]byte, []int) {
-	return file_blockchain_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *ReqSubscribe) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ReqSubscribe) GetType() int32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
+// Deprecated: Use BlockCheckpoints.ProtoReflect.Descriptor instead.
+func (*BlockCheckpoints) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ReqSubscribe) GetContract() map[string]bool {
+func (x *BlockCheckpoints) GetItems() []*BlockCheckpoint {
 	if x != nil {
-		return x.Contract
+		return x.Items
 	}
 	return nil
 }
 
-type SubscribeStatus struct {
+// 轻节点从指定节点获取交易及其默克尔证明
+type ReqTxProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// 1:active,2:noactive
-	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pid  string `protobuf:"bytes,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *SubscribeStatus) Reset() {
-	*x = SubscribeStatus{}
+func (x *ReqTxProof) Reset() {
+	*x = ReqTxProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blockchain_proto_msgTypes[52]
+		mi := &file_blockchain_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeStatus) String() string {
+func (x *ReqTxProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeStatus) ProtoMessage() {}
+func (*ReqTxProof) ProtoMessage() {}
 
-func (x *SubscribeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_blockchain_proto_msgTypes[52]
+func (x *ReqTxProof) ProtoReflect() protoreflect.Message {
+	mi := &file_blockchain_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3406,23 +4160,23 @@ func (x *SubscribeStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeStatus.ProtoReflect.Descriptor instead.
-func (*SubscribeStatus) Descriptor() ([]byte, []int) {
-	return file_blockchain_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ReqTxProof.ProtoReflect.Descriptor instead.
+func (*ReqTxProof) Descriptor() ([]byte, []int) {
+	return file_blockchain_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SubscribeStatus) GetName() string {
+func (x *ReqTxProof) GetPid() string {
 	if x != nil {
-		return x.Name
+		return x.Pid
 	}
 	return ""
 }
 
-func (x *SubscribeStatus) GetStatus() int32 {
+func (x *ReqTxProof) GetHash() []byte {
 	if x != nil {
-		return x.Status
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
 var File_blockchain_proto protoreflect.FileDescriptor
@@ -3727,7 +4481,7 @@ var file_blockchain_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x44,
 	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x44,
 	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68,
+	0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x95, 0x03, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55,
@@ -3744,42 +4498,154 @@ var file_blockchain_proto_rawDesc = []byte{
 	0x72, 0x61, 0x63, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
 	0x52, 0x65, 0x71, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x43,
+	0x79, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x74,
+	0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x08, 0x74, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9a, 0x04, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x3d, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54,
+	0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x37, 0x0a, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a, 0x0a, 0x07, 0x61, 0x6e, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x41,
+	0x6e, 0x79, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6e, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x46, 0x72, 0x6f,
+	0x6d, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x6e, 0x79, 0x41, 0x64, 0x64, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x0e,
+	0x50, 0x75, 0x73, 0x68, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b,
+	0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73,
+	0x4f, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x50, 0x75, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x76, 0x0a, 0x0e, 0x50, 0x75,
+	0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x74, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x3b, 0x0a, 0x0d, 0x43,
 	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x75,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x41, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x75, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68,
-	0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x4f, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x73, 0x4f, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xb2,
-	0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdd, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x6f, 0x72,
+	0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x70, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x54, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x70, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x70, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x70, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x6f, 0x72, 0x67,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x52, 0x65, 0x6f, 0x72,
+	0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x40, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x32, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -3794,7 +4660,7 @@ func file_blockchain_proto_rawDescGZIP() []byte {
 	return file_blockchain_proto_rawDescData
 }
 
-var file_blockchain_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_blockchain_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_blockchain_proto_goTypes = []interface{}{
 	(*Header)(nil),               // 0: types.Header
 	(*Block)(nil),                // 1: types.Block
@@ -3844,23 +4710,37 @@ var file_blockchain_proto_goTypes = []interface{}{
 	(*ChunkInfo)(nil),            // 45: types.ChunkInfo
 	(*ReqChunkRecords)(nil),      // 46: types.ReqChunkRecords
 	(*PushSubscribeReq)(nil),     // 47: types.PushSubscribeReq
-	(*PushWithStatus)(nil),       // 48: types.PushWithStatus
-	(*PushSubscribes)(nil),       // 49: types.PushSubscribes
-	(*ReplySubscribePush)(nil),   // 50: types.ReplySubscribePush
-	(*ReqSubscribe)(nil),         // 51: types.ReqSubscribe
-	(*SubscribeStatus)(nil),      // 52: types.SubscribeStatus
-	nil,                          // 53: types.PushSubscribeReq.ContractEntry
-	nil,                          // 54: types.ReqSubscribe.ContractEntry
-	(*Signature)(nil),            // 55: types.Signature
-	(*Transaction)(nil),          // 56: types.Transaction
-	(*ReceiptData)(nil),          // 57: types.ReceiptData
-	(*KeyValue)(nil),             // 58: types.KeyValue
-	(*Receipt)(nil),              // 59: types.Receipt
+	(*PushTxFilter)(nil),         // 48: types.PushTxFilter
+	(*PushWithStatus)(nil),       // 49: types.PushWithStatus
+	(*PushSubscribes)(nil),       // 50: types.PushSubscribes
+	(*ReplySubscribePush)(nil),   // 51: types.ReplySubscribePush
+	(*ReqManagePush)(nil),        // 52: types.ReqManagePush
+	(*ReqAttachPush)(nil),        // 53: types.ReqAttachPush
+	(*PushStreamData)(nil),       // 54: types.PushStreamData
+	(*ReqSubscribe)(nil),         // 55: types.ReqSubscribe
+	(*SubscribeStatus)(nil),      // 56: types.SubscribeStatus
+	(*ReorgRecord)(nil),          // 57: types.ReorgRecord
+	(*ReorgRecords)(nil),         // 58: types.ReorgRecords
+	(*ReqReorgHistory)(nil),      // 59: types.ReqReorgHistory
+	(*BlockCheckpoint)(nil),      // 60: types.BlockCheckpoint
+	(*BlockCheckpoints)(nil),     // 61: types.BlockCheckpoints
+	(*ReqTxProof)(nil),           // 62: types.ReqTxProof
+	nil,                          // 63: types.PushSubscribeReq.ContractEntry
+	nil,                          // 64: types.PushTxFilter.FromAddrEntry
+	nil,                          // 65: types.PushTxFilter.ToAddrEntry
+	nil,                          // 66: types.PushTxFilter.AnyAddrEntry
+	nil,                          // 67: types.PushTxFilter.ActionNameEntry
+	nil,                          // 68: types.ReqSubscribe.ContractEntry
+	(*Signature)(nil),            // 69: types.Signature
+	(*Transaction)(nil),          // 70: types.Transaction
+	(*ReceiptData)(nil),          // 71: types.ReceiptData
+	(*KeyValue)(nil),             // 72: types.KeyValue
+	(*Receipt)(nil),              // 73: types.Receipt
 }
 var file_blockchain_proto_depIdxs = []int32{
-	55, // 0: types.Header.signature:type_name -> types.Signature
-	55, // 1: types.Block.signature:type_name -> types.Signature
-	56, // 2: types.Block.txs:type_name -> types.Transaction
+	69, // 0: types.Header.signature:type_name -> types.Signature
+	69, // 1: types.Block.signature:type_name -> types.Signature
+	70, // 2: types.Block.txs:type_name -> types.Transaction
 	1,  // 3: types.Blocks.items:type_name -> types.Block
 	23, // 4: types.BlockSeq.seq:type_name -> types.BlockSequence
 	10, // 5: types.BlockSeq.detail:type_name -> types.BlockDetail
@@ -3871,20 +4751,20 @@ var file_blockchain_proto_depIdxs = []int32{
 	7,  // 10: types.HeadersPid.headers:type_name -> types.Headers
 	0,  // 11: types.BlockOverview.head:type_name -> types.Header
 	1,  // 12: types.BlockDetail.block:type_name -> types.Block
-	57, // 13: types.BlockDetail.receipts:type_name -> types.ReceiptData
-	58, // 14: types.BlockDetail.KV:type_name -> types.KeyValue
-	59, // 15: types.Receipts.receipts:type_name -> types.Receipt
-	56, // 16: types.BlockBody.txs:type_name -> types.Transaction
-	57, // 17: types.BlockBody.receipts:type_name -> types.ReceiptData
-	57, // 18: types.BlockReceipt.receipts:type_name -> types.ReceiptData
-	58, // 19: types.BlockKVs.KVs:type_name -> types.KeyValue
+	71, // 13: types.BlockDetail.receipts:type_name -> types.ReceiptData
+	72, // 14: types.BlockDetail.KV:type_name -> types.KeyValue
+	73, // 15: types.Receipts.receipts:type_name -> types.Receipt
+	70, // 16: types.BlockBody.txs:type_name -> types.Transaction
+	71, // 17: types.BlockBody.receipts:type_name -> types.ReceiptData
+	71, // 18: types.BlockReceipt.receipts:type_name -> types.ReceiptData
+	72, // 19: types.BlockKVs.KVs:type_name -> types.KeyValue
 	23, // 20: types.BlockSequences.items:type_name -> types.BlockSequence
 	10, // 21: types.ParaChainBlockDetail.blockdetail:type_name -> types.BlockDetail
 	27, // 22: types.ParaTxDetails.items:type_name -> types.ParaTxDetail
 	0,  // 23: types.ParaTxDetail.header:type_name -> types.Header
 	28, // 24: types.ParaTxDetail.txDetails:type_name -> types.TxDetail
-	56, // 25: types.TxDetail.tx:type_name -> types.Transaction
-	57, // 26: types.TxDetail.receipt:type_name -> types.ReceiptData
+	70, // 25: types.TxDetail.tx:type_name -> types.Transaction
+	71, // 26: types.TxDetail.receipt:type_name -> types.ReceiptData
 	23, // 27: types.HeaderSeq.seq:type_name -> types.BlockSequence
 	0,  // 28: types.HeaderSeq.header:type_name -> types.Header
 	32, // 29: types.HeaderSeqs.seqs:type_name -> types.HeaderSeq
@@ -3893,15 +4773,25 @@ var file_blockchain_proto_depIdxs = []int32{
 	1,  // 32: types.CmpBlock.block:type_name -> types.Block
 	17, // 33: types.BlockBodys.items:type_name -> types.BlockBody
 	45, // 34: types.ChunkRecords.infos:type_name -> types.ChunkInfo
-	53, // 35: types.PushSubscribeReq.contract:type_name -> types.PushSubscribeReq.ContractEntry
-	47, // 36: types.PushWithStatus.push:type_name -> types.PushSubscribeReq
-	47, // 37: types.PushSubscribes.pushes:type_name -> types.PushSubscribeReq
-	54, // 38: types.ReqSubscribe.contract:type_name -> types.ReqSubscribe.ContractEntry
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	63, // 35: types.PushSubscribeReq.contract:type_name -> types.PushSubscribeReq.ContractEntry
+	48, // 36: types.PushSubscribeReq.txFilter:type_name -> types.PushTxFilter
+	64, // 37: types.PushTxFilter.fromAddr:type_name -> types.PushTxFilter.FromAddrEntry
+	65, // 38: types.PushTxFilter.toAddr:type_name -> types.PushTxFilter.ToAddrEntry
+	66, // 39: types.PushTxFilter.anyAddr:type_name -> types.PushTxFilter.AnyAddrEntry
+	67, // 40: types.PushTxFilter.actionName:type_name -> types.PushTxFilter.ActionNameEntry
+	47, // 41: types.PushWithStatus.push:type_name -> types.PushSubscribeReq
+	47, // 42: types.PushSubscribes.pushes:type_name -> types.PushSubscribeReq
+	69, // 43: types.ReqManagePush.signature:type_name -> types.Signature
+	69, // 44: types.ReqAttachPush.signature:type_name -> types.Signature
+	68, // 45: types.ReqSubscribe.contract:type_name -> types.ReqSubscribe.ContractEntry
+	48, // 46: types.ReqSubscribe.txFilter:type_name -> types.PushTxFilter
+	57, // 47: types.ReorgRecords.records:type_name -> types.ReorgRecord
+	60, // 48: types.BlockCheckpoints.items:type_name -> types.BlockCheckpoint
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_blockchain_proto_init() }
@@ -4489,7 +5379,7 @@ func file_blockchain_proto_init() {
 			}
 		}
 		file_blockchain_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushWithStatus); i {
+			switch v := v.(*PushTxFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4501,7 +5391,7 @@ func file_blockchain_proto_init() {
 			}
 		}
 		file_blockchain_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushSubscribes); i {
+			switch v := v.(*PushWithStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4513,7 +5403,7 @@ func file_blockchain_proto_init() {
 			}
 		}
 		file_blockchain_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplySubscribePush); i {
+			switch v := v.(*PushSubscribes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4525,7 +5415,7 @@ func file_blockchain_proto_init() {
 			}
 		}
 		file_blockchain_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqSubscribe); i {
+			switch v := v.(*ReplySubscribePush); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4537,6 +5427,54 @@ func file_blockchain_proto_init() {
 			}
 		}
 		file_blockchain_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqManagePush); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqAttachPush); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushStreamData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSubscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeStatus); i {
 			case 0:
 				return &v.state
@@ -4548,6 +5486,78 @@ func file_blockchain_proto_init() {
 				return nil
 			}
 		}
+		file_blockchain_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorgRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorgRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqReorgHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockCheckpoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blockchain_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTxProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4555,7 +5565,7 @@ func file_blockchain_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_blockchain_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   55,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -118,6 +118,26 @@ type Mempool struct {
 	DisableExecCheck bool `json:"disableExecCheck,omitempty"`
 	//开启ETH交易类型的检查
 	EnableEthCheck bool `json:"enableEthCheck,omitempty"`
+	// 开启交易日志持久化, 节点重启后重新加载未打包的交易
+	EnableJournal bool `json:"enableJournal,omitempty"`
+	// 交易日志存储路径, 默认datadir/mempool
+	JournalPath string `json:"journalPath,omitempty"`
+	// 交易日志数据库驱动, 默认leveldb
+	JournalDriver string `json:"journalDriver,omitempty"`
+	// 交易日志重写周期, 单位秒, 默认3600
+	JournalRotate int64 `json:"journalRotate,omitempty"`
+	// eth交易允许超前当前nonce的最大距离, 默认64
+	MaxNonceGap int64 `json:"maxNonceGap,omitempty"`
+	// 每个账户等待前序nonce的eth交易最大数量, 默认16
+	MaxQueuedTxPerAccount int64 `json:"maxQueuedTxPerAccount,omitempty"`
+	// 等待前序nonce的eth交易总数量, 默认1024
+	MaxQueuedTxs int64 `json:"maxQueuedTxs,omitempty"`
+	// 等待前序nonce的eth交易过期时间, 单位秒, 默认600
+	QueuedTxExpire int64 `json:"queuedTxExpire,omitempty"`
+	// 替换相同nonce的eth交易时, 新交易手续费需要高出的百分比, 默认10
+	PriceBump int64 `json:"priceBump,omitempty"`
+	// 记录最近交易状态变化的交易数量, 默认10240
+	TxStatusCacheSize int64 `json:"txStatusCacheSize,omitempty"`
 }
 
 // Consensus 配置
@@ -159,7 +179,7 @@ type Wallet struct {
 type Store struct {
 	// 数据存储格式名称，目前支持mavl,kvdb,kvmvcc,mpt
 	Name string `json:"name,omitempty"`
-	// 数据存储驱动类别，目前支持leveldb,goleveldb,memdb,gobadgerdb,ssdb,pegasus
+	// 数据存储驱动类别，目前支持leveldb,goleveldb,memdb,gobadgerdb,pebble,ssdb,pegasus
 	Driver string `json:"driver,omitempty"`
 	// 数据文件存储路径
 	DbPath string `json:"dbPath,omitempty"`
@@ -234,6 +254,22 @@ type BlockChain struct {
 	DisableClockDriftCheck bool `json:"disableClockDriftCheck,omitempty"`
 	//保存每个区块的block　kvs
 	EnableSaveBlockKVs bool `json:"enableSaveBlockKVs,omitempty"`
+	// 使能状态快照同步, 新节点直接同步最近高度的mavl状态树, 不再从创世区块开始执行区块
+	EnableSnapshotSync bool `json:"enableSnapshotSync,omitempty"`
+	// 落后最高节点超过该区块数时才启用状态快照同步
+	SnapshotSyncMinGap int64 `json:"snapshotSyncMinGap,omitempty"`
+	// 主链切换允许回滚的最大区块数, 超过时拒绝切换到竞争分叉, 0表示不限制
+	MaxReorgDepth int64 `json:"maxReorgDepth,omitempty"`
+	// 检查点, 同步时拒绝与检查点区块hash冲突的分叉, 与chaincfg中注册的检查点合并, 相同高度以配置文件为准
+	Checkpoints []*CheckpointConfig `json:"checkpoints,omitempty"`
+	// 轻节点模式, 只同步并校验区块头, 交易及其默克尔证明按需从全节点获取, 不运行执行器和状态存储模块
+	LightMode bool `json:"lightMode,omitempty"`
+}
+
+// CheckpointConfig 检查点配置, 区块高度对应的区块hash(hex)
+type CheckpointConfig struct {
+	Height int64  `json:"height,omitempty"`
+	Hash   string `json:"hash,omitempty"`
 }
 
 // P2P 配置
@@ -303,6 +339,12 @@ type Exec struct {
 	DisableFeeIndex    bool `json:"disableFeeIndex,omitempty"`
 	DisableTxDupCheck  bool `json:"disableTxDupCheck,omitempty"`
 	DisableExecLocal   bool `json:"disableExecLocal,omitempty"`
+	// 是否关闭evm日志布隆过滤器索引, 关闭后eth_getLogs需要逐个区块扫描
+	DisableEvmLogIndex bool `json:"disableEvmLogIndex,omitempty"`
+	// 是否开启交易并行执行, 需要同时达到ForkParallelExec分叉高度
+	EnableParallelExec bool `json:"enableParallelExec,omitempty"`
+	// 并行执行交易的协程数, 默认为cpu核数
+	ParallelExecWorkers int32 `json:"parallelExecWorkers,omitempty"`
 }
 
 // Pprof 配置
@@ -24,3 +24,18 @@ func Load(name string) string {
 func LoadAll() map[string]string {
 	return configMap
 }
+
+var checkpointMap = make(map[string]map[int64]string)
+
+// RegisterCheckpoints 注册指定title的链内置的检查点, height--->block hash(hex)
+func RegisterCheckpoints(title string, checkpoints map[int64]string) {
+	if _, ok := checkpointMap[title]; ok {
+		panic("chain checkpoints of " + title + " is exist")
+	}
+	checkpointMap[title] = checkpoints
+}
+
+// LoadCheckpoints 加载指定title的链内置的检查点
+func LoadCheckpoints(title string) map[int64]string {
+	return checkpointMap[title]
+}
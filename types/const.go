@@ -131,6 +131,37 @@ const (
 	ExecOk   = 2
 )
 
+//tx status 交易在mempool和区块链中的状态
+const (
+	TxStatusUnknown = iota
+	//进入mempool等待打包
+	TxStatusPending
+	//等待前序nonce的交易
+	TxStatusQueued
+	//已被区块打包, 只记录在本节点mempool中出现过的交易
+	TxStatusPacked
+	//在mempool中过期
+	TxStatusExpired
+	//mempool已满时被淘汰
+	TxStatusEvicted
+	//被相同nonce且手续费更高的交易替换
+	TxStatusReplaced
+	//未通过本节点的检查被拒绝, 包括从其他节点广播过来的交易
+	TxStatusRejected
+	//所在区块被回滚
+	TxStatusReorged
+)
+
+var txStatusName = [...]string{"unknown", "pending", "queued", "packed", "expired", "evicted", "replaced", "rejected", "reorged"}
+
+//TxStatusName 交易状态名称
+func TxStatusName(status int32) string {
+	if status < 0 || int(status) >= len(txStatusName) {
+		return txStatusName[TxStatusUnknown]
+	}
+	return txStatusName[status]
+}
+
 // TODO 后续调试确认放的位置
 //func init() {
 //	S("TxHeight", false)
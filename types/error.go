@@ -72,6 +72,9 @@ var (
 	ErrManyTx                     = errors.New("ErrManyTx")
 	ErrDupTx                      = errors.New("ErrDupTx")
 	ErrMemFull                    = errors.New("ErrMemFull")
+	ErrNonceTooHigh               = errors.New("ErrNonceTooHigh")
+	ErrDupNonce                   = errors.New("ErrDupNonce")
+	ErrReplaceUnderpriced         = errors.New("ErrReplaceUnderpriced")
 	ErrNoBalance                  = errors.New("ErrNoBalance")
 	ErrBalanceLessThanTenTimesFee = errors.New("ErrBalanceLessThanTenTimesFee")
 	ErrTxExpire                   = errors.New("ErrTxExpire")
@@ -108,6 +111,10 @@ var (
 	ErrDecode                 = errors.New("ErrDecode")
 	ErrNotRollBack            = errors.New("ErrNotRollBack")
 	ErrPeerInfoIsNil          = errors.New("ErrPeerInfoIsNil")
+	ErrReorgTooDeep           = errors.New("ErrReorgTooDeep")
+	ErrCheckpointMismatch     = errors.New("ErrCheckpointMismatch")
+	ErrLightHeaderInvalid     = errors.New("ErrLightHeaderInvalid")
+	ErrTxProofInvalid         = errors.New("ErrTxProofInvalid")
 	//ErrWalletIsLocked wallet
 	ErrWalletIsLocked       = errors.New("ErrWalletIsLocked")
 	ErrSaveSeedFirst        = errors.New("ErrSaveSeedFirst")
@@ -205,6 +212,15 @@ var (
 	ErrNotAllowModifyPush = errors.New("ErrNotAllowModifyPush")
 	ErrTxReceiptReduced   = errors.New("ErrTxReceiptReduced")
 	ErrPushNotSubscribed  = errors.New("ErrPushNotSubscribed")
+	ErrPushNoOwner        = errors.New("ErrPushNoOwner")
+	ErrPushNotOwner       = errors.New("ErrPushNotOwner")
+	ErrPushPaused         = errors.New("ErrPushPaused")
+	ErrPushManageNonce    = errors.New("ErrPushManageNonce")
+	ErrPushStreamAttached = errors.New("ErrPushStreamAttached")
+	ErrPushNotAttached    = errors.New("ErrPushNotAttached")
 	ErrTxChainID          = errors.New("ErrTxChainID")
 	ErrTimeout            = errors.New("ErrTimeout")
+
+	ErrStateProofRoot    = errors.New("ErrStateProofRoot")
+	ErrStateProofInvalid = errors.New("ErrStateProofInvalid")
 )
@@ -209,6 +209,46 @@ const (
 	//返回节点中最高的区块高度
 	EventHighestBlock = 370
 	EventGetEvmNonce  = 371
+
+	//状态快照同步
+	//从指定节点获取mavl状态树节点
+	EventFetchStateSnapshot = 372
+	//从指定节点获取快照高度的区块
+	EventFetchSnapshotBlock = 373
+	//从store中读取mavl状态树节点
+	EventStoreGetSnapshot = 374
+	//校验并导入mavl状态树节点到store
+	EventStoreImportSnapshot = 375
+	//校验导入完成的mavl状态树
+	EventStoreCheckSnapshot = 376
+	//获取状态数据的merkle证明
+	EventStoreGetProof = 377
+	//在父区块状态上重新执行交易并返回执行追踪
+	EventTraceTx = 378
+	//获取交易最近的状态变化
+	EventGetTxStatus = 379
+	//订阅或取消订阅交易状态变化的推送
+	EventSubTxStatus = 380
+	//推送交易状态变化
+	EventPushTxStatus = 381
+	//管理推送订阅
+	EventManagePush = 382
+	//websocket或者SSE连接附加到推送订阅
+	EventAttachPush = 383
+	//通过websocket或者SSE连接推送订阅的数据
+	EventPushStream = 384
+	//推送主链切换记录
+	EventPushReorg = 385
+	//获取主链切换的历史记录
+	EventGetReorgHistory = 386
+	//获取区块同步的检查点
+	EventGetCheckpoints = 387
+	//轻节点从指定节点获取交易及其默克尔证明
+	EventFetchTxProof = 388
+	//共识模块校验轻节点同步的区块头
+	EventCheckHeader = 389
+	//状态快照同步时从指定节点获取快照高度之前的区块头
+	EventFetchSnapshotHeaders = 390
 )
 
 var eventName = map[int]string{
@@ -379,4 +419,23 @@ var eventName = map[int]string{
 	EventPushTxResult:               "EventPushTxResult",
 	EventHighestBlock:               "EventHighestBlock",
 	EventGetEvmNonce:                "EventGetEvmNonce",
+	EventFetchStateSnapshot:         "EventFetchStateSnapshot",
+	EventFetchSnapshotBlock:         "EventFetchSnapshotBlock",
+	EventStoreGetSnapshot:           "EventStoreGetSnapshot",
+	EventStoreImportSnapshot:        "EventStoreImportSnapshot",
+	EventStoreCheckSnapshot:         "EventStoreCheckSnapshot",
+	EventStoreGetProof:              "EventStoreGetProof",
+	EventTraceTx:                    "EventTraceTx",
+	EventGetTxStatus:                "EventGetTxStatus",
+	EventSubTxStatus:                "EventSubTxStatus",
+	EventPushTxStatus:               "EventPushTxStatus",
+	EventManagePush:                 "EventManagePush",
+	EventAttachPush:                 "EventAttachPush",
+	EventPushStream:                 "EventPushStream",
+	EventPushReorg:                  "EventPushReorg",
+	EventGetReorgHistory:            "EventGetReorgHistory",
+	EventGetCheckpoints:             "EventGetCheckpoints",
+	EventFetchTxProof:               "EventFetchTxProof",
+	EventCheckHeader:                "EventCheckHeader",
+	EventFetchSnapshotHeaders:       "EventFetchSnapshotHeaders",
 }
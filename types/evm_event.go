@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "bytes"
+
+// evm合约内部定义的log类型, 由evm合约初始化时注册, 未注册时不解析evm日志
+var (
+	// 合约调用/创建的收据, 包含合约地址和消耗的gas
+	tyLogEVMContract int32 = -1
+	// 合约event日志
+	tyLogEVMEventData int32 = -1
+)
+
+// RegisterEVMLogTy evm合约注册合约收据和event日志的log类型, 需要在初始化时调用
+func RegisterEVMLogTy(contractTy, eventDataTy int32) {
+	tyLogEVMContract, tyLogEVMEventData = contractTy, eventDataTy
+}
+
+// EvmExecName evm执行器名称
+var EvmExecName = []byte("evm")
+
+// DecodeEVMTxLogs 解析执行成功的evm交易产生的合约地址和event日志, 非evm交易返回空
+func DecodeEVMTxLogs(tx *Transaction, receipt *ReceiptData) (contractAddr string, logs []*EVMLog, err error) {
+	if receipt.GetTy() != ExecOk || !bytes.Equal(GetRealExecName(tx.Execer), EvmExecName) {
+		return "", nil, nil
+	}
+	for _, l := range receipt.GetLogs() {
+		switch l.Ty {
+		case tyLogEVMEventData:
+			var evmLog EVMLog
+			if err := Decode(l.Log, &evmLog); err != nil {
+				return "", nil, err
+			}
+			logs = append(logs, &evmLog)
+		case tyLogEVMContract:
+			//合约收据类型定义在evm合约内部, 未加载evm合约时从交易中获取
+			msg, err := DecodeLog(tx.Execer, int64(l.Ty), l.Log)
+			if err != nil {
+				continue
+			}
+			if r, ok := msg.(interface{ GetContractAddr() string }); ok {
+				contractAddr = r.GetContractAddr()
+			}
+		}
+	}
+	if contractAddr == "" {
+		var action EVMContractAction4Chain33
+		if err := Decode(tx.Payload, &action); err == nil {
+			contractAddr = action.ContractAddr
+		}
+	}
+	return contractAddr, logs, nil
+}
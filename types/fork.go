@@ -142,6 +142,8 @@ func (f *Forks) SetTestNetFork() {
 	f.SetFork("ForkTicketFundAddrV1", 3350000)
 	f.SetFork("ForkRootHash", 4500000)
 	f.SetFork(address.ForkFormatAddressKey, 0)
+	f.SetFork("ForkParallelExec", MaxHeight)
+	f.SetFork("ForkBtcScriptHeightLock", MaxHeight)
 }
 
 func (f *Forks) setLocalFork() {
@@ -25,6 +25,8 @@ var (
 	ConsensusParaTxsPrefix = []byte("LODBP:Consensus:Para:")            //存贮para共识模块从主链拉取的平行链交易
 	FlagReduceLocaldb      = []byte("FLAG:ReduceLocaldb")               // 精简版localdb标记
 	ReduceLocaldbHeight    = append(FlagReduceLocaldb, []byte(":H")...) // 精简版localdb高度
+	EvmLogBloomPrefix      = []byte("EvmLogBloom:")                     // 区块evm日志的地址和topic布隆过滤器
+	FlagEvmLogIndex        = []byte("FLAG:EvmLogIndex")                 // evm日志索引的起始高度
 )
 
 // GetLocalDBKeyList 获取localdb的key列表
@@ -95,6 +97,11 @@ func TotalFeeKey(hash []byte) []byte {
 	return append(key, hash...)
 }
 
+//CalcEvmLogBloomKey 存储区块evm日志的布隆过滤器, key=EvmLogBloom:height
+func CalcEvmLogBloomKey(height int64) []byte {
+	return append(EvmLogBloomPrefix, []byte(fmt.Sprintf("%012d", height))...)
+}
+
 //CalcLocalPrefix 计算localdb key
 func CalcLocalPrefix(execer []byte) []byte {
 	s := append([]byte("LODB-"), execer...)
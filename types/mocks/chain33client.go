@@ -887,6 +887,36 @@ func (_m *Chain33Client) GetFork(ctx context.Context, in *types.ReqKey, opts ...
 	return r0, r1
 }
 
+// GetHTLCAddress provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) GetHTLCAddress(ctx context.Context, in *types.ReqGetHTLCAddr, opts ...grpc.CallOption) (*types.ReplyString, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyString
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqGetHTLCAddr, ...grpc.CallOption) *types.ReplyString); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyString)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqGetHTLCAddr, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHeaders provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) GetHeaders(ctx context.Context, in *types.ReqBlocks, opts ...grpc.CallOption) (*types.Headers, error) {
 	_va := make([]interface{}, len(opts))
@@ -2147,6 +2177,36 @@ func (_m *Chain33Client) SetTxFee(ctx context.Context, in *types.ReqWalletSetFee
 	return r0, r1
 }
 
+// SignHTLCTx provides a mock function with given fields: ctx, in, opts
+func (_m *Chain33Client) SignHTLCTx(ctx context.Context, in *types.ReqSignHTLCTx, opts ...grpc.CallOption) (*types.ReplySignRawTx, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplySignRawTx
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSignHTLCTx, ...grpc.CallOption) *types.ReplySignRawTx); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplySignRawTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSignHTLCTx, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignRawTx provides a mock function with given fields: ctx, in, opts
 func (_m *Chain33Client) SignRawTx(ctx context.Context, in *types.ReqSignRawTx, opts ...grpc.CallOption) (*types.ReplySignRawTx, error) {
 	_va := make([]interface{}, len(opts))
@@ -373,6 +373,8 @@ type PushData struct {
 	//	*PushData_TxReceipts
 	//	*PushData_TxResult
 	//	*PushData_EvmLogs
+	//	*PushData_TxStatus
+	//	*PushData_Reorgs
 	Value isPushData_Value `protobuf_oneof:"value"`
 }
 
@@ -457,6 +459,20 @@ func (x *PushData) GetEvmLogs() *EVMTxLogsInBlks {
 	return nil
 }
 
+func (x *PushData) GetTxStatus() *TxStatusHistory {
+	if x, ok := x.GetValue().(*PushData_TxStatus); ok {
+		return x.TxStatus
+	}
+	return nil
+}
+
+func (x *PushData) GetReorgs() *ReorgRecords {
+	if x, ok := x.GetValue().(*PushData_Reorgs); ok {
+		return x.Reorgs
+	}
+	return nil
+}
+
 type isPushData_Value interface {
 	isPushData_Value()
 }
@@ -481,6 +497,14 @@ type PushData_EvmLogs struct {
 	EvmLogs *EVMTxLogsInBlks `protobuf:"bytes,6,opt,name=evmLogs,proto3,oneof"`
 }
 
+type PushData_TxStatus struct {
+	TxStatus *TxStatusHistory `protobuf:"bytes,7,opt,name=txStatus,proto3,oneof"`
+}
+
+type PushData_Reorgs struct {
+	Reorgs *ReorgRecords `protobuf:"bytes,8,opt,name=reorgs,proto3,oneof"`
+}
+
 func (*PushData_BlockSeqs) isPushData_Value() {}
 
 func (*PushData_HeaderSeqs) isPushData_Value() {}
@@ -491,6 +515,10 @@ func (*PushData_TxResult) isPushData_Value() {}
 
 func (*PushData_EvmLogs) isPushData_Value() {}
 
+func (*PushData_TxStatus) isPushData_Value() {}
+
+func (*PushData_Reorgs) isPushData_Value() {}
+
 var File_push_tx_receipt_proto protoreflect.FileDescriptor
 
 var file_push_tx_receipt_proto_rawDesc = []byte{
@@ -544,7 +572,7 @@ var file_push_tx_receipt_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x71, 0x73, 0x12, 0x2d,
 	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x65,
-	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb4, 0x02,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x99, 0x03,
 	0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
 	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -563,10 +591,17 @@ var file_push_tx_receipt_proto_rawDesc = []byte{
 	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f,
 	0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x45, 0x56, 0x4d, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x6b, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x00, 0x52, 0x07, 0x65, 0x76, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x74,
+	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x74, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -594,6 +629,8 @@ var file_push_tx_receipt_proto_goTypes = []interface{}{
 	(*BlockSeqs)(nil),                  // 8: types.BlockSeqs
 	(*HeaderSeqs)(nil),                 // 9: types.HeaderSeqs
 	(*EVMTxLogsInBlks)(nil),            // 10: types.EVMTxLogsInBlks
+	(*TxStatusHistory)(nil),            // 11: types.TxStatusHistory
+	(*ReorgRecords)(nil),               // 12: types.ReorgRecords
 }
 var file_push_tx_receipt_proto_depIdxs = []int32{
 	6,  // 0: types.TxReceipts4SubscribePerBlk.tx:type_name -> types.Transaction
@@ -606,11 +643,13 @@ var file_push_tx_receipt_proto_depIdxs = []int32{
 	1,  // 7: types.PushData.txReceipts:type_name -> types.TxReceipts4Subscribe
 	4,  // 8: types.PushData.txResult:type_name -> types.TxResultSeqs
 	10, // 9: types.PushData.evmLogs:type_name -> types.EVMTxLogsInBlks
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 10: types.PushData.txStatus:type_name -> types.TxStatusHistory
+	12, // 11: types.PushData.reorgs:type_name -> types.ReorgRecords
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_push_tx_receipt_proto_init() }
@@ -701,6 +740,8 @@ func file_push_tx_receipt_proto_init() {
 		(*PushData_TxReceipts)(nil),
 		(*PushData_TxResult)(nil),
 		(*PushData_EvmLogs)(nil),
+		(*PushData_TxStatus)(nil),
+		(*PushData_Reorgs)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -473,6 +473,158 @@ func (x *ReqSignWalletRecoverTx) GetRawTx() string {
 	return ""
 }
 
+// 获取哈希时间锁定(HTLC)地址请求
+type ReqGetHTLCAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashLock       string `protobuf:"bytes,1,opt,name=hashLock,proto3" json:"hashLock,omitempty"`
+	ReceiverPubKey string `protobuf:"bytes,2,opt,name=receiverPubKey,proto3" json:"receiverPubKey,omitempty"`
+	RefundPubKey   string `protobuf:"bytes,3,opt,name=refundPubKey,proto3" json:"refundPubKey,omitempty"`
+	LockTime       int64  `protobuf:"varint,4,opt,name=lockTime,proto3" json:"lockTime,omitempty"`
+}
+
+func (x *ReqGetHTLCAddr) Reset() {
+	*x = ReqGetHTLCAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqGetHTLCAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqGetHTLCAddr) ProtoMessage() {}
+
+func (x *ReqGetHTLCAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqGetHTLCAddr.ProtoReflect.Descriptor instead.
+func (*ReqGetHTLCAddr) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReqGetHTLCAddr) GetHashLock() string {
+	if x != nil {
+		return x.HashLock
+	}
+	return ""
+}
+
+func (x *ReqGetHTLCAddr) GetReceiverPubKey() string {
+	if x != nil {
+		return x.ReceiverPubKey
+	}
+	return ""
+}
+
+func (x *ReqGetHTLCAddr) GetRefundPubKey() string {
+	if x != nil {
+		return x.RefundPubKey
+	}
+	return ""
+}
+
+func (x *ReqGetHTLCAddr) GetLockTime() int64 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+// HTLC交易签名请求, preimage为空时为超时退回签名
+type ReqSignHTLCTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HtlcParam *ReqGetHTLCAddr `protobuf:"bytes,1,opt,name=htlcParam,proto3" json:"htlcParam,omitempty"`
+	SignAddr  string          `protobuf:"bytes,2,opt,name=signAddr,proto3" json:"signAddr,omitempty"`
+	PrivKey   string          `protobuf:"bytes,3,opt,name=privKey,proto3" json:"privKey,omitempty"`
+	RawTx     string          `protobuf:"bytes,4,opt,name=rawTx,proto3" json:"rawTx,omitempty"`
+	Preimage  string          `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (x *ReqSignHTLCTx) Reset() {
+	*x = ReqSignHTLCTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSignHTLCTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSignHTLCTx) ProtoMessage() {}
+
+func (x *ReqSignHTLCTx) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSignHTLCTx.ProtoReflect.Descriptor instead.
+func (*ReqSignHTLCTx) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReqSignHTLCTx) GetHtlcParam() *ReqGetHTLCAddr {
+	if x != nil {
+		return x.HtlcParam
+	}
+	return nil
+}
+
+func (x *ReqSignHTLCTx) GetSignAddr() string {
+	if x != nil {
+		return x.SignAddr
+	}
+	return ""
+}
+
+func (x *ReqSignHTLCTx) GetPrivKey() string {
+	if x != nil {
+		return x.PrivKey
+	}
+	return ""
+}
+
+func (x *ReqSignHTLCTx) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+func (x *ReqSignHTLCTx) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
 type ChainConfigInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -494,7 +646,7 @@ type ChainConfigInfo struct {
 func (x *ChainConfigInfo) Reset() {
 	*x = ChainConfigInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[8]
+		mi := &file_rpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -507,7 +659,7 @@ func (x *ChainConfigInfo) String() string {
 func (*ChainConfigInfo) ProtoMessage() {}
 
 func (x *ChainConfigInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[8]
+	mi := &file_rpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +672,7 @@ func (x *ChainConfigInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChainConfigInfo.ProtoReflect.Descriptor instead.
 func (*ChainConfigInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{8}
+	return file_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ChainConfigInfo) GetTitle() string {
@@ -612,7 +764,7 @@ type Replies struct {
 func (x *Replies) Reset() {
 	*x = Replies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[9]
+		mi := &file_rpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -625,7 +777,7 @@ func (x *Replies) String() string {
 func (*Replies) ProtoMessage() {}
 
 func (x *Replies) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[9]
+	mi := &file_rpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +790,7 @@ func (x *Replies) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Replies.ProtoReflect.Descriptor instead.
 func (*Replies) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{9}
+	return file_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Replies) GetReplyList() []*Reply {
@@ -660,51 +812,72 @@ var file_rpc_proto_rawDesc = []byte{
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x65,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70,
 	0x75, 0x73, 0x68, 0x5f, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34,
-	0x0a, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x79, 0x70, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79,
-	0x70, 0x65, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x27, 0x0a, 0x07, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x52, 0x07, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x52, 0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x07, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x54, 0x78, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x64,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01,
-	0x0a, 0x17, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x74, 0x72,
-	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x74,
-	0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12,
-	0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x01,
-	0x0a, 0x16, 0x52, 0x65, 0x71, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x78, 0x12, 0x4e, 0x0a, 0x12, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x52, 0x12, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x61, 0x77, 0x54, 0x78, 0x22, 0xf3, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x08, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x44, 0x22, 0x35,
+	0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x07,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x52, 0x07, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x73, 0x22, 0x3b, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79,
+	0x70, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65,
+	0x49, 0x44, 0x22, 0x40, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x07, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x78, 0x12,
+	0x22, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x02, 0x74, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x74, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x74, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x53,
+	0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x54, 0x78, 0x12, 0x4e, 0x0a, 0x12, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x52, 0x12,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x77, 0x54,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x94,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a,
+	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x53, 0x69, 0x67,
+	0x6e, 0x48, 0x54, 0x4c, 0x43, 0x54, 0x78, 0x12, 0x33, 0x0a, 0x09, 0x68, 0x74, 0x6c, 0x63, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x41, 0x64, 0x64,
+	0x72, 0x52, 0x09, 0x68, 0x74, 0x6c, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x4b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x02, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a,
 	0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -731,7 +904,7 @@ var file_rpc_proto_rawDesc = []byte{
 	0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69,
 	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x32, 0x8e, 0x23, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x12, 0x2d, 0x0a,
+	0x74, 0x32, 0xbe, 0x26, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33, 0x12, 0x2d, 0x0a,
 	0x09, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0c, 0x2e, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0d,
@@ -750,265 +923,292 @@ var file_rpc_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48,
 	0x61, 0x73, 0x68, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e,
 	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12,
-	0x39, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x16,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0f, 0x53, 0x65,
-	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x41, 0x64, 0x64, 0x72, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x71, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x50, 0x6f,
-	0x6f, 0x6c, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x35,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x0d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x65, 0x77, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x15, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x6b,
-	0x65, 0x79, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65,
-	0x79, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x00, 0x12, 0x32, 0x0a,
-	0x08, 0x53, 0x65, 0x74, 0x54, 0x78, 0x46, 0x65, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x74, 0x46, 0x65,
-	0x65, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x6c, 0x12, 0x18, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65,
-	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x12, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12,
-	0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x64, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x04, 0x4c, 0x6f,
-	0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69,
-	0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x2d, 0x0a, 0x06, 0x55, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x4c, 0x6f, 0x63, 0x6b,
-	0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x35, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x50, 0x6f,
-	0x6f, 0x6c, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69,
-	0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x78, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x71, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x1a, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61,
-	0x73, 0x68, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x2e,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x0e, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69,
-	0x65, 0x77, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x49, 0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x53, 0x65,
-	0x65, 0x64, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x53, 0x65,
-	0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x65, 0x64, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x65, 0x64, 0x42, 0x79, 0x50, 0x77, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x65, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a,
-	0x08, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x42, 0x79, 0x50, 0x77, 0x1a, 0x0c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a,
-	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x6f, 0x72, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x78, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x78, 0x22, 0x00, 0x12, 0x30, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x78, 0x54, 0x78, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x1a,
-	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x78, 0x54, 0x78, 0x22, 0x00, 0x12,
-	0x35, 0x0a, 0x0b, 0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x44, 0x75, 0x6d, 0x70, 0x50, 0x72,
-	0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69,
-	0x6c, 0x65, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76,
-	0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x65,
-	0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x2e, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x74, 0x79,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x78, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x10,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x78, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0a, 0x4e, 0x65,
+	0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x15, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
+	0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x54, 0x78, 0x46,
+	0x65, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x74, 0x46, 0x65, 0x65, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x53, 0x65,
+	0x74, 0x4c, 0x61, 0x62, 0x6c, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a,
+	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x09, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x64, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x06, 0x55, 0x6e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x55, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x0d, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
-	0x12, 0x27, 0x0a, 0x06, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x00, 0x12, 0x38, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0e, 0x49,
-	0x73, 0x4e, 0x74, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c,
+	0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x14, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x4f, 0x76,
+	0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x49, 0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x1a,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x65, 0x65,
+	0x64, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x42, 0x79,
+	0x50, 0x77, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x53, 0x65, 0x65, 0x64, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65,
+	0x65, 0x64, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53,
+	0x65, 0x65, 0x64, 0x42, 0x79, 0x50, 0x77, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x1a,
+	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x14, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x6f, 0x72, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x1a, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x78, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x54, 0x78, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x78,
+	0x54, 0x78, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x78, 0x54, 0x78, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x75, 0x6d, 0x70,
+	0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x10, 0x44, 0x75, 0x6d, 0x70, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50,
+	0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x12, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x72, 0x69,
+	0x76, 0x6b, 0x65, 0x79, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x4e, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x27, 0x0a, 0x06, 0x49, 0x73, 0x53,
+	0x79, 0x6e, 0x63, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e,
+	0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32, 0x50, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x07, 0x4e, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x32,
+	0x50, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x0e, 0x49, 0x73, 0x4e, 0x74, 0x70, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61, 0x74, 0x61,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
 	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x00, 0x12, 0x35,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x10,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x53, 0x65, 0x71, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0a, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x45, 0x78, 0x65, 0x63, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x6c,
-	0x6c, 0x45, 0x78, 0x65, 0x63, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x12, 0x13, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78,
-	0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x1a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x78, 0x1a, 0x15, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77,
-	0x54, 0x78, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e,
-	0x64, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x52, 0x61, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x71, 0x4b, 0x65, 0x79, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x78, 0x73, 0x12, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x78,
-	0x73, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53,
-	0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78,
-	0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x19, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x54,
-	0x78, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
-	0x00, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x4e, 0x69, 0x6c, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69,
-	0x6c, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x14, 0x53, 0x65,
-	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x54, 0x78, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x78, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x78, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48,
+	0x61, 0x73, 0x68, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00,
+	0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x53, 0x65,
+	0x71, 0x12, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x1a,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71,
+	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a,
+	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x41, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x61, 0x77, 0x54, 0x78, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x4e, 0x69, 0x6c, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x11, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x78, 0x65, 0x63, 0x74, 0x6f, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x0d, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00,
-	0x12, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x78, 0x12, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67,
-	0x6e, 0x54, 0x78, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x50, 0x75, 0x73, 0x68,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68, 0x22, 0x00, 0x12,
-	0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x12, 0x0d, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x15, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x75, 0x73, 0x68,
-	0x53, 0x65, 0x71, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x0c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x54, 0x78, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x61, 0x6e, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x1a, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b,
+	0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4b, 0x65, 0x79, 0x1a,
+	0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x54, 0x78, 0x73, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x78, 0x73, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54,
+	0x78, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78,
+	0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x4c, 0x6f, 0x61, 0x64,
+	0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x79, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78,
+	0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x54, 0x78, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x1a, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x54,
+	0x78, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0e, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x00, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69,
+	0x6c, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x54, 0x78, 0x1a, 0x0c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x13,
+	0x53, 0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x54, 0x78, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53,
+	0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x54, 0x78, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x52, 0x61, 0x77, 0x54, 0x78, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x47, 0x65, 0x74, 0x48, 0x54, 0x4c, 0x43,
+	0x41, 0x64, 0x64, 0x72, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0a, 0x53, 0x69,
+	0x67, 0x6e, 0x48, 0x54, 0x4c, 0x43, 0x54, 0x78, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x53, 0x69, 0x67, 0x6e, 0x48, 0x54, 0x4c, 0x43, 0x54, 0x78, 0x1a, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e,
+	0x52, 0x61, 0x77, 0x54, 0x78, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x34, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x52, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x54, 0x78, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x78, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75,
+	0x73, 0x68, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x73, 0x68,
+	0x65, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4e, 0x69,
+	0x6c, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x75, 0x73, 0x68, 0x53, 0x65, 0x71, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x75, 0x6d, 0x12,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x1a, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12,
+	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x50, 0x75, 0x73, 0x68, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x75, 0x73, 0x68,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x4e, 0x69, 0x6c, 0x1a, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08,
 	0x53, 0x75, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x0f, 0x2e,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00,
@@ -1029,7 +1229,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_rpc_proto_goTypes = []interface{}{
 	(*ServerTime)(nil),               // 0: types.serverTime
 	(*Crypto)(nil),                   // 1: types.crypto
@@ -1039,254 +1239,278 @@ var file_rpc_proto_goTypes = []interface{}{
 	(*DelayTx)(nil),                  // 5: types.delayTx
 	(*ReqGetWalletRecoverAddr)(nil),  // 6: types.ReqGetWalletRecoverAddr
 	(*ReqSignWalletRecoverTx)(nil),   // 7: types.ReqSignWalletRecoverTx
-	(*ChainConfigInfo)(nil),          // 8: types.ChainConfigInfo
-	(*Replies)(nil),                  // 9: types.Replies
-	(*Transaction)(nil),              // 10: types.Transaction
-	(*Reply)(nil),                    // 11: types.Reply
-	(*ReqBlocks)(nil),                // 12: types.ReqBlocks
-	(*ReqNil)(nil),                   // 13: types.ReqNil
-	(*CreateTx)(nil),                 // 14: types.CreateTx
-	(*CreateTransactionGroup)(nil),   // 15: types.CreateTransactionGroup
-	(*ReqHash)(nil),                  // 16: types.ReqHash
-	(*Transactions)(nil),             // 17: types.Transactions
-	(*ReqAddr)(nil),                  // 18: types.ReqAddr
-	(*ReqHashes)(nil),                // 19: types.ReqHashes
-	(*ReqGetMempool)(nil),            // 20: types.ReqGetMempool
-	(*ReqGetAccount)(nil),            // 21: types.ReqGetAccount
-	(*ReqNewAccount)(nil),            // 22: types.ReqNewAccount
-	(*ReqWalletTransactionList)(nil), // 23: types.ReqWalletTransactionList
-	(*ReqWalletImportPrivkey)(nil),   // 24: types.ReqWalletImportPrivkey
-	(*ReqWalletSendToAddress)(nil),   // 25: types.ReqWalletSendToAddress
-	(*ReqWalletSetFee)(nil),          // 26: types.ReqWalletSetFee
-	(*ReqWalletSetLabel)(nil),        // 27: types.ReqWalletSetLabel
-	(*ReqWalletMergeBalance)(nil),    // 28: types.ReqWalletMergeBalance
-	(*ReqWalletSetPasswd)(nil),       // 29: types.ReqWalletSetPasswd
-	(*WalletUnLock)(nil),             // 30: types.WalletUnLock
-	(*ReqProperFee)(nil),             // 31: types.ReqProperFee
-	(*ReqInt)(nil),                   // 32: types.ReqInt
-	(*GenSeedLang)(nil),              // 33: types.GenSeedLang
-	(*GetSeedByPw)(nil),              // 34: types.GetSeedByPw
-	(*SaveSeedByPw)(nil),             // 35: types.SaveSeedByPw
-	(*ReqBalance)(nil),               // 36: types.ReqBalance
-	(*ChainExecutor)(nil),            // 37: types.ChainExecutor
-	(*CreateTxIn)(nil),               // 38: types.CreateTxIn
-	(*ReqString)(nil),                // 39: types.ReqString
-	(*ReqPrivkeysFile)(nil),          // 40: types.ReqPrivkeysFile
-	(*P2PGetPeerReq)(nil),            // 41: types.P2PGetPeerReq
-	(*P2PGetNetInfoReq)(nil),         // 42: types.P2PGetNetInfoReq
-	(*Int64)(nil),                    // 43: types.Int64
-	(*ReqAllExecBalance)(nil),        // 44: types.ReqAllExecBalance
-	(*ReqSignRawTx)(nil),             // 45: types.ReqSignRawTx
-	(*NoBalanceTx)(nil),              // 46: types.NoBalanceTx
-	(*ReqRandHash)(nil),              // 47: types.ReqRandHash
-	(*ReqKey)(nil),                   // 48: types.ReqKey
-	(*NoBalanceTxs)(nil),             // 49: types.NoBalanceTxs
-	(*ReqParaTxByTitle)(nil),         // 50: types.ReqParaTxByTitle
-	(*ReqHeightByTitle)(nil),         // 51: types.ReqHeightByTitle
-	(*ReqParaTxByHeight)(nil),        // 52: types.ReqParaTxByHeight
-	(*ReWriteRawTx)(nil),             // 53: types.ReWriteRawTx
-	(*PushSubscribeReq)(nil),         // 54: types.PushSubscribeReq
-	(*ReqSubscribe)(nil),             // 55: types.ReqSubscribe
-	(*Header)(nil),                   // 56: types.Header
-	(*UnsignTx)(nil),                 // 57: types.UnsignTx
-	(*TransactionDetail)(nil),        // 58: types.TransactionDetail
-	(*ReplyTxInfos)(nil),             // 59: types.ReplyTxInfos
-	(*TransactionDetails)(nil),       // 60: types.TransactionDetails
-	(*ReplyTxList)(nil),              // 61: types.ReplyTxList
-	(*WalletAccounts)(nil),           // 62: types.WalletAccounts
-	(*WalletAccount)(nil),            // 63: types.WalletAccount
-	(*WalletTxDetails)(nil),          // 64: types.WalletTxDetails
-	(*ReplyHash)(nil),                // 65: types.ReplyHash
-	(*ReplyHashes)(nil),              // 66: types.ReplyHashes
-	(*ReplyProperFee)(nil),           // 67: types.ReplyProperFee
-	(*WalletStatus)(nil),             // 68: types.WalletStatus
-	(*BlockOverview)(nil),            // 69: types.BlockOverview
-	(*AddrOverview)(nil),             // 70: types.AddrOverview
-	(*ReplySeed)(nil),                // 71: types.ReplySeed
-	(*Accounts)(nil),                 // 72: types.Accounts
-	(*HexTx)(nil),                    // 73: types.HexTx
-	(*ReplyString)(nil),              // 74: types.ReplyString
-	(*VersionInfo)(nil),              // 75: types.VersionInfo
-	(*PeerList)(nil),                 // 76: types.PeerList
-	(*NodeNetInfo)(nil),              // 77: types.NodeNetInfo
-	(*Int32)(nil),                    // 78: types.Int32
-	(*BlockDetails)(nil),             // 79: types.BlockDetails
-	(*BlockSeq)(nil),                 // 80: types.BlockSeq
-	(*AllExecBalance)(nil),           // 81: types.AllExecBalance
-	(*ReplySignRawTx)(nil),           // 82: types.ReplySignRawTx
-	(*ParaTxDetails)(nil),            // 83: types.ParaTxDetails
-	(*ReplyHeightByTitle)(nil),       // 84: types.ReplyHeightByTitle
-	(*Headers)(nil),                  // 85: types.Headers
-	(*BlockSequences)(nil),           // 86: types.BlockSequences
-	(*ReplySubscribePush)(nil),       // 87: types.ReplySubscribePush
-	(*PushSubscribes)(nil),           // 88: types.PushSubscribes
-	(*PushData)(nil),                 // 89: types.PushData
+	(*ReqGetHTLCAddr)(nil),           // 8: types.ReqGetHTLCAddr
+	(*ReqSignHTLCTx)(nil),            // 9: types.ReqSignHTLCTx
+	(*ChainConfigInfo)(nil),          // 10: types.ChainConfigInfo
+	(*Replies)(nil),                  // 11: types.Replies
+	(*Transaction)(nil),              // 12: types.Transaction
+	(*Reply)(nil),                    // 13: types.Reply
+	(*ReqBlocks)(nil),                // 14: types.ReqBlocks
+	(*ReqNil)(nil),                   // 15: types.ReqNil
+	(*CreateTx)(nil),                 // 16: types.CreateTx
+	(*CreateTransactionGroup)(nil),   // 17: types.CreateTransactionGroup
+	(*ReqHash)(nil),                  // 18: types.ReqHash
+	(*Transactions)(nil),             // 19: types.Transactions
+	(*ReqAddr)(nil),                  // 20: types.ReqAddr
+	(*ReqHashes)(nil),                // 21: types.ReqHashes
+	(*ReqGetMempool)(nil),            // 22: types.ReqGetMempool
+	(*ReqGetAccount)(nil),            // 23: types.ReqGetAccount
+	(*ReqNewAccount)(nil),            // 24: types.ReqNewAccount
+	(*ReqWalletTransactionList)(nil), // 25: types.ReqWalletTransactionList
+	(*ReqWalletImportPrivkey)(nil),   // 26: types.ReqWalletImportPrivkey
+	(*ReqWalletSendToAddress)(nil),   // 27: types.ReqWalletSendToAddress
+	(*ReqWalletSetFee)(nil),          // 28: types.ReqWalletSetFee
+	(*ReqWalletSetLabel)(nil),        // 29: types.ReqWalletSetLabel
+	(*ReqWalletMergeBalance)(nil),    // 30: types.ReqWalletMergeBalance
+	(*ReqWalletSetPasswd)(nil),       // 31: types.ReqWalletSetPasswd
+	(*WalletUnLock)(nil),             // 32: types.WalletUnLock
+	(*ReqProperFee)(nil),             // 33: types.ReqProperFee
+	(*ReqInt)(nil),                   // 34: types.ReqInt
+	(*GenSeedLang)(nil),              // 35: types.GenSeedLang
+	(*GetSeedByPw)(nil),              // 36: types.GetSeedByPw
+	(*SaveSeedByPw)(nil),             // 37: types.SaveSeedByPw
+	(*ReqBalance)(nil),               // 38: types.ReqBalance
+	(*ChainExecutor)(nil),            // 39: types.ChainExecutor
+	(*CreateTxIn)(nil),               // 40: types.CreateTxIn
+	(*ReqString)(nil),                // 41: types.ReqString
+	(*ReqPrivkeysFile)(nil),          // 42: types.ReqPrivkeysFile
+	(*P2PGetPeerReq)(nil),            // 43: types.P2PGetPeerReq
+	(*P2PGetNetInfoReq)(nil),         // 44: types.P2PGetNetInfoReq
+	(*Int64)(nil),                    // 45: types.Int64
+	(*ReqAllExecBalance)(nil),        // 46: types.ReqAllExecBalance
+	(*ReqSignRawTx)(nil),             // 47: types.ReqSignRawTx
+	(*NoBalanceTx)(nil),              // 48: types.NoBalanceTx
+	(*ReqRandHash)(nil),              // 49: types.ReqRandHash
+	(*ReqKey)(nil),                   // 50: types.ReqKey
+	(*NoBalanceTxs)(nil),             // 51: types.NoBalanceTxs
+	(*ReqParaTxByTitle)(nil),         // 52: types.ReqParaTxByTitle
+	(*ReqHeightByTitle)(nil),         // 53: types.ReqHeightByTitle
+	(*ReqParaTxByHeight)(nil),        // 54: types.ReqParaTxByHeight
+	(*ReWriteRawTx)(nil),             // 55: types.ReWriteRawTx
+	(*PushSubscribeReq)(nil),         // 56: types.PushSubscribeReq
+	(*ReqManagePush)(nil),            // 57: types.ReqManagePush
+	(*ReqReorgHistory)(nil),          // 58: types.ReqReorgHistory
+	(*ReqStateProof)(nil),            // 59: types.ReqStateProof
+	(*ReqSubscribe)(nil),             // 60: types.ReqSubscribe
+	(*Header)(nil),                   // 61: types.Header
+	(*UnsignTx)(nil),                 // 62: types.UnsignTx
+	(*TransactionDetail)(nil),        // 63: types.TransactionDetail
+	(*TxStatusHistory)(nil),          // 64: types.TxStatusHistory
+	(*ReplyTxInfos)(nil),             // 65: types.ReplyTxInfos
+	(*TransactionDetails)(nil),       // 66: types.TransactionDetails
+	(*ReplyTxList)(nil),              // 67: types.ReplyTxList
+	(*WalletAccounts)(nil),           // 68: types.WalletAccounts
+	(*WalletAccount)(nil),            // 69: types.WalletAccount
+	(*WalletTxDetails)(nil),          // 70: types.WalletTxDetails
+	(*ReplyHash)(nil),                // 71: types.ReplyHash
+	(*ReplyHashes)(nil),              // 72: types.ReplyHashes
+	(*ReplyProperFee)(nil),           // 73: types.ReplyProperFee
+	(*WalletStatus)(nil),             // 74: types.WalletStatus
+	(*BlockOverview)(nil),            // 75: types.BlockOverview
+	(*AddrOverview)(nil),             // 76: types.AddrOverview
+	(*ReplySeed)(nil),                // 77: types.ReplySeed
+	(*Accounts)(nil),                 // 78: types.Accounts
+	(*HexTx)(nil),                    // 79: types.HexTx
+	(*ReplyString)(nil),              // 80: types.ReplyString
+	(*VersionInfo)(nil),              // 81: types.VersionInfo
+	(*PeerList)(nil),                 // 82: types.PeerList
+	(*NodeNetInfo)(nil),              // 83: types.NodeNetInfo
+	(*Int32)(nil),                    // 84: types.Int32
+	(*BlockDetails)(nil),             // 85: types.BlockDetails
+	(*BlockSeq)(nil),                 // 86: types.BlockSeq
+	(*AllExecBalance)(nil),           // 87: types.AllExecBalance
+	(*ReplySignRawTx)(nil),           // 88: types.ReplySignRawTx
+	(*ParaTxDetails)(nil),            // 89: types.ParaTxDetails
+	(*ReplyHeightByTitle)(nil),       // 90: types.ReplyHeightByTitle
+	(*Headers)(nil),                  // 91: types.Headers
+	(*BlockSequences)(nil),           // 92: types.BlockSequences
+	(*ReplySubscribePush)(nil),       // 93: types.ReplySubscribePush
+	(*PushSubscribes)(nil),           // 94: types.PushSubscribes
+	(*ReorgRecords)(nil),             // 95: types.ReorgRecords
+	(*BlockCheckpoints)(nil),         // 96: types.BlockCheckpoints
+	(*StateProof)(nil),               // 97: types.StateProof
+	(*PushData)(nil),                 // 98: types.PushData
 }
 var file_rpc_proto_depIdxs = []int32{
 	1,  // 0: types.cryptoList.cryptos:type_name -> types.crypto
 	3,  // 1: types.addressDrivers.drivers:type_name -> types.addressDriver
-	10, // 2: types.delayTx.tx:type_name -> types.Transaction
+	12, // 2: types.delayTx.tx:type_name -> types.Transaction
 	6,  // 3: types.ReqSignWalletRecoverTx.walletRecoverParam:type_name -> types.ReqGetWalletRecoverAddr
-	11, // 4: types.Replies.replyList:type_name -> types.Reply
-	12, // 5: types.chain33.GetBlocks:input_type -> types.ReqBlocks
-	13, // 6: types.chain33.GetLastHeader:input_type -> types.ReqNil
-	14, // 7: types.chain33.CreateRawTransaction:input_type -> types.CreateTx
-	15, // 8: types.chain33.CreateRawTxGroup:input_type -> types.CreateTransactionGroup
-	16, // 9: types.chain33.QueryTransaction:input_type -> types.ReqHash
-	10, // 10: types.chain33.SendTransactionSync:input_type -> types.Transaction
-	10, // 11: types.chain33.SendTransaction:input_type -> types.Transaction
-	17, // 12: types.chain33.SendTransactions:input_type -> types.Transactions
-	18, // 13: types.chain33.GetTransactionByAddr:input_type -> types.ReqAddr
-	19, // 14: types.chain33.GetTransactionByHashes:input_type -> types.ReqHashes
-	20, // 15: types.chain33.GetMemPool:input_type -> types.ReqGetMempool
-	13, // 16: types.chain33.GetAccounts:input_type -> types.ReqNil
-	21, // 17: types.chain33.GetAccount:input_type -> types.ReqGetAccount
-	22, // 18: types.chain33.NewAccount:input_type -> types.ReqNewAccount
-	23, // 19: types.chain33.WalletTransactionList:input_type -> types.ReqWalletTransactionList
-	24, // 20: types.chain33.ImportPrivkey:input_type -> types.ReqWalletImportPrivkey
-	25, // 21: types.chain33.SendToAddress:input_type -> types.ReqWalletSendToAddress
-	26, // 22: types.chain33.SetTxFee:input_type -> types.ReqWalletSetFee
-	27, // 23: types.chain33.SetLabl:input_type -> types.ReqWalletSetLabel
-	28, // 24: types.chain33.MergeBalance:input_type -> types.ReqWalletMergeBalance
-	29, // 25: types.chain33.SetPasswd:input_type -> types.ReqWalletSetPasswd
-	13, // 26: types.chain33.Lock:input_type -> types.ReqNil
-	30, // 27: types.chain33.UnLock:input_type -> types.WalletUnLock
-	13, // 28: types.chain33.GetLastMemPool:input_type -> types.ReqNil
-	31, // 29: types.chain33.GetProperFee:input_type -> types.ReqProperFee
-	13, // 30: types.chain33.GetWalletStatus:input_type -> types.ReqNil
-	16, // 31: types.chain33.GetBlockOverview:input_type -> types.ReqHash
-	18, // 32: types.chain33.GetAddrOverview:input_type -> types.ReqAddr
-	32, // 33: types.chain33.GetBlockHash:input_type -> types.ReqInt
-	33, // 34: types.chain33.GenSeed:input_type -> types.GenSeedLang
-	34, // 35: types.chain33.GetSeed:input_type -> types.GetSeedByPw
-	35, // 36: types.chain33.SaveSeed:input_type -> types.SaveSeedByPw
-	36, // 37: types.chain33.GetBalance:input_type -> types.ReqBalance
-	37, // 38: types.chain33.QueryChain:input_type -> types.ChainExecutor
-	37, // 39: types.chain33.ExecWallet:input_type -> types.ChainExecutor
-	37, // 40: types.chain33.QueryConsensus:input_type -> types.ChainExecutor
-	38, // 41: types.chain33.CreateTransaction:input_type -> types.CreateTxIn
-	16, // 42: types.chain33.GetHexTxByHash:input_type -> types.ReqHash
-	39, // 43: types.chain33.DumpPrivkey:input_type -> types.ReqString
-	40, // 44: types.chain33.DumpPrivkeysFile:input_type -> types.ReqPrivkeysFile
-	40, // 45: types.chain33.ImportPrivkeysFile:input_type -> types.ReqPrivkeysFile
-	13, // 46: types.chain33.Version:input_type -> types.ReqNil
-	13, // 47: types.chain33.IsSync:input_type -> types.ReqNil
-	41, // 48: types.chain33.GetPeerInfo:input_type -> types.P2PGetPeerReq
-	42, // 49: types.chain33.NetInfo:input_type -> types.P2PGetNetInfoReq
-	13, // 50: types.chain33.IsNtpClockSync:input_type -> types.ReqNil
-	13, // 51: types.chain33.GetFatalFailure:input_type -> types.ReqNil
-	13, // 52: types.chain33.GetLastBlockSequence:input_type -> types.ReqNil
-	16, // 53: types.chain33.GetSequenceByHash:input_type -> types.ReqHash
-	19, // 54: types.chain33.GetBlockByHashes:input_type -> types.ReqHashes
-	43, // 55: types.chain33.GetBlockBySeq:input_type -> types.Int64
-	13, // 56: types.chain33.CloseQueue:input_type -> types.ReqNil
-	44, // 57: types.chain33.GetAllExecBalance:input_type -> types.ReqAllExecBalance
-	45, // 58: types.chain33.SignRawTx:input_type -> types.ReqSignRawTx
-	46, // 59: types.chain33.CreateNoBalanceTransaction:input_type -> types.NoBalanceTx
-	47, // 60: types.chain33.QueryRandNum:input_type -> types.ReqRandHash
-	48, // 61: types.chain33.GetFork:input_type -> types.ReqKey
-	49, // 62: types.chain33.CreateNoBalanceTxs:input_type -> types.NoBalanceTxs
-	50, // 63: types.chain33.GetParaTxByTitle:input_type -> types.ReqParaTxByTitle
-	51, // 64: types.chain33.LoadParaTxByTitle:input_type -> types.ReqHeightByTitle
-	52, // 65: types.chain33.GetParaTxByHeight:input_type -> types.ReqParaTxByHeight
-	12, // 66: types.chain33.GetHeaders:input_type -> types.ReqBlocks
-	13, // 67: types.chain33.GetServerTime:input_type -> types.ReqNil
-	13, // 68: types.chain33.GetCryptoList:input_type -> types.ReqNil
-	13, // 69: types.chain33.GetAddressDrivers:input_type -> types.ReqNil
-	5,  // 70: types.chain33.SendDelayTransaction:input_type -> types.delayTx
-	6,  // 71: types.chain33.GetWalletRecoverAddress:input_type -> types.ReqGetWalletRecoverAddr
-	7,  // 72: types.chain33.SignWalletRecoverTx:input_type -> types.ReqSignWalletRecoverTx
-	13, // 73: types.chain33.GetChainConfig:input_type -> types.ReqNil
-	39, // 74: types.chain33.ConvertExectoAddr:input_type -> types.ReqString
-	13, // 75: types.chain33.GetCoinSymbol:input_type -> types.ReqNil
-	53, // 76: types.chain33.ReWriteTx:input_type -> types.ReWriteRawTx
-	12, // 77: types.chain33.GetBlockSequences:input_type -> types.ReqBlocks
-	54, // 78: types.chain33.AddPushSubscribe:input_type -> types.PushSubscribeReq
-	13, // 79: types.chain33.ListPushes:input_type -> types.ReqNil
-	39, // 80: types.chain33.GetPushSeqLastNum:input_type -> types.ReqString
-	55, // 81: types.chain33.SubEvent:input_type -> types.ReqSubscribe
-	11, // 82: types.chain33.GetBlocks:output_type -> types.Reply
-	56, // 83: types.chain33.GetLastHeader:output_type -> types.Header
-	57, // 84: types.chain33.CreateRawTransaction:output_type -> types.UnsignTx
-	57, // 85: types.chain33.CreateRawTxGroup:output_type -> types.UnsignTx
-	58, // 86: types.chain33.QueryTransaction:output_type -> types.TransactionDetail
-	11, // 87: types.chain33.SendTransactionSync:output_type -> types.Reply
-	11, // 88: types.chain33.SendTransaction:output_type -> types.Reply
-	9,  // 89: types.chain33.SendTransactions:output_type -> types.Replies
-	59, // 90: types.chain33.GetTransactionByAddr:output_type -> types.ReplyTxInfos
-	60, // 91: types.chain33.GetTransactionByHashes:output_type -> types.TransactionDetails
-	61, // 92: types.chain33.GetMemPool:output_type -> types.ReplyTxList
-	62, // 93: types.chain33.GetAccounts:output_type -> types.WalletAccounts
-	63, // 94: types.chain33.GetAccount:output_type -> types.WalletAccount
-	63, // 95: types.chain33.NewAccount:output_type -> types.WalletAccount
-	64, // 96: types.chain33.WalletTransactionList:output_type -> types.WalletTxDetails
-	63, // 97: types.chain33.ImportPrivkey:output_type -> types.WalletAccount
-	65, // 98: types.chain33.SendToAddress:output_type -> types.ReplyHash
-	11, // 99: types.chain33.SetTxFee:output_type -> types.Reply
-	63, // 100: types.chain33.SetLabl:output_type -> types.WalletAccount
-	66, // 101: types.chain33.MergeBalance:output_type -> types.ReplyHashes
-	11, // 102: types.chain33.SetPasswd:output_type -> types.Reply
-	11, // 103: types.chain33.Lock:output_type -> types.Reply
-	11, // 104: types.chain33.UnLock:output_type -> types.Reply
-	61, // 105: types.chain33.GetLastMemPool:output_type -> types.ReplyTxList
-	67, // 106: types.chain33.GetProperFee:output_type -> types.ReplyProperFee
-	68, // 107: types.chain33.GetWalletStatus:output_type -> types.WalletStatus
-	69, // 108: types.chain33.GetBlockOverview:output_type -> types.BlockOverview
-	70, // 109: types.chain33.GetAddrOverview:output_type -> types.AddrOverview
-	65, // 110: types.chain33.GetBlockHash:output_type -> types.ReplyHash
-	71, // 111: types.chain33.GenSeed:output_type -> types.ReplySeed
-	71, // 112: types.chain33.GetSeed:output_type -> types.ReplySeed
-	11, // 113: types.chain33.SaveSeed:output_type -> types.Reply
-	72, // 114: types.chain33.GetBalance:output_type -> types.Accounts
-	11, // 115: types.chain33.QueryChain:output_type -> types.Reply
-	11, // 116: types.chain33.ExecWallet:output_type -> types.Reply
-	11, // 117: types.chain33.QueryConsensus:output_type -> types.Reply
-	57, // 118: types.chain33.CreateTransaction:output_type -> types.UnsignTx
-	73, // 119: types.chain33.GetHexTxByHash:output_type -> types.HexTx
-	74, // 120: types.chain33.DumpPrivkey:output_type -> types.ReplyString
-	11, // 121: types.chain33.DumpPrivkeysFile:output_type -> types.Reply
-	11, // 122: types.chain33.ImportPrivkeysFile:output_type -> types.Reply
-	75, // 123: types.chain33.Version:output_type -> types.VersionInfo
-	11, // 124: types.chain33.IsSync:output_type -> types.Reply
-	76, // 125: types.chain33.GetPeerInfo:output_type -> types.PeerList
-	77, // 126: types.chain33.NetInfo:output_type -> types.NodeNetInfo
-	11, // 127: types.chain33.IsNtpClockSync:output_type -> types.Reply
-	78, // 128: types.chain33.GetFatalFailure:output_type -> types.Int32
-	43, // 129: types.chain33.GetLastBlockSequence:output_type -> types.Int64
-	43, // 130: types.chain33.GetSequenceByHash:output_type -> types.Int64
-	79, // 131: types.chain33.GetBlockByHashes:output_type -> types.BlockDetails
-	80, // 132: types.chain33.GetBlockBySeq:output_type -> types.BlockSeq
-	11, // 133: types.chain33.CloseQueue:output_type -> types.Reply
-	81, // 134: types.chain33.GetAllExecBalance:output_type -> types.AllExecBalance
-	82, // 135: types.chain33.SignRawTx:output_type -> types.ReplySignRawTx
-	82, // 136: types.chain33.CreateNoBalanceTransaction:output_type -> types.ReplySignRawTx
-	65, // 137: types.chain33.QueryRandNum:output_type -> types.ReplyHash
-	43, // 138: types.chain33.GetFork:output_type -> types.Int64
-	82, // 139: types.chain33.CreateNoBalanceTxs:output_type -> types.ReplySignRawTx
-	83, // 140: types.chain33.GetParaTxByTitle:output_type -> types.ParaTxDetails
-	84, // 141: types.chain33.LoadParaTxByTitle:output_type -> types.ReplyHeightByTitle
-	83, // 142: types.chain33.GetParaTxByHeight:output_type -> types.ParaTxDetails
-	85, // 143: types.chain33.GetHeaders:output_type -> types.Headers
-	0,  // 144: types.chain33.GetServerTime:output_type -> types.serverTime
-	2,  // 145: types.chain33.GetCryptoList:output_type -> types.cryptoList
-	4,  // 146: types.chain33.GetAddressDrivers:output_type -> types.addressDrivers
-	11, // 147: types.chain33.SendDelayTransaction:output_type -> types.Reply
-	74, // 148: types.chain33.GetWalletRecoverAddress:output_type -> types.ReplyString
-	82, // 149: types.chain33.SignWalletRecoverTx:output_type -> types.ReplySignRawTx
-	8,  // 150: types.chain33.GetChainConfig:output_type -> types.ChainConfigInfo
-	74, // 151: types.chain33.ConvertExectoAddr:output_type -> types.ReplyString
-	74, // 152: types.chain33.GetCoinSymbol:output_type -> types.ReplyString
-	57, // 153: types.chain33.ReWriteTx:output_type -> types.UnsignTx
-	86, // 154: types.chain33.GetBlockSequences:output_type -> types.BlockSequences
-	87, // 155: types.chain33.AddPushSubscribe:output_type -> types.ReplySubscribePush
-	88, // 156: types.chain33.ListPushes:output_type -> types.PushSubscribes
-	43, // 157: types.chain33.GetPushSeqLastNum:output_type -> types.Int64
-	89, // 158: types.chain33.SubEvent:output_type -> types.PushData
-	82, // [82:159] is the sub-list for method output_type
-	5,  // [5:82] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	8,  // 4: types.ReqSignHTLCTx.htlcParam:type_name -> types.ReqGetHTLCAddr
+	13, // 5: types.Replies.replyList:type_name -> types.Reply
+	14, // 6: types.chain33.GetBlocks:input_type -> types.ReqBlocks
+	15, // 7: types.chain33.GetLastHeader:input_type -> types.ReqNil
+	16, // 8: types.chain33.CreateRawTransaction:input_type -> types.CreateTx
+	17, // 9: types.chain33.CreateRawTxGroup:input_type -> types.CreateTransactionGroup
+	18, // 10: types.chain33.QueryTransaction:input_type -> types.ReqHash
+	18, // 11: types.chain33.GetTxStatus:input_type -> types.ReqHash
+	12, // 12: types.chain33.SendTransactionSync:input_type -> types.Transaction
+	12, // 13: types.chain33.SendTransaction:input_type -> types.Transaction
+	19, // 14: types.chain33.SendTransactions:input_type -> types.Transactions
+	20, // 15: types.chain33.GetTransactionByAddr:input_type -> types.ReqAddr
+	21, // 16: types.chain33.GetTransactionByHashes:input_type -> types.ReqHashes
+	22, // 17: types.chain33.GetMemPool:input_type -> types.ReqGetMempool
+	15, // 18: types.chain33.GetAccounts:input_type -> types.ReqNil
+	23, // 19: types.chain33.GetAccount:input_type -> types.ReqGetAccount
+	24, // 20: types.chain33.NewAccount:input_type -> types.ReqNewAccount
+	25, // 21: types.chain33.WalletTransactionList:input_type -> types.ReqWalletTransactionList
+	26, // 22: types.chain33.ImportPrivkey:input_type -> types.ReqWalletImportPrivkey
+	27, // 23: types.chain33.SendToAddress:input_type -> types.ReqWalletSendToAddress
+	28, // 24: types.chain33.SetTxFee:input_type -> types.ReqWalletSetFee
+	29, // 25: types.chain33.SetLabl:input_type -> types.ReqWalletSetLabel
+	30, // 26: types.chain33.MergeBalance:input_type -> types.ReqWalletMergeBalance
+	31, // 27: types.chain33.SetPasswd:input_type -> types.ReqWalletSetPasswd
+	15, // 28: types.chain33.Lock:input_type -> types.ReqNil
+	32, // 29: types.chain33.UnLock:input_type -> types.WalletUnLock
+	15, // 30: types.chain33.GetLastMemPool:input_type -> types.ReqNil
+	33, // 31: types.chain33.GetProperFee:input_type -> types.ReqProperFee
+	15, // 32: types.chain33.GetWalletStatus:input_type -> types.ReqNil
+	18, // 33: types.chain33.GetBlockOverview:input_type -> types.ReqHash
+	20, // 34: types.chain33.GetAddrOverview:input_type -> types.ReqAddr
+	34, // 35: types.chain33.GetBlockHash:input_type -> types.ReqInt
+	35, // 36: types.chain33.GenSeed:input_type -> types.GenSeedLang
+	36, // 37: types.chain33.GetSeed:input_type -> types.GetSeedByPw
+	37, // 38: types.chain33.SaveSeed:input_type -> types.SaveSeedByPw
+	38, // 39: types.chain33.GetBalance:input_type -> types.ReqBalance
+	39, // 40: types.chain33.QueryChain:input_type -> types.ChainExecutor
+	39, // 41: types.chain33.ExecWallet:input_type -> types.ChainExecutor
+	39, // 42: types.chain33.QueryConsensus:input_type -> types.ChainExecutor
+	40, // 43: types.chain33.CreateTransaction:input_type -> types.CreateTxIn
+	18, // 44: types.chain33.GetHexTxByHash:input_type -> types.ReqHash
+	41, // 45: types.chain33.DumpPrivkey:input_type -> types.ReqString
+	42, // 46: types.chain33.DumpPrivkeysFile:input_type -> types.ReqPrivkeysFile
+	42, // 47: types.chain33.ImportPrivkeysFile:input_type -> types.ReqPrivkeysFile
+	15, // 48: types.chain33.Version:input_type -> types.ReqNil
+	15, // 49: types.chain33.IsSync:input_type -> types.ReqNil
+	43, // 50: types.chain33.GetPeerInfo:input_type -> types.P2PGetPeerReq
+	44, // 51: types.chain33.NetInfo:input_type -> types.P2PGetNetInfoReq
+	15, // 52: types.chain33.IsNtpClockSync:input_type -> types.ReqNil
+	15, // 53: types.chain33.GetFatalFailure:input_type -> types.ReqNil
+	15, // 54: types.chain33.GetLastBlockSequence:input_type -> types.ReqNil
+	18, // 55: types.chain33.GetSequenceByHash:input_type -> types.ReqHash
+	21, // 56: types.chain33.GetBlockByHashes:input_type -> types.ReqHashes
+	45, // 57: types.chain33.GetBlockBySeq:input_type -> types.Int64
+	15, // 58: types.chain33.CloseQueue:input_type -> types.ReqNil
+	46, // 59: types.chain33.GetAllExecBalance:input_type -> types.ReqAllExecBalance
+	47, // 60: types.chain33.SignRawTx:input_type -> types.ReqSignRawTx
+	48, // 61: types.chain33.CreateNoBalanceTransaction:input_type -> types.NoBalanceTx
+	49, // 62: types.chain33.QueryRandNum:input_type -> types.ReqRandHash
+	50, // 63: types.chain33.GetFork:input_type -> types.ReqKey
+	51, // 64: types.chain33.CreateNoBalanceTxs:input_type -> types.NoBalanceTxs
+	52, // 65: types.chain33.GetParaTxByTitle:input_type -> types.ReqParaTxByTitle
+	53, // 66: types.chain33.LoadParaTxByTitle:input_type -> types.ReqHeightByTitle
+	54, // 67: types.chain33.GetParaTxByHeight:input_type -> types.ReqParaTxByHeight
+	14, // 68: types.chain33.GetHeaders:input_type -> types.ReqBlocks
+	15, // 69: types.chain33.GetServerTime:input_type -> types.ReqNil
+	15, // 70: types.chain33.GetCryptoList:input_type -> types.ReqNil
+	15, // 71: types.chain33.GetAddressDrivers:input_type -> types.ReqNil
+	5,  // 72: types.chain33.SendDelayTransaction:input_type -> types.delayTx
+	6,  // 73: types.chain33.GetWalletRecoverAddress:input_type -> types.ReqGetWalletRecoverAddr
+	7,  // 74: types.chain33.SignWalletRecoverTx:input_type -> types.ReqSignWalletRecoverTx
+	8,  // 75: types.chain33.GetHTLCAddress:input_type -> types.ReqGetHTLCAddr
+	9,  // 76: types.chain33.SignHTLCTx:input_type -> types.ReqSignHTLCTx
+	15, // 77: types.chain33.GetChainConfig:input_type -> types.ReqNil
+	41, // 78: types.chain33.ConvertExectoAddr:input_type -> types.ReqString
+	15, // 79: types.chain33.GetCoinSymbol:input_type -> types.ReqNil
+	55, // 80: types.chain33.ReWriteTx:input_type -> types.ReWriteRawTx
+	14, // 81: types.chain33.GetBlockSequences:input_type -> types.ReqBlocks
+	56, // 82: types.chain33.AddPushSubscribe:input_type -> types.PushSubscribeReq
+	15, // 83: types.chain33.ListPushes:input_type -> types.ReqNil
+	41, // 84: types.chain33.GetPushSeqLastNum:input_type -> types.ReqString
+	57, // 85: types.chain33.ManagePush:input_type -> types.ReqManagePush
+	58, // 86: types.chain33.GetReorgHistory:input_type -> types.ReqReorgHistory
+	15, // 87: types.chain33.GetCheckpoints:input_type -> types.ReqNil
+	59, // 88: types.chain33.GetStateProof:input_type -> types.ReqStateProof
+	60, // 89: types.chain33.SubEvent:input_type -> types.ReqSubscribe
+	13, // 90: types.chain33.GetBlocks:output_type -> types.Reply
+	61, // 91: types.chain33.GetLastHeader:output_type -> types.Header
+	62, // 92: types.chain33.CreateRawTransaction:output_type -> types.UnsignTx
+	62, // 93: types.chain33.CreateRawTxGroup:output_type -> types.UnsignTx
+	63, // 94: types.chain33.QueryTransaction:output_type -> types.TransactionDetail
+	64, // 95: types.chain33.GetTxStatus:output_type -> types.TxStatusHistory
+	13, // 96: types.chain33.SendTransactionSync:output_type -> types.Reply
+	13, // 97: types.chain33.SendTransaction:output_type -> types.Reply
+	11, // 98: types.chain33.SendTransactions:output_type -> types.Replies
+	65, // 99: types.chain33.GetTransactionByAddr:output_type -> types.ReplyTxInfos
+	66, // 100: types.chain33.GetTransactionByHashes:output_type -> types.TransactionDetails
+	67, // 101: types.chain33.GetMemPool:output_type -> types.ReplyTxList
+	68, // 102: types.chain33.GetAccounts:output_type -> types.WalletAccounts
+	69, // 103: types.chain33.GetAccount:output_type -> types.WalletAccount
+	69, // 104: types.chain33.NewAccount:output_type -> types.WalletAccount
+	70, // 105: types.chain33.WalletTransactionList:output_type -> types.WalletTxDetails
+	69, // 106: types.chain33.ImportPrivkey:output_type -> types.WalletAccount
+	71, // 107: types.chain33.SendToAddress:output_type -> types.ReplyHash
+	13, // 108: types.chain33.SetTxFee:output_type -> types.Reply
+	69, // 109: types.chain33.SetLabl:output_type -> types.WalletAccount
+	72, // 110: types.chain33.MergeBalance:output_type -> types.ReplyHashes
+	13, // 111: types.chain33.SetPasswd:output_type -> types.Reply
+	13, // 112: types.chain33.Lock:output_type -> types.Reply
+	13, // 113: types.chain33.UnLock:output_type -> types.Reply
+	67, // 114: types.chain33.GetLastMemPool:output_type -> types.ReplyTxList
+	73, // 115: types.chain33.GetProperFee:output_type -> types.ReplyProperFee
+	74, // 116: types.chain33.GetWalletStatus:output_type -> types.WalletStatus
+	75, // 117: types.chain33.GetBlockOverview:output_type -> types.BlockOverview
+	76, // 118: types.chain33.GetAddrOverview:output_type -> types.AddrOverview
+	71, // 119: types.chain33.GetBlockHash:output_type -> types.ReplyHash
+	77, // 120: types.chain33.GenSeed:output_type -> types.ReplySeed
+	77, // 121: types.chain33.GetSeed:output_type -> types.ReplySeed
+	13, // 122: types.chain33.SaveSeed:output_type -> types.Reply
+	78, // 123: types.chain33.GetBalance:output_type -> types.Accounts
+	13, // 124: types.chain33.QueryChain:output_type -> types.Reply
+	13, // 125: types.chain33.ExecWallet:output_type -> types.Reply
+	13, // 126: types.chain33.QueryConsensus:output_type -> types.Reply
+	62, // 127: types.chain33.CreateTransaction:output_type -> types.UnsignTx
+	79, // 128: types.chain33.GetHexTxByHash:output_type -> types.HexTx
+	80, // 129: types.chain33.DumpPrivkey:output_type -> types.ReplyString
+	13, // 130: types.chain33.DumpPrivkeysFile:output_type -> types.Reply
+	13, // 131: types.chain33.ImportPrivkeysFile:output_type -> types.Reply
+	81, // 132: types.chain33.Version:output_type -> types.VersionInfo
+	13, // 133: types.chain33.IsSync:output_type -> types.Reply
+	82, // 134: types.chain33.GetPeerInfo:output_type -> types.PeerList
+	83, // 135: types.chain33.NetInfo:output_type -> types.NodeNetInfo
+	13, // 136: types.chain33.IsNtpClockSync:output_type -> types.Reply
+	84, // 137: types.chain33.GetFatalFailure:output_type -> types.Int32
+	45, // 138: types.chain33.GetLastBlockSequence:output_type -> types.Int64
+	45, // 139: types.chain33.GetSequenceByHash:output_type -> types.Int64
+	85, // 140: types.chain33.GetBlockByHashes:output_type -> types.BlockDetails
+	86, // 141: types.chain33.GetBlockBySeq:output_type -> types.BlockSeq
+	13, // 142: types.chain33.CloseQueue:output_type -> types.Reply
+	87, // 143: types.chain33.GetAllExecBalance:output_type -> types.AllExecBalance
+	88, // 144: types.chain33.SignRawTx:output_type -> types.ReplySignRawTx
+	88, // 145: types.chain33.CreateNoBalanceTransaction:output_type -> types.ReplySignRawTx
+	71, // 146: types.chain33.QueryRandNum:output_type -> types.ReplyHash
+	45, // 147: types.chain33.GetFork:output_type -> types.Int64
+	88, // 148: types.chain33.CreateNoBalanceTxs:output_type -> types.ReplySignRawTx
+	89, // 149: types.chain33.GetParaTxByTitle:output_type -> types.ParaTxDetails
+	90, // 150: types.chain33.LoadParaTxByTitle:output_type -> types.ReplyHeightByTitle
+	89, // 151: types.chain33.GetParaTxByHeight:output_type -> types.ParaTxDetails
+	91, // 152: types.chain33.GetHeaders:output_type -> types.Headers
+	0,  // 153: types.chain33.GetServerTime:output_type -> types.serverTime
+	2,  // 154: types.chain33.GetCryptoList:output_type -> types.cryptoList
+	4,  // 155: types.chain33.GetAddressDrivers:output_type -> types.addressDrivers
+	13, // 156: types.chain33.SendDelayTransaction:output_type -> types.Reply
+	80, // 157: types.chain33.GetWalletRecoverAddress:output_type -> types.ReplyString
+	88, // 158: types.chain33.SignWalletRecoverTx:output_type -> types.ReplySignRawTx
+	80, // 159: types.chain33.GetHTLCAddress:output_type -> types.ReplyString
+	88, // 160: types.chain33.SignHTLCTx:output_type -> types.ReplySignRawTx
+	10, // 161: types.chain33.GetChainConfig:output_type -> types.ChainConfigInfo
+	80, // 162: types.chain33.ConvertExectoAddr:output_type -> types.ReplyString
+	80, // 163: types.chain33.GetCoinSymbol:output_type -> types.ReplyString
+	62, // 164: types.chain33.ReWriteTx:output_type -> types.UnsignTx
+	92, // 165: types.chain33.GetBlockSequences:output_type -> types.BlockSequences
+	93, // 166: types.chain33.AddPushSubscribe:output_type -> types.ReplySubscribePush
+	94, // 167: types.chain33.ListPushes:output_type -> types.PushSubscribes
+	45, // 168: types.chain33.GetPushSeqLastNum:output_type -> types.Int64
+	93, // 169: types.chain33.ManagePush:output_type -> types.ReplySubscribePush
+	95, // 170: types.chain33.GetReorgHistory:output_type -> types.ReorgRecords
+	96, // 171: types.chain33.GetCheckpoints:output_type -> types.BlockCheckpoints
+	97, // 172: types.chain33.GetStateProof:output_type -> types.StateProof
+	98, // 173: types.chain33.SubEvent:output_type -> types.PushData
+	90, // [90:174] is the sub-list for method output_type
+	6,  // [6:90] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -1302,6 +1526,7 @@ func file_rpc_proto_init() {
 	file_account_proto_init()
 	file_executor_proto_init()
 	file_push_tx_receipt_proto_init()
+	file_db_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerTime); i {
@@ -1400,7 +1625,7 @@ func file_rpc_proto_init() {
 			}
 		}
 		file_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfigInfo); i {
+			switch v := v.(*ReqGetHTLCAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1412,6 +1637,30 @@ func file_rpc_proto_init() {
 			}
 		}
 		file_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqSignHTLCTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainConfigInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Replies); i {
 			case 0:
 				return &v.state
@@ -1430,7 +1679,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1466,6 +1715,8 @@ type Chain33Client interface {
 	CreateRawTxGroup(ctx context.Context, in *CreateTransactionGroup, opts ...grpc.CallOption) (*UnsignTx, error)
 	// 根据哈希查询交易
 	QueryTransaction(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*TransactionDetail, error)
+	// 根据哈希查询交易最近的状态变化
+	GetTxStatus(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*TxStatusHistory, error)
 	// 发送交易&&根据哈希查询交易
 	SendTransactionSync(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*Reply, error)
 	// 发送交易
@@ -1587,6 +1838,10 @@ type Chain33Client interface {
 	GetWalletRecoverAddress(ctx context.Context, in *ReqGetWalletRecoverAddr, opts ...grpc.CallOption) (*ReplyString, error)
 	// 钱包找回交易签名
 	SignWalletRecoverTx(ctx context.Context, in *ReqSignWalletRecoverTx, opts ...grpc.CallOption) (*ReplySignRawTx, error)
+	// 获取哈希时间锁定地址
+	GetHTLCAddress(ctx context.Context, in *ReqGetHTLCAddr, opts ...grpc.CallOption) (*ReplyString, error)
+	// 哈希时间锁定交易签名, 提取或超时退回
+	SignHTLCTx(ctx context.Context, in *ReqSignHTLCTx, opts ...grpc.CallOption) (*ReplySignRawTx, error)
 	// 获取节点配置信息
 	GetChainConfig(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ChainConfigInfo, error)
 	//根据执行期名称创建对应的地址
@@ -1601,6 +1856,14 @@ type Chain33Client interface {
 	AddPushSubscribe(ctx context.Context, in *PushSubscribeReq, opts ...grpc.CallOption) (*ReplySubscribePush, error)
 	ListPushes(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*PushSubscribes, error)
 	GetPushSeqLastNum(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*Int64, error)
+	//暂停、恢复、删除订阅, 重置推送的sequence或者修改推送的URL
+	ManagePush(ctx context.Context, in *ReqManagePush, opts ...grpc.CallOption) (*ReplySubscribePush, error)
+	//获取主链切换的历史记录
+	GetReorgHistory(ctx context.Context, in *ReqReorgHistory, opts ...grpc.CallOption) (*ReorgRecords, error)
+	//获取区块同步的检查点
+	GetCheckpoints(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*BlockCheckpoints, error)
+	//获取状态数据的merkle证明
+	GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error)
 	//发送订阅的数据到客户端
 	SubEvent(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubEventClient, error)
 }
@@ -1658,6 +1921,15 @@ func (c *chain33Client) QueryTransaction(ctx context.Context, in *ReqHash, opts
 	return out, nil
 }
 
+func (c *chain33Client) GetTxStatus(ctx context.Context, in *ReqHash, opts ...grpc.CallOption) (*TxStatusHistory, error) {
+	out := new(TxStatusHistory)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetTxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) SendTransactionSync(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*Reply, error) {
 	out := new(Reply)
 	err := c.cc.Invoke(ctx, "/types.chain33/SendTransactionSync", in, out, opts...)
@@ -2225,6 +2497,24 @@ func (c *chain33Client) SignWalletRecoverTx(ctx context.Context, in *ReqSignWall
 	return out, nil
 }
 
+func (c *chain33Client) GetHTLCAddress(ctx context.Context, in *ReqGetHTLCAddr, opts ...grpc.CallOption) (*ReplyString, error) {
+	out := new(ReplyString)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetHTLCAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) SignHTLCTx(ctx context.Context, in *ReqSignHTLCTx, opts ...grpc.CallOption) (*ReplySignRawTx, error) {
+	out := new(ReplySignRawTx)
+	err := c.cc.Invoke(ctx, "/types.chain33/SignHTLCTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) GetChainConfig(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ChainConfigInfo, error) {
 	out := new(ChainConfigInfo)
 	err := c.cc.Invoke(ctx, "/types.chain33/GetChainConfig", in, out, opts...)
@@ -2297,6 +2587,42 @@ func (c *chain33Client) GetPushSeqLastNum(ctx context.Context, in *ReqString, op
 	return out, nil
 }
 
+func (c *chain33Client) ManagePush(ctx context.Context, in *ReqManagePush, opts ...grpc.CallOption) (*ReplySubscribePush, error) {
+	out := new(ReplySubscribePush)
+	err := c.cc.Invoke(ctx, "/types.chain33/ManagePush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetReorgHistory(ctx context.Context, in *ReqReorgHistory, opts ...grpc.CallOption) (*ReorgRecords, error) {
+	out := new(ReorgRecords)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetReorgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetCheckpoints(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*BlockCheckpoints, error) {
+	out := new(BlockCheckpoints)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chain33Client) GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/types.chain33/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chain33Client) SubEvent(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Chain33_SubEventClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_Chain33_serviceDesc.Streams[0], "/types.chain33/SubEvent", opts...)
 	if err != nil {
@@ -2341,6 +2667,8 @@ type Chain33Server interface {
 	CreateRawTxGroup(context.Context, *CreateTransactionGroup) (*UnsignTx, error)
 	// 根据哈希查询交易
 	QueryTransaction(context.Context, *ReqHash) (*TransactionDetail, error)
+	// 根据哈希查询交易最近的状态变化
+	GetTxStatus(context.Context, *ReqHash) (*TxStatusHistory, error)
 	// 发送交易&&根据哈希查询交易
 	SendTransactionSync(context.Context, *Transaction) (*Reply, error)
 	// 发送交易
@@ -2462,6 +2790,10 @@ type Chain33Server interface {
 	GetWalletRecoverAddress(context.Context, *ReqGetWalletRecoverAddr) (*ReplyString, error)
 	// 钱包找回交易签名
 	SignWalletRecoverTx(context.Context, *ReqSignWalletRecoverTx) (*ReplySignRawTx, error)
+	// 获取哈希时间锁定地址
+	GetHTLCAddress(context.Context, *ReqGetHTLCAddr) (*ReplyString, error)
+	// 哈希时间锁定交易签名, 提取或超时退回
+	SignHTLCTx(context.Context, *ReqSignHTLCTx) (*ReplySignRawTx, error)
 	// 获取节点配置信息
 	GetChainConfig(context.Context, *ReqNil) (*ChainConfigInfo, error)
 	//根据执行期名称创建对应的地址
@@ -2476,6 +2808,14 @@ type Chain33Server interface {
 	AddPushSubscribe(context.Context, *PushSubscribeReq) (*ReplySubscribePush, error)
 	ListPushes(context.Context, *ReqNil) (*PushSubscribes, error)
 	GetPushSeqLastNum(context.Context, *ReqString) (*Int64, error)
+	//暂停、恢复、删除订阅, 重置推送的sequence或者修改推送的URL
+	ManagePush(context.Context, *ReqManagePush) (*ReplySubscribePush, error)
+	//获取主链切换的历史记录
+	GetReorgHistory(context.Context, *ReqReorgHistory) (*ReorgRecords, error)
+	//获取区块同步的检查点
+	GetCheckpoints(context.Context, *ReqNil) (*BlockCheckpoints, error)
+	//获取状态数据的merkle证明
+	GetStateProof(context.Context, *ReqStateProof) (*StateProof, error)
 	//发送订阅的数据到客户端
 	SubEvent(*ReqSubscribe, Chain33_SubEventServer) error
 }
@@ -2499,6 +2839,9 @@ func (*UnimplementedChain33Server) CreateRawTxGroup(context.Context, *CreateTran
 func (*UnimplementedChain33Server) QueryTransaction(context.Context, *ReqHash) (*TransactionDetail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTransaction not implemented")
 }
+func (*UnimplementedChain33Server) GetTxStatus(context.Context, *ReqHash) (*TxStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxStatus not implemented")
+}
 func (*UnimplementedChain33Server) SendTransactionSync(context.Context, *Transaction) (*Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTransactionSync not implemented")
 }
@@ -2688,6 +3031,12 @@ func (*UnimplementedChain33Server) GetWalletRecoverAddress(context.Context, *Req
 func (*UnimplementedChain33Server) SignWalletRecoverTx(context.Context, *ReqSignWalletRecoverTx) (*ReplySignRawTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignWalletRecoverTx not implemented")
 }
+func (*UnimplementedChain33Server) GetHTLCAddress(context.Context, *ReqGetHTLCAddr) (*ReplyString, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHTLCAddress not implemented")
+}
+func (*UnimplementedChain33Server) SignHTLCTx(context.Context, *ReqSignHTLCTx) (*ReplySignRawTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHTLCTx not implemented")
+}
 func (*UnimplementedChain33Server) GetChainConfig(context.Context, *ReqNil) (*ChainConfigInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChainConfig not implemented")
 }
@@ -2712,6 +3061,18 @@ func (*UnimplementedChain33Server) ListPushes(context.Context, *ReqNil) (*PushSu
 func (*UnimplementedChain33Server) GetPushSeqLastNum(context.Context, *ReqString) (*Int64, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPushSeqLastNum not implemented")
 }
+func (*UnimplementedChain33Server) ManagePush(context.Context, *ReqManagePush) (*ReplySubscribePush, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagePush not implemented")
+}
+func (*UnimplementedChain33Server) GetReorgHistory(context.Context, *ReqReorgHistory) (*ReorgRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgHistory not implemented")
+}
+func (*UnimplementedChain33Server) GetCheckpoints(context.Context, *ReqNil) (*BlockCheckpoints, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpoints not implemented")
+}
+func (*UnimplementedChain33Server) GetStateProof(context.Context, *ReqStateProof) (*StateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
 func (*UnimplementedChain33Server) SubEvent(*ReqSubscribe, Chain33_SubEventServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubEvent not implemented")
 }
@@ -2810,6 +3171,24 @@ func _Chain33_QueryTransaction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetTxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetTxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetTxStatus(ctx, req.(*ReqHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_SendTransactionSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Transaction)
 	if err := dec(in); err != nil {
@@ -3944,6 +4323,42 @@ func _Chain33_SignWalletRecoverTx_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_GetHTLCAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetHTLCAddr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetHTLCAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetHTLCAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetHTLCAddress(ctx, req.(*ReqGetHTLCAddr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_SignHTLCTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqSignHTLCTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).SignHTLCTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/SignHTLCTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).SignHTLCTx(ctx, req.(*ReqSignHTLCTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_GetChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqNil)
 	if err := dec(in); err != nil {
@@ -4088,6 +4503,78 @@ func _Chain33_GetPushSeqLastNum_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chain33_ManagePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqManagePush)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).ManagePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/ManagePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).ManagePush(ctx, req.(*ReqManagePush))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqReorgHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetReorgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetReorgHistory(ctx, req.(*ReqReorgHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqNil)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetCheckpoints(ctx, req.(*ReqNil))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chain33_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Chain33Server).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.chain33/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Chain33Server).GetStateProof(ctx, req.(*ReqStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chain33_SubEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ReqSubscribe)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4133,6 +4620,10 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryTransaction",
 			Handler:    _Chain33_QueryTransaction_Handler,
 		},
+		{
+			MethodName: "GetTxStatus",
+			Handler:    _Chain33_GetTxStatus_Handler,
+		},
 		{
 			MethodName: "SendTransactionSync",
 			Handler:    _Chain33_SendTransactionSync_Handler,
@@ -4385,6 +4876,14 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignWalletRecoverTx",
 			Handler:    _Chain33_SignWalletRecoverTx_Handler,
 		},
+		{
+			MethodName: "GetHTLCAddress",
+			Handler:    _Chain33_GetHTLCAddress_Handler,
+		},
+		{
+			MethodName: "SignHTLCTx",
+			Handler:    _Chain33_SignHTLCTx_Handler,
+		},
 		{
 			MethodName: "GetChainConfig",
 			Handler:    _Chain33_GetChainConfig_Handler,
@@ -4417,6 +4916,22 @@ var _Chain33_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPushSeqLastNum",
 			Handler:    _Chain33_GetPushSeqLastNum_Handler,
 		},
+		{
+			MethodName: "ManagePush",
+			Handler:    _Chain33_ManagePush_Handler,
+		},
+		{
+			MethodName: "GetReorgHistory",
+			Handler:    _Chain33_GetReorgHistory_Handler,
+		},
+		{
+			MethodName: "GetCheckpoints",
+			Handler:    _Chain33_GetCheckpoints_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Chain33_GetStateProof_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1571,6 +1571,198 @@ func (x *ReqGetMempool) GetIsAll() bool {
 	return false
 }
 
+// 交易状态变化, status参见TxStatusXXX
+type TxStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   int64  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *TxStatusEvent) Reset() {
+	*x = TxStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStatusEvent) ProtoMessage() {}
+
+func (x *TxStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStatusEvent.ProtoReflect.Descriptor instead.
+func (*TxStatusEvent) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TxStatusEvent) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TxStatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TxStatusEvent) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TxStatusEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// 交易最近的状态变化记录, status为最新的状态
+type TxStatusHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash   []byte           `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status int32            `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Events []*TxStatusEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TxStatusHistory) Reset() {
+	*x = TxStatusHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStatusHistory) ProtoMessage() {}
+
+func (x *TxStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStatusHistory.ProtoReflect.Descriptor instead.
+func (*TxStatusHistory) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TxStatusHistory) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *TxStatusHistory) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TxStatusHistory) GetEvents() []*TxStatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// 订阅交易状态变化的推送, name为推送的主题
+type ReqSubTxStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Unsubscribe bool   `protobuf:"varint,2,opt,name=unsubscribe,proto3" json:"unsubscribe,omitempty"`
+}
+
+func (x *ReqSubTxStatus) Reset() {
+	*x = ReqSubTxStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transaction_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqSubTxStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqSubTxStatus) ProtoMessage() {}
+
+func (x *ReqSubTxStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqSubTxStatus.ProtoReflect.Descriptor instead.
+func (*ReqSubTxStatus) Descriptor() ([]byte, []int) {
+	return file_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReqSubTxStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReqSubTxStatus) GetUnsubscribe() bool {
+	if x != nil {
+		return x.Unsubscribe
+	}
+	return false
+}
+
 type ReqProperFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1583,7 +1775,7 @@ type ReqProperFee struct {
 func (x *ReqProperFee) Reset() {
 	*x = ReqProperFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[23]
+		mi := &file_transaction_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1596,7 +1788,7 @@ func (x *ReqProperFee) String() string {
 func (*ReqProperFee) ProtoMessage() {}
 
 func (x *ReqProperFee) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[23]
+	mi := &file_transaction_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +1801,7 @@ func (x *ReqProperFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqProperFee.ProtoReflect.Descriptor instead.
 func (*ReqProperFee) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{23}
+	return file_transaction_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReqProperFee) GetTxCount() int32 {
@@ -1637,7 +1829,7 @@ type ReplyProperFee struct {
 func (x *ReplyProperFee) Reset() {
 	*x = ReplyProperFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[24]
+		mi := &file_transaction_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1650,7 +1842,7 @@ func (x *ReplyProperFee) String() string {
 func (*ReplyProperFee) ProtoMessage() {}
 
 func (x *ReplyProperFee) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[24]
+	mi := &file_transaction_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +1855,7 @@ func (x *ReplyProperFee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyProperFee.ProtoReflect.Descriptor instead.
 func (*ReplyProperFee) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{24}
+	return file_transaction_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReplyProperFee) GetProperFee() int64 {
@@ -1686,7 +1878,7 @@ type TxHashList struct {
 func (x *TxHashList) Reset() {
 	*x = TxHashList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[25]
+		mi := &file_transaction_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1699,7 +1891,7 @@ func (x *TxHashList) String() string {
 func (*TxHashList) ProtoMessage() {}
 
 func (x *TxHashList) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[25]
+	mi := &file_transaction_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,7 +1904,7 @@ func (x *TxHashList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxHashList.ProtoReflect.Descriptor instead.
 func (*TxHashList) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{25}
+	return file_transaction_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TxHashList) GetHashes() [][]byte {
@@ -1747,7 +1939,7 @@ type ReplyTxInfos struct {
 func (x *ReplyTxInfos) Reset() {
 	*x = ReplyTxInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[26]
+		mi := &file_transaction_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1760,7 +1952,7 @@ func (x *ReplyTxInfos) String() string {
 func (*ReplyTxInfos) ProtoMessage() {}
 
 func (x *ReplyTxInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[26]
+	mi := &file_transaction_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1773,7 +1965,7 @@ func (x *ReplyTxInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyTxInfos.ProtoReflect.Descriptor instead.
 func (*ReplyTxInfos) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{26}
+	return file_transaction_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ReplyTxInfos) GetTxInfos() []*ReplyTxInfo {
@@ -1802,7 +1994,7 @@ type AddrTxFeeInfo struct {
 func (x *AddrTxFeeInfo) Reset() {
 	*x = AddrTxFeeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[27]
+		mi := &file_transaction_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1815,7 +2007,7 @@ func (x *AddrTxFeeInfo) String() string {
 func (*AddrTxFeeInfo) ProtoMessage() {}
 
 func (x *AddrTxFeeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[27]
+	mi := &file_transaction_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1828,7 +2020,7 @@ func (x *AddrTxFeeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddrTxFeeInfo.ProtoReflect.Descriptor instead.
 func (*AddrTxFeeInfo) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{27}
+	return file_transaction_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AddrTxFeeInfo) GetFromAddr() string {
@@ -1898,7 +2090,7 @@ type AddrTxFeeInfos struct {
 func (x *AddrTxFeeInfos) Reset() {
 	*x = AddrTxFeeInfos{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[28]
+		mi := &file_transaction_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1911,7 +2103,7 @@ func (x *AddrTxFeeInfos) String() string {
 func (*AddrTxFeeInfos) ProtoMessage() {}
 
 func (x *AddrTxFeeInfos) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[28]
+	mi := &file_transaction_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,7 +2116,7 @@ func (x *AddrTxFeeInfos) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddrTxFeeInfos.ProtoReflect.Descriptor instead.
 func (*AddrTxFeeInfos) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{28}
+	return file_transaction_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AddrTxFeeInfos) GetTxInfos() []*AddrTxFeeInfo {
@@ -1946,7 +2138,7 @@ type ReceiptLog struct {
 func (x *ReceiptLog) Reset() {
 	*x = ReceiptLog{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[29]
+		mi := &file_transaction_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1959,7 +2151,7 @@ func (x *ReceiptLog) String() string {
 func (*ReceiptLog) ProtoMessage() {}
 
 func (x *ReceiptLog) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[29]
+	mi := &file_transaction_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,7 +2164,7 @@ func (x *ReceiptLog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptLog.ProtoReflect.Descriptor instead.
 func (*ReceiptLog) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{29}
+	return file_transaction_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ReceiptLog) GetTy() int32 {
@@ -2005,7 +2197,7 @@ type Receipt struct {
 func (x *Receipt) Reset() {
 	*x = Receipt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[30]
+		mi := &file_transaction_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2018,7 +2210,7 @@ func (x *Receipt) String() string {
 func (*Receipt) ProtoMessage() {}
 
 func (x *Receipt) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[30]
+	mi := &file_transaction_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2031,7 +2223,7 @@ func (x *Receipt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{30}
+	return file_transaction_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Receipt) GetTy() int32 {
@@ -2067,7 +2259,7 @@ type ReceiptData struct {
 func (x *ReceiptData) Reset() {
 	*x = ReceiptData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[31]
+		mi := &file_transaction_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2080,7 +2272,7 @@ func (x *ReceiptData) String() string {
 func (*ReceiptData) ProtoMessage() {}
 
 func (x *ReceiptData) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[31]
+	mi := &file_transaction_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,7 +2285,7 @@ func (x *ReceiptData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReceiptData.ProtoReflect.Descriptor instead.
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{31}
+	return file_transaction_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ReceiptData) GetTy() int32 {
@@ -2126,7 +2318,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[32]
+		mi := &file_transaction_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2139,7 +2331,7 @@ func (x *TxResult) String() string {
 func (*TxResult) ProtoMessage() {}
 
 func (x *TxResult) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[32]
+	mi := &file_transaction_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,7 +2344,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{32}
+	return file_transaction_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TxResult) GetHeight() int64 {
@@ -2219,7 +2411,7 @@ type TransactionDetail struct {
 func (x *TransactionDetail) Reset() {
 	*x = TransactionDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[33]
+		mi := &file_transaction_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2232,7 +2424,7 @@ func (x *TransactionDetail) String() string {
 func (*TransactionDetail) ProtoMessage() {}
 
 func (x *TransactionDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[33]
+	mi := &file_transaction_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,7 +2437,7 @@ func (x *TransactionDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionDetail.ProtoReflect.Descriptor instead.
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{33}
+	return file_transaction_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TransactionDetail) GetTx() *Transaction {
@@ -2343,7 +2535,7 @@ type TransactionDetails struct {
 func (x *TransactionDetails) Reset() {
 	*x = TransactionDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[34]
+		mi := &file_transaction_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2356,7 +2548,7 @@ func (x *TransactionDetails) String() string {
 func (*TransactionDetails) ProtoMessage() {}
 
 func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[34]
+	mi := &file_transaction_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2561,7 @@ func (x *TransactionDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionDetails.ProtoReflect.Descriptor instead.
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{34}
+	return file_transaction_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TransactionDetails) GetTxs() []*TransactionDetail {
@@ -2390,7 +2582,7 @@ type ReqAddrs struct {
 func (x *ReqAddrs) Reset() {
 	*x = ReqAddrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[35]
+		mi := &file_transaction_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2403,7 +2595,7 @@ func (x *ReqAddrs) String() string {
 func (*ReqAddrs) ProtoMessage() {}
 
 func (x *ReqAddrs) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[35]
+	mi := &file_transaction_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,7 +2608,7 @@ func (x *ReqAddrs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqAddrs.ProtoReflect.Descriptor instead.
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{35}
+	return file_transaction_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ReqAddrs) GetAddrs() []string {
@@ -2437,7 +2629,7 @@ type ReqDecodeRawTransaction struct {
 func (x *ReqDecodeRawTransaction) Reset() {
 	*x = ReqDecodeRawTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[36]
+		mi := &file_transaction_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2450,7 +2642,7 @@ func (x *ReqDecodeRawTransaction) String() string {
 func (*ReqDecodeRawTransaction) ProtoMessage() {}
 
 func (x *ReqDecodeRawTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[36]
+	mi := &file_transaction_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2463,7 +2655,7 @@ func (x *ReqDecodeRawTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqDecodeRawTransaction.ProtoReflect.Descriptor instead.
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{36}
+	return file_transaction_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ReqDecodeRawTransaction) GetTxHex() string {
@@ -2485,7 +2677,7 @@ type UserWrite struct {
 func (x *UserWrite) Reset() {
 	*x = UserWrite{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[37]
+		mi := &file_transaction_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2498,7 +2690,7 @@ func (x *UserWrite) String() string {
 func (*UserWrite) ProtoMessage() {}
 
 func (x *UserWrite) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[37]
+	mi := &file_transaction_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2511,7 +2703,7 @@ func (x *UserWrite) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserWrite.ProtoReflect.Descriptor instead.
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{37}
+	return file_transaction_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UserWrite) GetTopic() string {
@@ -2541,7 +2733,7 @@ type UpgradeMeta struct {
 func (x *UpgradeMeta) Reset() {
 	*x = UpgradeMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[38]
+		mi := &file_transaction_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2554,7 +2746,7 @@ func (x *UpgradeMeta) String() string {
 func (*UpgradeMeta) ProtoMessage() {}
 
 func (x *UpgradeMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[38]
+	mi := &file_transaction_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2567,7 +2759,7 @@ func (x *UpgradeMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradeMeta.ProtoReflect.Descriptor instead.
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{38}
+	return file_transaction_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpgradeMeta) GetStarting() bool {
@@ -2604,7 +2796,7 @@ type ReqTxHashList struct {
 func (x *ReqTxHashList) Reset() {
 	*x = ReqTxHashList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[39]
+		mi := &file_transaction_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2617,7 +2809,7 @@ func (x *ReqTxHashList) String() string {
 func (*ReqTxHashList) ProtoMessage() {}
 
 func (x *ReqTxHashList) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[39]
+	mi := &file_transaction_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,7 +2822,7 @@ func (x *ReqTxHashList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqTxHashList.ProtoReflect.Descriptor instead.
 func (*ReqTxHashList) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{39}
+	return file_transaction_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ReqTxHashList) GetHashes() []string {
@@ -2661,7 +2853,7 @@ type TxProof struct {
 func (x *TxProof) Reset() {
 	*x = TxProof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[40]
+		mi := &file_transaction_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2674,7 +2866,7 @@ func (x *TxProof) String() string {
 func (*TxProof) ProtoMessage() {}
 
 func (x *TxProof) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[40]
+	mi := &file_transaction_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,7 +2879,7 @@ func (x *TxProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxProof.ProtoReflect.Descriptor instead.
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{40}
+	return file_transaction_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *TxProof) GetProofs() [][]byte {
@@ -2723,7 +2915,7 @@ type ReqCheckTxsExist struct {
 func (x *ReqCheckTxsExist) Reset() {
 	*x = ReqCheckTxsExist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[41]
+		mi := &file_transaction_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2736,7 +2928,7 @@ func (x *ReqCheckTxsExist) String() string {
 func (*ReqCheckTxsExist) ProtoMessage() {}
 
 func (x *ReqCheckTxsExist) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[41]
+	mi := &file_transaction_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,7 +2941,7 @@ func (x *ReqCheckTxsExist) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqCheckTxsExist.ProtoReflect.Descriptor instead.
 func (*ReqCheckTxsExist) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{41}
+	return file_transaction_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ReqCheckTxsExist) GetTxHashes() [][]byte {
@@ -2773,7 +2965,7 @@ type ReplyCheckTxsExist struct {
 func (x *ReplyCheckTxsExist) Reset() {
 	*x = ReplyCheckTxsExist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transaction_proto_msgTypes[42]
+		mi := &file_transaction_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2786,7 +2978,7 @@ func (x *ReplyCheckTxsExist) String() string {
 func (*ReplyCheckTxsExist) ProtoMessage() {}
 
 func (x *ReplyCheckTxsExist) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_proto_msgTypes[42]
+	mi := &file_transaction_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2799,7 +2991,7 @@ func (x *ReplyCheckTxsExist) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyCheckTxsExist.ProtoReflect.Descriptor instead.
 func (*ReplyCheckTxsExist) Descriptor() ([]byte, []int) {
-	return file_transaction_proto_rawDescGZIP(), []int{42}
+	return file_transaction_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ReplyCheckTxsExist) GetExistFlags() []bool {
@@ -2966,131 +3158,149 @@ var file_transaction_proto_rawDesc = []byte{
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x52,
 	0x65, 0x71, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05,
 	0x69, 0x73, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x41,
-	0x6c, 0x6c, 0x22, 0x40, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x46,
-	0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x78,
-	0x53, 0x69, 0x7a, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x46, 0x65, 0x65, 0x22, 0x52, 0x0a, 0x0a, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x69,
+	0x6c, 0x6c, 0x22, 0x6b, 0x0a, 0x0d, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x6b, 0x0a, 0x0f, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x0e,
+	0x52, 0x65, 0x71, 0x53, 0x75, 0x62, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2e, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x46, 0x65, 0x65, 0x22, 0x52, 0x0a, 0x0a, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x74, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64,
+	0x72, 0x54, 0x78, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x54, 0x78,
+	0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x54, 0x78, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x74, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x2e, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x61, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x02, 0x4b, 0x56, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x22, 0xd0, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78,
+	0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x2c,
+	0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x78,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74, 0x78,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x40, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x03, 0x74, 0x78, 0x73, 0x22, 0x20, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x44, 0x65, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x3b, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x49, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x69,
 	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x74,
-	0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x54,
-	0x78, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
-	0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x78, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x54, 0x78, 0x46, 0x65,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x66, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x54, 0x78, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x74,
-	0x78, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x2e, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x61, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74,
-	0x79, 0x12, 0x1f, 0x0a, 0x02, 0x4b, 0x56, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
-	0x4b, 0x56, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x0b, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22,
-	0xd0, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x34,
-	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x2c, 0x0a, 0x07,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x78, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74, 0x78, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68,
-	0x22, 0x40, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x03, 0x74,
-	0x78, 0x73, 0x22, 0x20, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x64, 0x64, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x44, 0x65, 0x63, 0x6f, 0x64,
-	0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x3b, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x49, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x53, 0x68,
-	0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
-	0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x53, 0x0a, 0x07, 0x54, 0x78,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22,
-	0x2e, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22,
-	0x54, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x78, 0x73,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74, 0x46, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x33, 0x33,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x53, 0x0a, 0x07,
+	0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x2e, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x78, 0x73,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x22, 0x54, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54,
+	0x78, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x33, 0x33, 0x63, 0x6e, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x33, 0x33, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3105,7 +3315,7 @@ func file_transaction_proto_rawDescGZIP() []byte {
 	return file_transaction_proto_rawDescData
 }
 
-var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_transaction_proto_goTypes = []interface{}{
 	(*AssetsGenesis)(nil),           // 0: types.AssetsGenesis
 	(*AssetsTransferToExec)(nil),    // 1: types.AssetsTransferToExec
@@ -3130,27 +3340,30 @@ var file_transaction_proto_goTypes = []interface{}{
 	(*ReqTxList)(nil),               // 20: types.ReqTxList
 	(*ReplyTxList)(nil),             // 21: types.ReplyTxList
 	(*ReqGetMempool)(nil),           // 22: types.ReqGetMempool
-	(*ReqProperFee)(nil),            // 23: types.ReqProperFee
-	(*ReplyProperFee)(nil),          // 24: types.ReplyProperFee
-	(*TxHashList)(nil),              // 25: types.TxHashList
-	(*ReplyTxInfos)(nil),            // 26: types.ReplyTxInfos
-	(*AddrTxFeeInfo)(nil),           // 27: types.AddrTxFeeInfo
-	(*AddrTxFeeInfos)(nil),          // 28: types.AddrTxFeeInfos
-	(*ReceiptLog)(nil),              // 29: types.ReceiptLog
-	(*Receipt)(nil),                 // 30: types.Receipt
-	(*ReceiptData)(nil),             // 31: types.ReceiptData
-	(*TxResult)(nil),                // 32: types.TxResult
-	(*TransactionDetail)(nil),       // 33: types.TransactionDetail
-	(*TransactionDetails)(nil),      // 34: types.TransactionDetails
-	(*ReqAddrs)(nil),                // 35: types.ReqAddrs
-	(*ReqDecodeRawTransaction)(nil), // 36: types.ReqDecodeRawTransaction
-	(*UserWrite)(nil),               // 37: types.UserWrite
-	(*UpgradeMeta)(nil),             // 38: types.UpgradeMeta
-	(*ReqTxHashList)(nil),           // 39: types.ReqTxHashList
-	(*TxProof)(nil),                 // 40: types.TxProof
-	(*ReqCheckTxsExist)(nil),        // 41: types.ReqCheckTxsExist
-	(*ReplyCheckTxsExist)(nil),      // 42: types.ReplyCheckTxsExist
-	(*KeyValue)(nil),                // 43: types.KeyValue
+	(*TxStatusEvent)(nil),           // 23: types.TxStatusEvent
+	(*TxStatusHistory)(nil),         // 24: types.TxStatusHistory
+	(*ReqSubTxStatus)(nil),          // 25: types.ReqSubTxStatus
+	(*ReqProperFee)(nil),            // 26: types.ReqProperFee
+	(*ReplyProperFee)(nil),          // 27: types.ReplyProperFee
+	(*TxHashList)(nil),              // 28: types.TxHashList
+	(*ReplyTxInfos)(nil),            // 29: types.ReplyTxInfos
+	(*AddrTxFeeInfo)(nil),           // 30: types.AddrTxFeeInfo
+	(*AddrTxFeeInfos)(nil),          // 31: types.AddrTxFeeInfos
+	(*ReceiptLog)(nil),              // 32: types.ReceiptLog
+	(*Receipt)(nil),                 // 33: types.Receipt
+	(*ReceiptData)(nil),             // 34: types.ReceiptData
+	(*TxResult)(nil),                // 35: types.TxResult
+	(*TransactionDetail)(nil),       // 36: types.TransactionDetail
+	(*TransactionDetails)(nil),      // 37: types.TransactionDetails
+	(*ReqAddrs)(nil),                // 38: types.ReqAddrs
+	(*ReqDecodeRawTransaction)(nil), // 39: types.ReqDecodeRawTransaction
+	(*UserWrite)(nil),               // 40: types.UserWrite
+	(*UpgradeMeta)(nil),             // 41: types.UpgradeMeta
+	(*ReqTxHashList)(nil),           // 42: types.ReqTxHashList
+	(*TxProof)(nil),                 // 43: types.TxProof
+	(*ReqCheckTxsExist)(nil),        // 44: types.ReqCheckTxsExist
+	(*ReplyCheckTxsExist)(nil),      // 45: types.ReplyCheckTxsExist
+	(*KeyValue)(nil),                // 46: types.KeyValue
 }
 var file_transaction_proto_depIdxs = []int32{
 	15, // 0: types.Transaction.signature:type_name -> types.Signature
@@ -3158,23 +3371,24 @@ var file_transaction_proto_depIdxs = []int32{
 	14, // 2: types.RingSignature.items:type_name -> types.RingSignatureItem
 	4,  // 3: types.ReplyTxInfo.assets:type_name -> types.Asset
 	11, // 4: types.ReplyTxList.txs:type_name -> types.Transaction
-	19, // 5: types.ReplyTxInfos.txInfos:type_name -> types.ReplyTxInfo
-	27, // 6: types.AddrTxFeeInfos.txInfos:type_name -> types.AddrTxFeeInfo
-	43, // 7: types.Receipt.KV:type_name -> types.KeyValue
-	29, // 8: types.Receipt.logs:type_name -> types.ReceiptLog
-	29, // 9: types.ReceiptData.logs:type_name -> types.ReceiptLog
-	11, // 10: types.TxResult.tx:type_name -> types.Transaction
-	31, // 11: types.TxResult.receiptdate:type_name -> types.ReceiptData
-	11, // 12: types.TransactionDetail.tx:type_name -> types.Transaction
-	31, // 13: types.TransactionDetail.receipt:type_name -> types.ReceiptData
-	4,  // 14: types.TransactionDetail.assets:type_name -> types.Asset
-	40, // 15: types.TransactionDetail.txProofs:type_name -> types.TxProof
-	33, // 16: types.TransactionDetails.txs:type_name -> types.TransactionDetail
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	23, // 5: types.TxStatusHistory.events:type_name -> types.TxStatusEvent
+	19, // 6: types.ReplyTxInfos.txInfos:type_name -> types.ReplyTxInfo
+	30, // 7: types.AddrTxFeeInfos.txInfos:type_name -> types.AddrTxFeeInfo
+	46, // 8: types.Receipt.KV:type_name -> types.KeyValue
+	32, // 9: types.Receipt.logs:type_name -> types.ReceiptLog
+	32, // 10: types.ReceiptData.logs:type_name -> types.ReceiptLog
+	11, // 11: types.TxResult.tx:type_name -> types.Transaction
+	34, // 12: types.TxResult.receiptdate:type_name -> types.ReceiptData
+	11, // 13: types.TransactionDetail.tx:type_name -> types.Transaction
+	34, // 14: types.TransactionDetail.receipt:type_name -> types.ReceiptData
+	4,  // 15: types.TransactionDetail.assets:type_name -> types.Asset
+	43, // 16: types.TransactionDetail.txProofs:type_name -> types.TxProof
+	36, // 17: types.TransactionDetails.txs:type_name -> types.TransactionDetail
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_transaction_proto_init() }
@@ -3461,7 +3675,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqProperFee); i {
+			switch v := v.(*TxStatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3473,7 +3687,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyProperFee); i {
+			switch v := v.(*TxStatusHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3485,7 +3699,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxHashList); i {
+			switch v := v.(*ReqSubTxStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3497,7 +3711,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyTxInfos); i {
+			switch v := v.(*ReqProperFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3509,7 +3723,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrTxFeeInfo); i {
+			switch v := v.(*ReplyProperFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3521,7 +3735,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddrTxFeeInfos); i {
+			switch v := v.(*TxHashList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3533,7 +3747,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptLog); i {
+			switch v := v.(*ReplyTxInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3545,7 +3759,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Receipt); i {
+			switch v := v.(*AddrTxFeeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3557,7 +3771,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptData); i {
+			switch v := v.(*AddrTxFeeInfos); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3569,7 +3783,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*ReceiptLog); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3581,7 +3795,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionDetail); i {
+			switch v := v.(*Receipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3593,7 +3807,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionDetails); i {
+			switch v := v.(*ReceiptData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3605,7 +3819,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqAddrs); i {
+			switch v := v.(*TxResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3617,7 +3831,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqDecodeRawTransaction); i {
+			switch v := v.(*TransactionDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3629,7 +3843,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserWrite); i {
+			switch v := v.(*TransactionDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3641,7 +3855,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeMeta); i {
+			switch v := v.(*ReqAddrs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3653,7 +3867,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqTxHashList); i {
+			switch v := v.(*ReqDecodeRawTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3665,7 +3879,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxProof); i {
+			switch v := v.(*UserWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3677,7 +3891,7 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqCheckTxsExist); i {
+			switch v := v.(*UpgradeMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3689,6 +3903,42 @@ func file_transaction_proto_init() {
 			}
 		}
 		file_transaction_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqTxHashList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqCheckTxsExist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transaction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplyCheckTxsExist); i {
 			case 0:
 				return &v.state
@@ -3707,7 +3957,7 @@ func file_transaction_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_transaction_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   43,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -474,14 +474,19 @@ func (tx *Transaction) CheckSign(blockHeight int64) bool {
 
 //txgroup 的情况
 func (tx *Transaction) checkSign(blockHeight int64) bool {
+	if tx.GetSignature() == nil {
+		return false
+	}
+	return CheckSign(tx.signData(), string(tx.Execer), tx.GetSignature(), blockHeight)
+}
+
+//signData 签名的原始数据, 即不含签名的交易编码
+func (tx *Transaction) signData() []byte {
 	copytx := CloneTx(tx)
 	copytx.Signature = nil
 	data := Encode(copytx)
 	FreeTx(copytx)
-	if tx.GetSignature() == nil {
-		return false
-	}
-	return CheckSign(data, string(tx.Execer), tx.GetSignature(), blockHeight)
+	return data
 }
 
 //Check 交易检测
@@ -276,6 +276,39 @@ func (innernode *InnerNode) Hash() []byte {
 	return common.Sha256(data)
 }
 
+//ProofHash 根据merkle证明中的兄弟节点和子节点hash计算中间节点的hash, LeftHash为空表示子节点在左侧
+func (innernode *InnerNode) ProofHash(childHash []byte) []byte {
+	node := InnerNode{Height: innernode.Height, Size: innernode.Size}
+	if len(innernode.LeftHash) == 0 {
+		node.LeftHash = childHash
+		node.RightHash = innernode.RightHash
+	} else {
+		node.LeftHash = innernode.LeftHash
+		node.RightHash = childHash
+	}
+	return node.Hash()
+}
+
+//Verify 验证状态数据证明, stateHash为可信的区块头中的状态hash, 不依赖本地数据库
+//key不存在的证明需要使用mavl.VerifyAbsenceProof验证
+func (proof *StateProof) Verify(stateHash []byte) error {
+	if proof.GetProof() == nil || len(stateHash) == 0 {
+		return ErrInvalidParam
+	}
+	if !bytes.Equal(proof.StateHash, stateHash) || !bytes.Equal(proof.Proof.RootHash, stateHash) {
+		return ErrStateProofRoot
+	}
+	leafNode := LeafNode{Key: proof.Key, Value: proof.Value, Height: 0, Size: 1}
+	hash := leafNode.Hash()
+	for _, branch := range proof.Proof.InnerNodes {
+		hash = branch.ProofHash(hash)
+	}
+	if !bytes.Equal(hash, stateHash) {
+		return ErrStateProofInvalid
+	}
+	return nil
+}
+
 //NewErrReceipt  new一个新的Receipt
 func NewErrReceipt(err error) *Receipt {
 	berr := err.Error()
@@ -166,8 +166,15 @@ func RunChain33(name, defCfg string) {
 	mem := mempool.New(chain33Cfg)
 	mem.SetQueueClient(q.Client())
 
-	log.Info("loading execs module")
-	exec := executor.New(chain33Cfg)
+	//轻节点只同步区块头, 不运行执行器和状态存储模块
+	lightMode := cfg.BlockChain.LightMode
+	log.Info("loading execs module", "lightMode", lightMode)
+	var exec queue.Module
+	if lightMode {
+		exec = &util.MockModule{Key: "execs"}
+	} else {
+		exec = executor.New(chain33Cfg)
+	}
 	exec.SetQueueClient(q.Client())
 
 	log.Info("loading blockchain module")
@@ -177,11 +184,17 @@ func RunChain33(name, defCfg string) {
 	chain.SetQueueClient(q.Client())
 
 	log.Info("loading store module")
-	s := store.New(chain33Cfg)
+	var s queue.Module
+	if lightMode {
+		s = &util.MockModule{Key: "store"}
+	} else {
+		s = store.New(chain33Cfg)
+	}
 	s.SetQueueClient(q.Client())
 
 	chain.Upgrade()
 
+	//轻节点的共识模块不出块, 只校验同步的区块头
 	log.Info("loading consensus module")
 	cs := consensus.New(chain33Cfg)
 	cs.SetQueueClient(q.Client())
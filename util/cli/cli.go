@@ -101,6 +101,7 @@ func Run(RPCAddr, ParaName, name string) {
 		commands.AssetCmd(),
 		commands.NoneCmd(),
 		commands.BtcScriptCmd(),
+		commands.BlsCmd(),
 	)
 
 	//test tls is enable
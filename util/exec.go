@@ -33,6 +33,25 @@ func CheckBlock(client queue.Client, block *types.BlockDetail) error {
 	return errors.New(string(reply.GetMsg()))
 }
 
+//CheckHeader : To check the header's validaty by consensus, used by light node
+func CheckHeader(client queue.Client, parent, header *types.Header) error {
+	req := &types.Headers{Items: []*types.Header{parent, header}}
+	msg := client.NewMessage("consensus", types.EventCheckHeader, req)
+	err := client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	reply := resp.GetData().(*types.Reply)
+	if reply.IsOk {
+		return nil
+	}
+	return errors.New(string(reply.GetMsg()))
+}
+
 //ExecTx : To send lists of txs within a block to exector for execution
 func ExecTx(client queue.Client, prevStateRoot []byte, block *types.Block) (*types.Receipts, error) {
 	list := &types.ExecTxList{
@@ -51,6 +51,14 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 
 	var key crypto.PrivKey
 	addressID := unsigned.GetAddressID()
+	//支持指定签名类型, 默认采用钱包配置的签名类型
+	signType := wallet.SignType
+	if unsigned.GetSignType() != "" {
+		signType = types.GetSignType("", unsigned.GetSignType())
+		if signType <= 0 {
+			return "", types.ErrNotSupport
+		}
+	}
 	if unsigned.GetAddr() != "" {
 		ok, err := wallet.checkWalletStatus()
 		if !ok {
@@ -60,6 +68,13 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		if err != nil {
 			return "", err
 		}
+		//同一私钥按照指定的签名类型重新加载, 如secp256k1私钥用于schnorr签名
+		if signType != wallet.SignType {
+			key, err = wallet.loadPrivKey(signType, key.Bytes())
+			if err != nil {
+				return "", err
+			}
+		}
 		addressID, err = address.GetAddressType(unsigned.Addr)
 		if err != nil {
 			return "", types.ErrInvalidAddress
@@ -73,11 +88,7 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		if len(keyByte) == 0 {
 			return "", types.ErrPrivateKeyLen
 		}
-		cr, err := crypto.Load(types.GetSignName("", wallet.SignType), wallet.lastHeader.GetHeight())
-		if err != nil {
-			return "", err
-		}
-		key, err = cr.PrivKeyFromBytes(keyByte)
+		key, err = wallet.loadPrivKey(signType, keyByte)
 		if err != nil {
 			return "", err
 		}
@@ -85,7 +96,7 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 		return "", types.ErrNoPrivKeyOrAddr
 	}
 	// signID integrate crypto ID with address ID
-	signID := types.EncodeSignID(int32(wallet.SignType), addressID)
+	signID := types.EncodeSignID(int32(signType), addressID)
 
 	txByteData, err := common.FromHex(unsigned.GetTxHex())
 	if err != nil {
@@ -169,6 +180,15 @@ func (wallet *Wallet) ProcSignRawTx(unsigned *types.ReqSignRawTx) (string, error
 	return signedTx, nil
 }
 
+// loadPrivKey 按照签名类型加载私钥, 需要签名插件在当前高度已启用
+func (wallet *Wallet) loadPrivKey(signType int, keyByte []byte) (crypto.PrivKey, error) {
+	cr, err := crypto.Load(types.GetSignName("", signType), wallet.lastHeader.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(keyByte)
+}
+
 // ProcGetAccount 通过地址标签获取账户地址
 func (wallet *Wallet) ProcGetAccount(req *types.ReqGetAccount) (*types.WalletAccount, error) {
 	wallet.mtx.Lock()
@@ -18,6 +18,8 @@ import (
 	"github.com/33cn/chain33/queue"
 	"github.com/33cn/chain33/store"
 	_ "github.com/33cn/chain33/system"
+	"github.com/33cn/chain33/system/address/taproot"
+	"github.com/33cn/chain33/system/crypto/schnorr"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/wallet/bipwallet"
@@ -795,6 +797,34 @@ func testSignRawTx(t *testing.T, wallet *Wallet) {
 	assert.NotNil(t, err)
 	wallet.SignType = signTy
 
+	//指定签名类型
+	unsigned.Privkey = AddrPrivKey
+	unsigned.NewToAddr = ""
+	unsigned.SignType = "unknown"
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	assert.Equal(t, types.ErrNotSupport, err)
+	unsigned.SignType = schnorr.Name
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	assert.Equal(t, crypto.ErrDriverNotEnable, err)
+	crypto.Init(&crypto.Config{EnableHeight: map[string]int64{schnorr.Name: 0}}, nil)
+	for _, req := range []*types.ReqSignRawTx{
+		{Privkey: AddrPrivKey, TxHex: unsigned.TxHex, Expire: "0", SignType: schnorr.Name, AddressID: taproot.ID},
+		{Addr: FromAddr, TxHex: unsigned.TxHex, Expire: "0", SignType: schnorr.Name},
+	} {
+		reply, err := wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", req)
+		require.NoError(t, err)
+		var tx types.Transaction
+		txByte, err := common.FromHex(reply.(*types.ReplySignRawTx).TxHex)
+		require.NoError(t, err)
+		require.NoError(t, types.Decode(txByte, &tx))
+		require.Equal(t, schnorr.Name, types.GetSignName("", int(tx.Signature.Ty)))
+		require.Equal(t, schnorr.PubKeySize, len(tx.Signature.Pubkey))
+		require.True(t, tx.CheckSign(0))
+		if req.AddressID == taproot.ID {
+			require.Equal(t, address.PubKeyToAddr(taproot.ID, tx.Signature.Pubkey), tx.From())
+		}
+	}
+
 	println("TestSignRawTx end")
 	println("--------------------------")
 }